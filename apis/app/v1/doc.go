@@ -0,0 +1,21 @@
+// Copyright 2019 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +k8s:deepcopy-gen=package
+// +groupName=app.kiegroup.org
+
+// Package v1 contains API Schema definitions for the app v1 API group.
+// v1 is the storage version and the conversion hub; it has the same schema as v1beta1, except that
+// conditions are plain optional lists and getters don't default the spec in place anymore.
+package v1
@@ -0,0 +1,69 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+// ImageRegistry is the container registry where the Kogito service image is pushed to by the Tekton build backend.
+type ImageRegistry struct {
+	// Registry and organization to push the final image to. The image is named after the target KogitoRuntime.
+	//
+	// Example: "quay.io/myorg".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Registry URL"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	URL string `json:"url"`
+
+	// Name of a secret of type kubernetes.io/dockerconfigjson holding the credentials to push to the registry.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Push Secret"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
+	Secret string `json:"secret,omitempty"`
+
+	// Set to true to push to a registry served over plain HTTP or with a self-signed certificate. Defaults to false.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// GetURL ...
+func (r *ImageRegistry) GetURL() string {
+	return r.URL
+}
+
+// SetURL ...
+func (r *ImageRegistry) SetURL(url string) {
+	r.URL = url
+}
+
+// GetSecret ...
+func (r *ImageRegistry) GetSecret() string {
+	return r.Secret
+}
+
+// SetSecret ...
+func (r *ImageRegistry) SetSecret(secret string) {
+	r.Secret = secret
+}
+
+// IsInsecure ...
+func (r *ImageRegistry) IsInsecure() bool {
+	return r.Insecure
+}
+
+// SetInsecure ...
+func (r *ImageRegistry) SetInsecure(insecure bool) {
+	r.Insecure = insecure
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+// Ingress defines the Kubernetes Ingress used to expose the service outside the cluster.
+type Ingress struct {
+	// Host name used to reach the service, e.g. "my-service.apps.example.com".
+	// The Ingress is only created when a host is provided.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	Host string `json:"host,omitempty"`
+
+	// Name of the IngressClass that will handle the Ingress. If not set, the cluster default class is used.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ingress Class Name"
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+
+	// Secret in the same namespace holding the TLS certificate for the host. When set, the service is exposed over HTTPS.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
+	TLSSecret string `json:"tlsSecret,omitempty"`
+
+	// Additional annotations to be added to the Ingress, usually to configure the ingress controller.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// GetHost ...
+func (i *Ingress) GetHost() string {
+	return i.Host
+}
+
+// SetHost ...
+func (i *Ingress) SetHost(host string) {
+	i.Host = host
+}
+
+// GetIngressClassName ...
+func (i *Ingress) GetIngressClassName() *string {
+	return i.IngressClassName
+}
+
+// SetIngressClassName ...
+func (i *Ingress) SetIngressClassName(ingressClassName *string) {
+	i.IngressClassName = ingressClassName
+}
+
+// GetTLSSecret ...
+func (i *Ingress) GetTLSSecret() string {
+	return i.TLSSecret
+}
+
+// SetTLSSecret ...
+func (i *Ingress) SetTLSSecret(tlsSecret string) {
+	i.TLSSecret = tlsSecret
+}
+
+// GetAnnotations ...
+func (i *Ingress) GetAnnotations() map[string]string {
+	return i.Annotations
+}
+
+// SetAnnotations ...
+func (i *Ingress) SetAnnotations(annotations map[string]string) {
+	i.Annotations = annotations
+}
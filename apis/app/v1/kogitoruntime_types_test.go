@@ -0,0 +1,47 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKogitoRuntimeSpec_GetRuntime(t *testing.T) {
+	instance := &KogitoRuntime{}
+	assert.Equal(t, api.QuarkusRuntimeType, instance.GetSpec().GetRuntime())
+	assert.Empty(t, instance.Spec.Runtime)
+
+	instance.Spec.Runtime = api.SpringBootRuntimeType
+	assert.Equal(t, api.SpringBootRuntimeType, instance.GetSpec().GetRuntime())
+}
+
+func TestKogitoRuntimeStatus_Conditions(t *testing.T) {
+	instance := &KogitoRuntime{}
+	conditions := instance.GetStatus().GetConditions()
+	assert.NotNil(t, conditions)
+	assert.Empty(t, *conditions)
+
+	*conditions = append(*conditions, metav1.Condition{Type: string(api.DeployedConditionType), Status: metav1.ConditionTrue})
+	assert.Len(t, instance.Status.Conditions, 1)
+
+	instance.GetStatus().SetRouteConditions(&[]metav1.Condition{{Type: "RouteCreated"}})
+	assert.Len(t, *instance.GetStatus().GetRouteConditions(), 1)
+	instance.GetStatus().SetConditions(nil)
+	assert.Nil(t, instance.Status.Conditions)
+}
@@ -0,0 +1,46 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+// Monitoring properties to connect with Monitoring service
+type Monitoring struct {
+	// HTTP scheme to use for scraping.
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+
+	// HTTP path to scrape for metrics.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// GetScheme ...
+func (m *Monitoring) GetScheme() string {
+	return m.Scheme
+}
+
+// SetScheme ...
+func (m *Monitoring) SetScheme(scheme string) {
+	m.Scheme = scheme
+}
+
+// GetPath ...
+func (m *Monitoring) GetPath() string {
+	return m.Path
+}
+
+// SetPath ...
+func (m *Monitoring) SetPath(path string) {
+	m.Path = path
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	api "github.com/kiegroup/kogito-operator/apis"
+)
+
+// Rollout defines how the new images of the KogitoRuntime are rolled out.
+type Rollout struct {
+	// Strategy used to roll out a new image:
+	// RollingUpdate replaces the pods of the service;
+	// Canary deploys the new image next to the current one and routes a share of the traffic to it;
+	// BlueGreen deploys the new image next to the current one and switches the traffic to it once promoted.
+	//
+	// Default value: RollingUpdate
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rollout Type"
+	// +kubebuilder:validation:Enum=RollingUpdate;Canary;BlueGreen
+	Type api.RolloutType `json:"type,omitempty"`
+
+	// Percentage of the traffic routed to the new image while a Canary rollout waits to be promoted.
+	// The traffic is split by the OpenShift Route and, when Istio is enabled, by an Istio VirtualService.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Canary Weight"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	CanaryWeight int32 `json:"canaryWeight,omitempty"`
+
+	// Image promoted to replace the current one, set it to the image of the candidate reported by the RollingOut condition.
+	// Until then, a new image set to the KogitoRuntime is deployed next to the current one, which keeps serving the traffic.
+	// The promotion only applies to the given image, the later ones are deployed next to it until promoted in turn.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Promoted Image"
+	PromotedImage string `json:"promotedImage,omitempty"`
+}
+
+// GetType ...
+func (r *Rollout) GetType() api.RolloutType {
+	if len(r.Type) == 0 {
+		return api.RollingUpdateRolloutType
+	}
+	return r.Type
+}
+
+// SetType ...
+func (r *Rollout) SetType(rolloutType api.RolloutType) {
+	r.Type = rolloutType
+}
+
+// GetCanaryWeight ...
+func (r *Rollout) GetCanaryWeight() int32 {
+	return r.CanaryWeight
+}
+
+// SetCanaryWeight ...
+func (r *Rollout) SetCanaryWeight(canaryWeight int32) {
+	r.CanaryWeight = canaryWeight
+}
+
+// GetPromotedImage ...
+func (r *Rollout) GetPromotedImage() string {
+	return r.PromotedImage
+}
+
+// SetPromotedImage ...
+func (r *Rollout) SetPromotedImage(promotedImage string) {
+	r.PromotedImage = promotedImage
+}
@@ -0,0 +1,188 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	api "github.com/kiegroup/kogito-operator/apis"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Volume defines a storage volume mounted in the service container.
+// Exactly one of ClaimName, ClaimTemplate or EmptyDir must be set.
+type Volume struct {
+	// Name of the volume, unique among the volumes of the service.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name"
+	Name string `json:"name"`
+
+	// Path within the service container at which the volume is mounted.
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Mount Path"
+	MountPath string `json:"mountPath"`
+
+	// Mounts the volume read-only.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Read Only"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// Name of an existing PersistentVolumeClaim in the namespace of the service.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Claim Name"
+	ClaimName string `json:"claimName,omitempty"`
+
+	// PersistentVolumeClaim created by the operator, named after the service and the volume.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Claim Template"
+	ClaimTemplate *VolumeClaimTemplate `json:"claimTemplate,omitempty"`
+
+	// Scratch storage sharing the lifetime of the pod.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Empty Dir"
+	EmptyDir *corev1.EmptyDirVolumeSource `json:"emptyDir,omitempty"`
+}
+
+// GetName ...
+func (v *Volume) GetName() string {
+	return v.Name
+}
+
+// SetName ...
+func (v *Volume) SetName(name string) {
+	v.Name = name
+}
+
+// GetMountPath ...
+func (v *Volume) GetMountPath() string {
+	return v.MountPath
+}
+
+// SetMountPath ...
+func (v *Volume) SetMountPath(mountPath string) {
+	v.MountPath = mountPath
+}
+
+// IsReadOnly ...
+func (v *Volume) IsReadOnly() bool {
+	return v.ReadOnly
+}
+
+// SetReadOnly ...
+func (v *Volume) SetReadOnly(readOnly bool) {
+	v.ReadOnly = readOnly
+}
+
+// GetClaimName ...
+func (v *Volume) GetClaimName() string {
+	return v.ClaimName
+}
+
+// SetClaimName ...
+func (v *Volume) SetClaimName(claimName string) {
+	v.ClaimName = claimName
+}
+
+// GetClaimTemplate ...
+func (v *Volume) GetClaimTemplate() api.VolumeClaimTemplateInterface {
+	if v.ClaimTemplate == nil {
+		return nil
+	}
+	return v.ClaimTemplate
+}
+
+// SetClaimTemplate ...
+func (v *Volume) SetClaimTemplate(claimTemplate api.VolumeClaimTemplateInterface) {
+	if claimTemplate == nil {
+		v.ClaimTemplate = nil
+	} else if newClaimTemplate, ok := claimTemplate.(*VolumeClaimTemplate); ok {
+		v.ClaimTemplate = newClaimTemplate
+	}
+}
+
+// GetEmptyDir ...
+func (v *Volume) GetEmptyDir() *corev1.EmptyDirVolumeSource {
+	return v.EmptyDir
+}
+
+// SetEmptyDir ...
+func (v *Volume) SetEmptyDir(emptyDir *corev1.EmptyDirVolumeSource) {
+	v.EmptyDir = emptyDir
+}
+
+// VolumeClaimTemplate defines the PersistentVolumeClaim created by the operator for a volume.
+type VolumeClaimTemplate struct {
+	// Requested storage of the claim. It can only be increased, when the storage class allows the expansion of the volumes.
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Size"
+	Size resource.Quantity `json:"size"`
+
+	// Name of the StorageClass of the claim. The default StorageClass of the cluster is used when not set.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Storage Class Name"
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// Access modes of the claim. Services running several pods at once, with more than one replica, autoscaling
+	// or a Canary or BlueGreen rollout, require ReadWriteMany.
+	//
+	// Default value: ReadWriteOnce
+	// +optional
+	// +listType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Access Modes"
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+}
+
+// GetSize ...
+func (v *VolumeClaimTemplate) GetSize() resource.Quantity {
+	return v.Size
+}
+
+// SetSize ...
+func (v *VolumeClaimTemplate) SetSize(size resource.Quantity) {
+	v.Size = size
+}
+
+// GetStorageClassName ...
+func (v *VolumeClaimTemplate) GetStorageClassName() *string {
+	return v.StorageClassName
+}
+
+// SetStorageClassName ...
+func (v *VolumeClaimTemplate) SetStorageClassName(storageClassName *string) {
+	v.StorageClassName = storageClassName
+}
+
+// GetAccessModes ...
+func (v *VolumeClaimTemplate) GetAccessModes() []corev1.PersistentVolumeAccessMode {
+	if len(v.AccessModes) == 0 {
+		return []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+	}
+	return v.AccessModes
+}
+
+// SetAccessModes ...
+func (v *VolumeClaimTemplate) SetAccessModes(accessModes []corev1.PersistentVolumeAccessMode) {
+	v.AccessModes = accessModes
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Autoscaling defines the HorizontalPodAutoscaler managed by the operator for the service.
+type Autoscaling struct {
+	// Lower limit for the number of replicas. Defaults to 1.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// Upper limit for the number of replicas. Cannot be lower than MinReplicas.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Target average CPU utilization over all the pods, represented as a percentage of the requested CPU.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// Target average memory utilization over all the pods, represented as a percentage of the requested memory.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// Custom metrics exposed by the service on its monitoring endpoint and served through the custom metrics API,
+	// e.g. by the Prometheus Adapter.
+	// +optional
+	// +listType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Metrics []AutoscalingMetric `json:"metrics,omitempty"`
+}
+
+// GetMinReplicas ...
+func (a *Autoscaling) GetMinReplicas() *int32 {
+	return a.MinReplicas
+}
+
+// SetMinReplicas ...
+func (a *Autoscaling) SetMinReplicas(minReplicas *int32) {
+	a.MinReplicas = minReplicas
+}
+
+// GetMaxReplicas ...
+func (a *Autoscaling) GetMaxReplicas() int32 {
+	return a.MaxReplicas
+}
+
+// SetMaxReplicas ...
+func (a *Autoscaling) SetMaxReplicas(maxReplicas int32) {
+	a.MaxReplicas = maxReplicas
+}
+
+// GetTargetCPUUtilizationPercentage ...
+func (a *Autoscaling) GetTargetCPUUtilizationPercentage() *int32 {
+	return a.TargetCPUUtilizationPercentage
+}
+
+// SetTargetCPUUtilizationPercentage ...
+func (a *Autoscaling) SetTargetCPUUtilizationPercentage(target *int32) {
+	a.TargetCPUUtilizationPercentage = target
+}
+
+// GetTargetMemoryUtilizationPercentage ...
+func (a *Autoscaling) GetTargetMemoryUtilizationPercentage() *int32 {
+	return a.TargetMemoryUtilizationPercentage
+}
+
+// SetTargetMemoryUtilizationPercentage ...
+func (a *Autoscaling) SetTargetMemoryUtilizationPercentage(target *int32) {
+	a.TargetMemoryUtilizationPercentage = target
+}
+
+// GetMetrics ...
+func (a *Autoscaling) GetMetrics() []api.AutoscalingMetricInterface {
+	metrics := make([]api.AutoscalingMetricInterface, len(a.Metrics))
+	for i, v := range a.Metrics {
+		metrics[i] = api.AutoscalingMetricInterface(v)
+	}
+	return metrics
+}
+
+// AutoscalingMetric is a custom per pod metric used to scale the service.
+type AutoscalingMetric struct {
+	// Name of the metric, e.g. "http_server_requests_per_second".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+
+	// Target value of the metric averaged across all the pods.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TargetAverageValue resource.Quantity `json:"targetAverageValue"`
+}
+
+// GetName ...
+func (a AutoscalingMetric) GetName() string {
+	return a.Name
+}
+
+// GetTargetAverageValue ...
+func (a AutoscalingMetric) GetTargetAverageValue() resource.Quantity {
+	return a.TargetAverageValue
+}
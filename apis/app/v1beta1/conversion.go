@@ -0,0 +1,95 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+
+	v1 "github.com/kiegroup/kogito-operator/apis/app/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this KogitoRuntime to the Hub version (v1).
+func (k *KogitoRuntime) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.KogitoRuntime)
+	dst.ObjectMeta = k.ObjectMeta
+	return convertSpecAndStatus(&k.Spec, &dst.Spec, &k.Status, &dst.Status)
+}
+
+// ConvertFrom converts from the Hub version (v1) to this version.
+func (k *KogitoRuntime) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.KogitoRuntime)
+	k.ObjectMeta = src.ObjectMeta
+	return convertSpecAndStatus(&src.Spec, &k.Spec, &src.Status, &k.Status)
+}
+
+// ConvertTo converts this KogitoBuild to the Hub version (v1).
+func (k *KogitoBuild) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.KogitoBuild)
+	dst.ObjectMeta = k.ObjectMeta
+	return convertSpecAndStatus(&k.Spec, &dst.Spec, &k.Status, &dst.Status)
+}
+
+// ConvertFrom converts from the Hub version (v1) to this version.
+func (k *KogitoBuild) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.KogitoBuild)
+	k.ObjectMeta = src.ObjectMeta
+	return convertSpecAndStatus(&src.Spec, &k.Spec, &src.Status, &k.Status)
+}
+
+// ConvertTo converts this KogitoInfra to the Hub version (v1).
+func (k *KogitoInfra) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.KogitoInfra)
+	dst.ObjectMeta = k.ObjectMeta
+	return convertSpecAndStatus(&k.Spec, &dst.Spec, &k.Status, &dst.Status)
+}
+
+// ConvertFrom converts from the Hub version (v1) to this version.
+func (k *KogitoInfra) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.KogitoInfra)
+	k.ObjectMeta = src.ObjectMeta
+	return convertSpecAndStatus(&src.Spec, &k.Spec, &src.Status, &k.Status)
+}
+
+// ConvertTo converts this KogitoSupportingService to the Hub version (v1).
+func (k *KogitoSupportingService) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.KogitoSupportingService)
+	dst.ObjectMeta = k.ObjectMeta
+	return convertSpecAndStatus(&k.Spec, &dst.Spec, &k.Status, &dst.Status)
+}
+
+// ConvertFrom converts from the Hub version (v1) to this version.
+func (k *KogitoSupportingService) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.KogitoSupportingService)
+	k.ObjectMeta = src.ObjectMeta
+	return convertSpecAndStatus(&src.Spec, &k.Spec, &src.Status, &k.Status)
+}
+
+// convertSpecAndStatus copies the spec and status between versions through their JSON representation.
+// v1 only changes how the Go types are handled (e.g. conditions aren't pointers anymore), the schema is the same as v1beta1.
+func convertSpecAndStatus(srcSpec, dstSpec, srcStatus, dstStatus interface{}) error {
+	if err := convertJSON(srcSpec, dstSpec); err != nil {
+		return err
+	}
+	return convertJSON(srcStatus, dstStatus)
+}
+
+func convertJSON(src, dst interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	v1 "github.com/kiegroup/kogito-operator/apis/app/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKogitoRuntime_ConvertRoundTrip(t *testing.T) {
+	replicas := int32(2)
+	conditions := []metav1.Condition{{Type: string(api.DeployedConditionType), Status: metav1.ConditionTrue, Reason: "Deployed"}}
+	original := &KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name(), Labels: map[string]string{"app": "process-quarkus-example"}},
+		Spec: KogitoRuntimeSpec{
+			KogitoServiceSpec: KogitoServiceSpec{
+				Replicas:    &replicas,
+				Image:       "quay.io/kiegroup/process-quarkus-example:latest",
+				Infra:       []string{"kogito-kafka"},
+				Autoscaling: &Autoscaling{MaxReplicas: 4},
+				Ingress:     Ingress{Host: "process-quarkus-example.example.com"},
+				EnableIstio: true,
+			},
+			Runtime: api.SpringBootRuntimeType,
+		},
+		Status: KogitoRuntimeStatus{
+			KogitoServiceStatus: KogitoServiceStatus{Conditions: &conditions, ExternalURI: "http://process-quarkus-example.example.com"},
+		},
+	}
+
+	hub := &v1.KogitoRuntime{}
+	assert.NoError(t, original.ConvertTo(hub))
+	assert.Equal(t, original.ObjectMeta, hub.ObjectMeta)
+	assert.Equal(t, replicas, *hub.Spec.Replicas)
+	assert.Equal(t, api.SpringBootRuntimeType, hub.Spec.Runtime)
+	assert.Equal(t, "process-quarkus-example.example.com", hub.Spec.Ingress.Host)
+	assert.Equal(t, int32(4), hub.Spec.Autoscaling.MaxReplicas)
+	assert.Equal(t, conditions, hub.Status.Conditions)
+
+	converted := &KogitoRuntime{}
+	assert.NoError(t, converted.ConvertFrom(hub))
+	assert.Equal(t, original, converted)
+}
+
+func TestKogitoBuild_ConvertRoundTrip(t *testing.T) {
+	original := &KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: KogitoBuildSpec{
+			Type:      api.RemoteSourceBuildType,
+			GitSource: GitSource{URI: "https://github.com/kiegroup/kogito-examples", ContextDir: "process-quarkus-example"},
+			Runtime:   api.QuarkusRuntimeType,
+		},
+		Status: KogitoBuildStatus{LatestBuild: "process-quarkus-example-1", Builds: Builds{Running: []string{"process-quarkus-example-1"}}},
+	}
+
+	hub := &v1.KogitoBuild{}
+	assert.NoError(t, original.ConvertTo(hub))
+	assert.Equal(t, "process-quarkus-example", hub.Spec.GitSource.ContextDir)
+	assert.Nil(t, hub.Status.Conditions)
+
+	converted := &KogitoBuild{}
+	assert.NoError(t, converted.ConvertFrom(hub))
+	assert.Equal(t, original, converted)
+}
+
+func TestKogitoInfra_ConvertRoundTrip(t *testing.T) {
+	original := &KogitoInfra{
+		ObjectMeta: metav1.ObjectMeta{Name: "kogito-kafka", Namespace: t.Name()},
+		Spec: KogitoInfraSpec{
+			Resource:        &InfraResource{APIVersion: "kafka.strimzi.io/v1beta2", Kind: "Kafka", Name: "kogito-kafka"},
+			InfraProperties: map[string]string{"topic": "kogito"},
+		},
+		Status: KogitoInfraStatus{ConfigMapEnvFromReferences: []string{"kogito-kafka-config"}},
+	}
+
+	hub := &v1.KogitoInfra{}
+	assert.NoError(t, original.ConvertTo(hub))
+	assert.Equal(t, "Kafka", hub.Spec.Resource.Kind)
+
+	converted := &KogitoInfra{}
+	assert.NoError(t, converted.ConvertFrom(hub))
+	assert.Equal(t, original, converted)
+}
+
+func TestKogitoSupportingService_ConvertRoundTrip(t *testing.T) {
+	original := &KogitoSupportingService{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-index", Namespace: t.Name()},
+		Spec:       KogitoSupportingServiceSpec{ServiceType: api.DataIndex},
+		Status:     KogitoSupportingServiceStatus{PersistenceBackend: api.PostgreSQLPersistenceBackend},
+	}
+
+	hub := &v1.KogitoSupportingService{}
+	assert.NoError(t, original.ConvertTo(hub))
+	assert.Equal(t, api.DataIndex, hub.Spec.ServiceType)
+	assert.Equal(t, api.PostgreSQLPersistenceBackend, hub.Status.PersistenceBackend)
+
+	converted := &KogitoSupportingService{}
+	assert.NoError(t, converted.ConvertFrom(hub))
+	assert.Equal(t, original, converted)
+}
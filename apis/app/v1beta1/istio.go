@@ -0,0 +1,252 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import api "github.com/kiegroup/kogito-operator/apis"
+
+// Istio defines the Istio resources managed by the operator for a service in the mesh, when EnableIstio is set.
+// A VirtualService and a DestinationRule are always created for the service, a Gateway only when configured.
+type Istio struct {
+	// TLS mode of the connections to the service set in its DestinationRule.
+	//
+	// Default value: ISTIO_MUTUAL
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Mode"
+	// +kubebuilder:validation:Enum=DISABLE;SIMPLE;MUTUAL;ISTIO_MUTUAL
+	TLSMode api.IstioTLSModeType `json:"tlsMode,omitempty"`
+
+	// Limits of the connections to the service set in its DestinationRule.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Connection Pool"
+	ConnectionPool *IstioConnectionPool `json:"connectionPool,omitempty"`
+
+	// Ejection of the unhealthy pods of the service from the load balancing pool set in its DestinationRule.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Outlier Detection"
+	OutlierDetection *IstioOutlierDetection `json:"outlierDetection,omitempty"`
+
+	// Exposes the service out of the mesh through an Istio Gateway bound to its VirtualService.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Gateway"
+	Gateway *IstioGateway `json:"gateway,omitempty"`
+}
+
+// IstioConnectionPool defines the limits of the connections to the service.
+type IstioConnectionPool struct {
+	// Maximum number of HTTP1/TCP connections to the service.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnections int32 `json:"maxConnections,omitempty"`
+
+	// Maximum number of HTTP requests waiting for a connection to the service.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	HTTP1MaxPendingRequests int32 `json:"http1MaxPendingRequests,omitempty"`
+
+	// Maximum number of requests per connection to the service. Set to 1 to disable the keep alive.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestsPerConnection int32 `json:"maxRequestsPerConnection,omitempty"`
+}
+
+// IstioOutlierDetection defines how the unhealthy pods of the service are ejected from the load balancing pool.
+type IstioOutlierDetection struct {
+	// Number of consecutive 5xx errors before a pod is ejected from the pool.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Consecutive5xxErrors int32 `json:"consecutive5xxErrors,omitempty"`
+
+	// Time interval between the ejection analysis, e.g. 10s.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	Interval string `json:"interval,omitempty"`
+
+	// Minimum ejection duration of a pod, e.g. 30s.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	BaseEjectionTime string `json:"baseEjectionTime,omitempty"`
+
+	// Maximum percentage of the pods of the service that can be ejected.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxEjectionPercent int32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// IstioGateway defines the Istio Gateway exposing the service out of the mesh.
+type IstioGateway struct {
+	// Hosts exposed by the Gateway, e.g. my-service.example.com.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	Hosts []string `json:"hosts"`
+
+	// Labels selecting the Istio ingress gateway pods the Gateway is applied to.
+	//
+	// Default value: istio: ingressgateway
+	// +optional
+	Selector map[string]string `json:"selector,omitempty"`
+}
+
+// GetTLSMode ...
+func (i *Istio) GetTLSMode() api.IstioTLSModeType {
+	if len(i.TLSMode) == 0 {
+		return api.IstioMeshMutualTLSMode
+	}
+	return i.TLSMode
+}
+
+// SetTLSMode ...
+func (i *Istio) SetTLSMode(tlsMode api.IstioTLSModeType) {
+	i.TLSMode = tlsMode
+}
+
+// GetConnectionPool ...
+func (i *Istio) GetConnectionPool() api.IstioConnectionPoolInterface {
+	if i.ConnectionPool == nil {
+		return nil
+	}
+	return i.ConnectionPool
+}
+
+// SetConnectionPool ...
+func (i *Istio) SetConnectionPool(connectionPool api.IstioConnectionPoolInterface) {
+	if newConnectionPool, ok := connectionPool.(*IstioConnectionPool); ok {
+		i.ConnectionPool = newConnectionPool
+	}
+}
+
+// GetOutlierDetection ...
+func (i *Istio) GetOutlierDetection() api.IstioOutlierDetectionInterface {
+	if i.OutlierDetection == nil {
+		return nil
+	}
+	return i.OutlierDetection
+}
+
+// SetOutlierDetection ...
+func (i *Istio) SetOutlierDetection(outlierDetection api.IstioOutlierDetectionInterface) {
+	if newOutlierDetection, ok := outlierDetection.(*IstioOutlierDetection); ok {
+		i.OutlierDetection = newOutlierDetection
+	}
+}
+
+// GetGateway ...
+func (i *Istio) GetGateway() api.IstioGatewayInterface {
+	if i.Gateway == nil {
+		return nil
+	}
+	return i.Gateway
+}
+
+// SetGateway ...
+func (i *Istio) SetGateway(gateway api.IstioGatewayInterface) {
+	if newGateway, ok := gateway.(*IstioGateway); ok {
+		i.Gateway = newGateway
+	}
+}
+
+// GetMaxConnections ...
+func (c *IstioConnectionPool) GetMaxConnections() int32 {
+	return c.MaxConnections
+}
+
+// SetMaxConnections ...
+func (c *IstioConnectionPool) SetMaxConnections(maxConnections int32) {
+	c.MaxConnections = maxConnections
+}
+
+// GetHTTP1MaxPendingRequests ...
+func (c *IstioConnectionPool) GetHTTP1MaxPendingRequests() int32 {
+	return c.HTTP1MaxPendingRequests
+}
+
+// SetHTTP1MaxPendingRequests ...
+func (c *IstioConnectionPool) SetHTTP1MaxPendingRequests(http1MaxPendingRequests int32) {
+	c.HTTP1MaxPendingRequests = http1MaxPendingRequests
+}
+
+// GetMaxRequestsPerConnection ...
+func (c *IstioConnectionPool) GetMaxRequestsPerConnection() int32 {
+	return c.MaxRequestsPerConnection
+}
+
+// SetMaxRequestsPerConnection ...
+func (c *IstioConnectionPool) SetMaxRequestsPerConnection(maxRequestsPerConnection int32) {
+	c.MaxRequestsPerConnection = maxRequestsPerConnection
+}
+
+// GetConsecutive5xxErrors ...
+func (o *IstioOutlierDetection) GetConsecutive5xxErrors() int32 {
+	return o.Consecutive5xxErrors
+}
+
+// SetConsecutive5xxErrors ...
+func (o *IstioOutlierDetection) SetConsecutive5xxErrors(consecutive5xxErrors int32) {
+	o.Consecutive5xxErrors = consecutive5xxErrors
+}
+
+// GetInterval ...
+func (o *IstioOutlierDetection) GetInterval() string {
+	return o.Interval
+}
+
+// SetInterval ...
+func (o *IstioOutlierDetection) SetInterval(interval string) {
+	o.Interval = interval
+}
+
+// GetBaseEjectionTime ...
+func (o *IstioOutlierDetection) GetBaseEjectionTime() string {
+	return o.BaseEjectionTime
+}
+
+// SetBaseEjectionTime ...
+func (o *IstioOutlierDetection) SetBaseEjectionTime(baseEjectionTime string) {
+	o.BaseEjectionTime = baseEjectionTime
+}
+
+// GetMaxEjectionPercent ...
+func (o *IstioOutlierDetection) GetMaxEjectionPercent() int32 {
+	return o.MaxEjectionPercent
+}
+
+// SetMaxEjectionPercent ...
+func (o *IstioOutlierDetection) SetMaxEjectionPercent(maxEjectionPercent int32) {
+	o.MaxEjectionPercent = maxEjectionPercent
+}
+
+// GetHosts ...
+func (g *IstioGateway) GetHosts() []string {
+	return g.Hosts
+}
+
+// SetHosts ...
+func (g *IstioGateway) SetHosts(hosts []string) {
+	g.Hosts = hosts
+}
+
+// GetSelector ...
+func (g *IstioGateway) GetSelector() map[string]string {
+	return g.Selector
+}
+
+// SetSelector ...
+func (g *IstioGateway) SetSelector(selector map[string]string) {
+	g.Selector = selector
+}
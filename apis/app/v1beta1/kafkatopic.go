@@ -0,0 +1,85 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+// KafkaTopic defines the configuration of a Kafka topic created by the operator in the Strimzi cluster bound through a KogitoInfra.
+type KafkaTopic struct {
+	// Name of the topic, as exposed by the Kogito service or required by the supporting service.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	Name string `json:"name"`
+
+	// Number of partitions of the topic. Partitions can only be increased once the topic is created. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	Partitions int32 `json:"partitions,omitempty"`
+
+	// Replication factor of the topic. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Topic configuration, for example "retention.ms" or "cleanup.policy".
+	//
+	// For more information, see https://kafka.apache.org/documentation/#topicconfigs.
+	// +optional
+	// +mapType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// GetName ...
+func (k *KafkaTopic) GetName() string {
+	return k.Name
+}
+
+// SetName ...
+func (k *KafkaTopic) SetName(name string) {
+	k.Name = name
+}
+
+// GetPartitions ...
+func (k *KafkaTopic) GetPartitions() int32 {
+	return k.Partitions
+}
+
+// SetPartitions ...
+func (k *KafkaTopic) SetPartitions(partitions int32) {
+	k.Partitions = partitions
+}
+
+// GetReplicas ...
+func (k *KafkaTopic) GetReplicas() int32 {
+	return k.Replicas
+}
+
+// SetReplicas ...
+func (k *KafkaTopic) SetReplicas(replicas int32) {
+	k.Replicas = replicas
+}
+
+// GetConfig ...
+func (k *KafkaTopic) GetConfig() map[string]string {
+	return k.Config
+}
+
+// SetConfig ...
+func (k *KafkaTopic) SetConfig(config map[string]string) {
+	k.Config = config
+}
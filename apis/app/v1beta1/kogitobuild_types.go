@@ -150,6 +150,28 @@ type KogitoBuildSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable Maven Download Output"
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
 	EnableMavenDownloadOutput bool `json:"enableMavenDownloadOutput,omitempty"`
+
+	// Engine that runs the builds. Use Tekton to build on clusters without OpenShift Builds.
+	// Default value: OpenShift on OpenShift, Tekton on the other clusters.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Build Backend"
+	// +kubebuilder:validation:Enum=OpenShift;Tekton
+	Backend api.KogitoBuildBackendType `json:"backend,omitempty"`
+
+	// Registry where the final image is pushed to. Required when Backend is Tekton.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Image Registry"
+	Registry *ImageRegistry `json:"registry,omitempty"`
+
+	// Name of the PersistentVolumeClaim holding the uploaded files (Local Source) or the compiled binaries (Binary).
+	// Required for Local Source and Binary builds when Backend is Tekton.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source Volume Claim"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	SourceVolumeClaim string `json:"sourceVolumeClaim,omitempty"`
 }
 
 // AddResourceRequest adds new resource request. Works also on an uninitialized Requests field.
@@ -324,6 +346,41 @@ func (k *KogitoBuildSpec) SetEnableMavenDownloadOutput(enableMavenDownloadOutput
 	k.EnableMavenDownloadOutput = enableMavenDownloadOutput
 }
 
+// GetBackend ...
+func (k *KogitoBuildSpec) GetBackend() api.KogitoBuildBackendType {
+	return k.Backend
+}
+
+// SetBackend ...
+func (k *KogitoBuildSpec) SetBackend(backend api.KogitoBuildBackendType) {
+	k.Backend = backend
+}
+
+// GetRegistry ...
+func (k *KogitoBuildSpec) GetRegistry() api.ImageRegistryInterface {
+	if k.Registry == nil {
+		return nil
+	}
+	return k.Registry
+}
+
+// SetRegistry ...
+func (k *KogitoBuildSpec) SetRegistry(registry api.ImageRegistryInterface) {
+	if newRegistry, ok := registry.(*ImageRegistry); ok {
+		k.Registry = newRegistry
+	}
+}
+
+// GetSourceVolumeClaim ...
+func (k *KogitoBuildSpec) GetSourceVolumeClaim() string {
+	return k.SourceVolumeClaim
+}
+
+// SetSourceVolumeClaim ...
+func (k *KogitoBuildSpec) SetSourceVolumeClaim(sourceVolumeClaim string) {
+	k.SourceVolumeClaim = sourceVolumeClaim
+}
+
 // KogitoBuildStatus defines the observed state of KogitoBuild.
 // +k8s:openapi-gen=true
 type KogitoBuildStatus struct {
@@ -470,6 +527,7 @@ func (b *Builds) SetCancelled(cancelled []string) {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +k8s:openapi-gen=true
 // +genclient
 // +kubebuilder:resource:path=kogitobuilds,scope=Namespaced
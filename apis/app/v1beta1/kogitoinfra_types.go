@@ -30,6 +30,12 @@ type KogitoInfraSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Resource *InfraResource `json:"resource,omitempty"`
 
+	// +optional
+	// Endpoint of an infrastructure not managed by an operator in the cluster, for example a managed Kafka or database service.
+	// Can't be set together with the resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	External *ExternalInfra `json:"external,omitempty"`
+
 	// +optional
 	// +mapType=atomic
 	// Optional properties which would be needed to setup correct runtime/service configuration, based on the resource type.
@@ -67,6 +73,14 @@ type KogitoInfraSpec struct {
 	// List of secret that should be munted to the services bound to this infra instance
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	SecretVolumeReferences []VolumeReference `json:"secretVolumeReferences,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	// Configuration of the Kafka topics created in the Kafka cluster referenced by this infra instance.
+	// Topics not listed here are created with 1 partition and 1 replica.
+	// The configuration defined in the KogitoRuntime or KogitoSupportingService takes precedence.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	KafkaTopics []KafkaTopic `json:"kafkaTopics,omitempty"`
 }
 
 // GetResource ...
@@ -79,6 +93,16 @@ func (k *KogitoInfraSpec) IsResourceEmpty() bool {
 	return k.Resource == nil
 }
 
+// GetExternal ...
+func (k *KogitoInfraSpec) GetExternal() api.ExternalInfraInterface {
+	return k.External
+}
+
+// IsExternalEmpty ...
+func (k *KogitoInfraSpec) IsExternalEmpty() bool {
+	return k.External == nil
+}
+
 // GetInfraProperties ...
 func (k *KogitoInfraSpec) GetInfraProperties() map[string]string {
 	return k.InfraProperties
@@ -131,6 +155,16 @@ func (k *KogitoInfraSpec) GetSecretVolumeReferences() []api.VolumeReferenceInter
 	return newSecretVolumeReferences
 }
 
+// GetKafkaTopics ...
+func (k *KogitoInfraSpec) GetKafkaTopics() []api.KafkaTopicInterface {
+	kafkaTopics := make([]api.KafkaTopicInterface, len(k.KafkaTopics))
+	for i, v := range k.KafkaTopics {
+		item := v
+		kafkaTopics[i] = &item
+	}
+	return kafkaTopics
+}
+
 // KogitoInfraStatus defines the observed state of KogitoInfra.
 // +k8s:openapi-gen=true
 type KogitoInfraStatus struct {
@@ -298,7 +332,9 @@ type InfraResource struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="APIVersion"
 	APIVersion string `json:"apiVersion"`
 
-	// Kind describes the kind of referred Kubernetes resource for example, Infinispan
+	// Kind describes the kind of referred Kubernetes resource for example, Infinispan.
+	// PostgreSQL is supported through the PostgresCluster of the Crunchy Data operator only,
+	// other PostgreSQL servers are referred as external infrastructure.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kind"
 	Kind string `json:"kind"`
@@ -355,7 +391,80 @@ func (r *InfraResource) SetName(name string) {
 	r.Name = name
 }
 
+// ExternalInfra describes the endpoint of an infrastructure not managed by an operator in the cluster
+type ExternalInfra struct {
+
+	// Kind of infrastructure provided by the endpoint.
+	// +kubebuilder:validation:Enum=Kafka;Infinispan;MongoDB;PostgreSQL;Keycloak
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kind"
+	Kind api.ExternalInfraKind `json:"kind"`
+
+	// URI of the endpoint: the bootstrap servers for Kafka (host1:9092,host2:9092), the server list for Infinispan (host:11222),
+	// the connection string for MongoDB (mongodb://host:27017/database), the JDBC URL for PostgreSQL (jdbc:postgresql://host:5432/database)
+	// or the realm URL for Keycloak (https://host/auth/realms/kogito).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="URI"
+	URI string `json:"uri"`
+
+	// +optional
+	// Name of the Secret holding the `username` and `password` keys used to connect to the endpoint.
+	// For Keycloak, they hold the client ID and the client secret of the services.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Credentials Secret"
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+
+	// +optional
+	// Name of the Secret holding the CA certificate (`ca.crt` key) trusted to connect to the endpoint over TLS.
+	// Kafka and Infinispan clients are configured with a truststore generated from it.
+	// For the other kinds, the certificate is mounted in the services under /home/kogito/certs/<kind in lower case>/ca.crt.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TrustStore Secret"
+	TrustStoreSecret string `json:"trustStoreSecret,omitempty"`
+}
+
+// GetKind ...
+func (e *ExternalInfra) GetKind() api.ExternalInfraKind {
+	return e.Kind
+}
+
+// SetKind ...
+func (e *ExternalInfra) SetKind(kind api.ExternalInfraKind) {
+	e.Kind = kind
+}
+
+// GetURI ...
+func (e *ExternalInfra) GetURI() string {
+	return e.URI
+}
+
+// SetURI ...
+func (e *ExternalInfra) SetURI(uri string) {
+	e.URI = uri
+}
+
+// GetCredentialsSecret ...
+func (e *ExternalInfra) GetCredentialsSecret() string {
+	return e.CredentialsSecret
+}
+
+// SetCredentialsSecret ...
+func (e *ExternalInfra) SetCredentialsSecret(credentialsSecret string) {
+	e.CredentialsSecret = credentialsSecret
+}
+
+// GetTrustStoreSecret ...
+func (e *ExternalInfra) GetTrustStoreSecret() string {
+	return e.TrustStoreSecret
+}
+
+// SetTrustStoreSecret ...
+func (e *ExternalInfra) SetTrustStoreSecret(trustStoreSecret string) {
+	e.TrustStoreSecret = trustStoreSecret
+}
+
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +k8s:openapi-gen=true
 // +genclient
 // +kubebuilder:resource:path=kogitoinfras,scope=Namespaced
@@ -394,6 +503,16 @@ type KogitoInfraList struct {
 	Items           []KogitoInfra `json:"items"`
 }
 
+// GetItems ...
+func (k *KogitoInfraList) GetItems() []api.KogitoInfraInterface {
+	models := make([]api.KogitoInfraInterface, len(k.Items))
+	for i, v := range k.Items {
+		item := v
+		models[i] = &item
+	}
+	return models
+}
+
 func init() {
 	SchemeBuilder.Register(&KogitoInfra{}, &KogitoInfraList{})
 }
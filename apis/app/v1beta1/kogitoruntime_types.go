@@ -23,12 +23,6 @@ import (
 type KogitoRuntimeSpec struct {
 	KogitoServiceSpec `json:",inline"`
 
-	// Annotates the pods managed by the operator with the required metadata for Istio to setup its sidecars, enabling the mesh. Defaults to false.
-	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable Istio"
-	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
-	EnableIstio bool `json:"enableIstio,omitempty"`
-
 	// The name of the runtime used, either Quarkus or SpringBoot.
 	//
 	// Default value: quarkus
@@ -36,6 +30,33 @@ type KogitoRuntimeSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Runtime"
 	// +kubebuilder:validation:Enum=quarkus;springboot
 	Runtime api.RuntimeType `json:"runtime,omitempty"`
+
+	// Defines how the new images of the service are rolled out. Defaults to a rolling update of its pods.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rollout"
+	Rollout *Rollout `json:"rollout,omitempty"`
+
+	// Defines the kind of resources the service is deployed with.
+	// KnativeService deploys it as a Knative Serving Service, scaling it to zero when idle, instead of a Deployment exposed through a Service and a Route.
+	//
+	// Default value: Deployment
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Deployment Mode"
+	// +kubebuilder:validation:Enum=Deployment;KnativeService
+	DeploymentMode api.DeploymentModeType `json:"deploymentMode,omitempty"`
+
+	// Supporting Services deployed in other namespaces whose URLs are injected into the service.
+	// Supporting Services of the same type deployed in the namespace of the KogitoRuntime are ignored.
+	// When neither is found, the Supporting Services of the namespace set to the operator with the
+	// KOGITO_SUPPORTING_SERVICES_NAMESPACE environment variable are used.
+	// +optional
+	// +listType=map
+	// +listMapKey=serviceType
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Supporting Service References"
+	SupportingServiceRefs []SupportingServiceReference `json:"supportingServiceRefs,omitempty"`
 }
 
 // GetRuntime ...
@@ -46,14 +67,52 @@ func (k *KogitoRuntimeSpec) GetRuntime() api.RuntimeType {
 	return k.Runtime
 }
 
-// IsEnableIstio ...
-func (k *KogitoRuntimeSpec) IsEnableIstio() bool {
-	return k.EnableIstio
+// GetRollout ...
+func (k *KogitoRuntimeSpec) GetRollout() api.RolloutInterface {
+	if k.Rollout == nil {
+		return nil
+	}
+	return k.Rollout
 }
 
-// SetEnableIstio ...
-func (k *KogitoRuntimeSpec) SetEnableIstio(enableIstio bool) {
-	k.EnableIstio = enableIstio
+// SetRollout ...
+func (k *KogitoRuntimeSpec) SetRollout(rollout api.RolloutInterface) {
+	if newRollout, ok := rollout.(*Rollout); ok {
+		k.Rollout = newRollout
+	}
+}
+
+// GetDeploymentMode gets the kind of resources the service is deployed with, falling back to Deployment when it's not set
+func (k *KogitoRuntimeSpec) GetDeploymentMode() api.DeploymentModeType {
+	if len(k.DeploymentMode) == 0 {
+		return api.DeploymentDeploymentMode
+	}
+	return k.DeploymentMode
+}
+
+// SetDeploymentMode ...
+func (k *KogitoRuntimeSpec) SetDeploymentMode(deploymentMode api.DeploymentModeType) {
+	k.DeploymentMode = deploymentMode
+}
+
+// GetSupportingServiceRefs ...
+func (k *KogitoRuntimeSpec) GetSupportingServiceRefs() []api.SupportingServiceReferenceInterface {
+	refs := make([]api.SupportingServiceReferenceInterface, len(k.SupportingServiceRefs))
+	for i := range k.SupportingServiceRefs {
+		refs[i] = &k.SupportingServiceRefs[i]
+	}
+	return refs
+}
+
+// SetSupportingServiceRefs ...
+func (k *KogitoRuntimeSpec) SetSupportingServiceRefs(supportingServiceRefs []api.SupportingServiceReferenceInterface) {
+	var refs []SupportingServiceReference
+	for _, ref := range supportingServiceRefs {
+		if newRef, ok := ref.(*SupportingServiceReference); ok {
+			refs = append(refs, *newRef)
+		}
+	}
+	k.SupportingServiceRefs = refs
 }
 
 // KogitoRuntimeStatus defines the observed state of KogitoRuntime.
@@ -62,6 +121,7 @@ type KogitoRuntimeStatus struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +k8s:openapi-gen=true
 // +genclient
 // +kubebuilder:resource:path=kogitoruntimes,scope=Namespaced
@@ -188,6 +188,54 @@ type KogitoServiceSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// Autoscaling configuration. When set, the operator manages a HorizontalPodAutoscaler for the service
+	// and Replicas is ignored.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Autoscaling"
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+
+	// PodDisruptionBudget configuration. When the service runs more than one replica, the operator manages a
+	// PodDisruptionBudget allowing one pod at a time to be evicted, unless configured otherwise here.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pod Disruption Budget"
+	PodDisruptionBudget *PodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+
+	// NetworkPolicy configuration. When enabled, the operator manages a NetworkPolicy allowing only the traffic
+	// between the service and the Kogito services and KogitoInfra resources it's bound to.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Network Policy"
+	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
+
+	// Annotates the pods managed by the operator with the required metadata for Istio to setup its sidecars, enabling the mesh.
+	// The operator also manages the Istio VirtualService and DestinationRule of the service. Defaults to false.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable Istio"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	EnableIstio bool `json:"enableIstio,omitempty"`
+
+	// Istio resources configuration, applied when EnableIstio is set.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Istio"
+	Istio *Istio `json:"istio,omitempty"`
+
+	// Serves HTTPS with a serving certificate managed by the operator. Can't be set along with EnableIstio.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS"
+	TLS *TLS `json:"tls,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	// Configuration of the Kafka topics created for this service in the Kafka cluster bound through a KogitoInfra.
+	// Takes precedence over the configuration defined in the KogitoInfra.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	KafkaTopics []KafkaTopic `json:"kafkaTopics,omitempty"`
+
 	// +optional
 	// +listType=atomic
 	// Environment variables to be added to the runtime container. Keys must be a C_IDENTIFIER.
@@ -223,6 +271,26 @@ type KogitoServiceSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Additional Deployment Labels"
 	DeploymentLabels map[string]string `json:"deploymentLabels,omitempty"`
 
+	// Overrides of the pod template rendered by the operator, strategic-merge-patched onto the pods of the Deployment.
+	//
+	// Allows adding sidecars and init containers or setting node selectors, tolerations, affinity, topology spread constraints,
+	// security context and priority class. The service container is patched through a container named after the service.
+	// +optional
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pod Template"
+	PodTemplate *corev1.PodTemplateSpec `json:"podTemplate,omitempty"`
+
+	// Storage volumes mounted in the service container, backed by PersistentVolumeClaims or emptyDir.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Volumes"
+	Volumes []Volume `json:"volumes,omitempty"`
+
 	// Additional labels to be added to the Service managed by the operator.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Additional Service Labels"
@@ -269,6 +337,16 @@ type KogitoServiceSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
 	TrustStoreSecret string `json:"trustStoreSecret,omitempty"`
 
+	// PEM encoded CA certificates that will be used by this service to make calls to TLS endpoints.
+	//
+	// The operator builds and rotates the truststore of the service from these sources, rolling out the pods when the certificates change.
+	// Can't be used together with TrustStoreSecret.
+	// +optional
+	// +listType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TrustStore Sources"
+	TrustStoreSources []TrustStoreSource `json:"trustStoreSources,omitempty"`
+
 	// A flag indicating that routes are disabled. Usable just on OpenShift.
 	//
 	// If not provided, defaults to 'false'.
@@ -277,6 +355,14 @@ type KogitoServiceSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="DisableRoute"
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
 	DisableRoute bool `json:"disableRoute,omitempty"`
+
+	// Ingress configuration used to expose the service outside the cluster. Usable just on Kubernetes, where Routes are not available.
+	//
+	// Setting DisableRoute to 'true' also disables the Ingress.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ingress"
+	Ingress Ingress `json:"ingress,omitempty"`
 }
 
 // GetReplicas ...
@@ -285,6 +371,115 @@ func (k *KogitoServiceSpec) GetReplicas() *int32 { return k.Replicas }
 // SetReplicas ...
 func (k *KogitoServiceSpec) SetReplicas(replicas int32) { k.Replicas = &replicas }
 
+// GetAutoscaling ...
+func (k *KogitoServiceSpec) GetAutoscaling() api.AutoscalingInterface {
+	if k.Autoscaling == nil {
+		return nil
+	}
+	return k.Autoscaling
+}
+
+// GetPodDisruptionBudget ...
+func (k *KogitoServiceSpec) GetPodDisruptionBudget() api.PodDisruptionBudgetInterface {
+	if k.PodDisruptionBudget == nil {
+		return nil
+	}
+	return k.PodDisruptionBudget
+}
+
+// SetPodDisruptionBudget ...
+func (k *KogitoServiceSpec) SetPodDisruptionBudget(podDisruptionBudget api.PodDisruptionBudgetInterface) {
+	if newPodDisruptionBudget, ok := podDisruptionBudget.(*PodDisruptionBudget); ok {
+		k.PodDisruptionBudget = newPodDisruptionBudget
+	}
+}
+
+// GetNetworkPolicy ...
+func (k *KogitoServiceSpec) GetNetworkPolicy() api.NetworkPolicyInterface {
+	if k.NetworkPolicy == nil {
+		return nil
+	}
+	return k.NetworkPolicy
+}
+
+// SetNetworkPolicy ...
+func (k *KogitoServiceSpec) SetNetworkPolicy(networkPolicy api.NetworkPolicyInterface) {
+	if newNetworkPolicy, ok := networkPolicy.(*NetworkPolicy); ok {
+		k.NetworkPolicy = newNetworkPolicy
+	}
+}
+
+// IsNetworkPolicyEnabled ...
+func (k *KogitoServiceSpec) IsNetworkPolicyEnabled() bool {
+	return k.NetworkPolicy != nil && k.NetworkPolicy.Enabled
+}
+
+// IsEnableIstio ...
+func (k *KogitoServiceSpec) IsEnableIstio() bool {
+	return k.EnableIstio
+}
+
+// SetEnableIstio ...
+func (k *KogitoServiceSpec) SetEnableIstio(enableIstio bool) {
+	k.EnableIstio = enableIstio
+}
+
+// GetIstio ...
+func (k *KogitoServiceSpec) GetIstio() api.IstioInterface {
+	if k.Istio == nil {
+		return nil
+	}
+	return k.Istio
+}
+
+// SetIstio ...
+func (k *KogitoServiceSpec) SetIstio(istio api.IstioInterface) {
+	if newIstio, ok := istio.(*Istio); ok {
+		k.Istio = newIstio
+	}
+}
+
+// GetTLS ...
+func (k *KogitoServiceSpec) GetTLS() api.TLSInterface {
+	if k.TLS == nil {
+		return nil
+	}
+	return k.TLS
+}
+
+// SetTLS ...
+func (k *KogitoServiceSpec) SetTLS(tls api.TLSInterface) {
+	if newTLS, ok := tls.(*TLS); ok {
+		k.TLS = newTLS
+	}
+}
+
+// GetKafkaTopics ...
+func (k *KogitoServiceSpec) GetKafkaTopics() []api.KafkaTopicInterface {
+	kafkaTopics := make([]api.KafkaTopicInterface, len(k.KafkaTopics))
+	for i, v := range k.KafkaTopics {
+		item := v
+		kafkaTopics[i] = &item
+	}
+	return kafkaTopics
+}
+
+// SetKafkaTopics ...
+func (k *KogitoServiceSpec) SetKafkaTopics(kafkaTopics []api.KafkaTopicInterface) {
+	var newKafkaTopics []KafkaTopic
+	for _, kafkaTopic := range kafkaTopics {
+		if newKafkaTopic, ok := kafkaTopic.(*KafkaTopic); ok {
+			newKafkaTopics = append(newKafkaTopics, *newKafkaTopic)
+		}
+	}
+	k.KafkaTopics = newKafkaTopics
+}
+
+// IsAutoscalingEnabled ...
+func (k *KogitoServiceSpec) IsAutoscalingEnabled() bool {
+	return k.Autoscaling != nil
+}
+
 // GetEnvs ...
 func (k *KogitoServiceSpec) GetEnvs() []corev1.EnvVar { return k.Env }
 
@@ -356,6 +551,35 @@ func (k *KogitoServiceSpec) SetDeploymentLabels(labels map[string]string) {
 	k.DeploymentLabels = labels
 }
 
+// GetPodTemplate ...
+func (k *KogitoServiceSpec) GetPodTemplate() *corev1.PodTemplateSpec { return k.PodTemplate }
+
+// SetPodTemplate ...
+func (k *KogitoServiceSpec) SetPodTemplate(podTemplate *corev1.PodTemplateSpec) {
+	k.PodTemplate = podTemplate
+}
+
+// GetVolumes ...
+func (k *KogitoServiceSpec) GetVolumes() []api.VolumeInterface {
+	volumes := make([]api.VolumeInterface, len(k.Volumes))
+	for i, v := range k.Volumes {
+		item := v
+		volumes[i] = &item
+	}
+	return volumes
+}
+
+// SetVolumes ...
+func (k *KogitoServiceSpec) SetVolumes(volumes []api.VolumeInterface) {
+	var newVolumes []Volume
+	for _, volume := range volumes {
+		if newVolume, ok := volume.(*Volume); ok {
+			newVolumes = append(newVolumes, *newVolume)
+		}
+	}
+	k.Volumes = newVolumes
+}
+
 // AddDeploymentLabel adds new deployment label. Works also on uninitialized DeploymentLabels field.
 func (k *KogitoServiceSpec) AddDeploymentLabel(name, value string) {
 	if k.DeploymentLabels == nil {
@@ -435,6 +659,27 @@ func (k *KogitoServiceSpec) SetTrustStoreSecret(trustStoreSecret string) {
 	k.TrustStoreSecret = trustStoreSecret
 }
 
+// GetTrustStoreSources ...
+func (k *KogitoServiceSpec) GetTrustStoreSources() []api.TrustStoreSourceInterface {
+	trustStoreSources := make([]api.TrustStoreSourceInterface, len(k.TrustStoreSources))
+	for i, v := range k.TrustStoreSources {
+		item := v
+		trustStoreSources[i] = &item
+	}
+	return trustStoreSources
+}
+
+// SetTrustStoreSources ...
+func (k *KogitoServiceSpec) SetTrustStoreSources(trustStoreSources []api.TrustStoreSourceInterface) {
+	var newTrustStoreSources []TrustStoreSource
+	for _, trustStoreSource := range trustStoreSources {
+		if newTrustStoreSource, ok := trustStoreSource.(*TrustStoreSource); ok {
+			newTrustStoreSources = append(newTrustStoreSources, *newTrustStoreSource)
+		}
+	}
+	k.TrustStoreSources = newTrustStoreSources
+}
+
 // IsRouteDisabled ...
 func (k *KogitoServiceSpec) IsRouteDisabled() bool {
 	return k.DisableRoute
@@ -444,3 +689,15 @@ func (k *KogitoServiceSpec) IsRouteDisabled() bool {
 func (k *KogitoServiceSpec) SetDisableRoute(disableRoute bool) {
 	k.DisableRoute = disableRoute
 }
+
+// GetIngress ...
+func (k *KogitoServiceSpec) GetIngress() api.IngressInterface {
+	return &k.Ingress
+}
+
+// SetIngress ...
+func (k *KogitoServiceSpec) SetIngress(ingress api.IngressInterface) {
+	if newIngress, ok := ingress.(*Ingress); ok {
+		k.Ingress = *newIngress
+	}
+}
@@ -52,9 +52,26 @@ func (k *KogitoSupportingServiceSpec) SetServiceType(serviceType api.ServiceType
 // +k8s:openapi-gen=true
 type KogitoSupportingServiceStatus struct {
 	KogitoServiceStatus `json:",inline"`
+
+	// Persistence backend used by the service, selected from the bound KogitoInfra resources.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Persistence Backend"
+	PersistenceBackend api.PersistenceBackendType `json:"persistenceBackend,omitempty"`
+}
+
+// GetPersistenceBackend ...
+func (k *KogitoSupportingServiceStatus) GetPersistenceBackend() api.PersistenceBackendType {
+	return k.PersistenceBackend
+}
+
+// SetPersistenceBackend ...
+func (k *KogitoSupportingServiceStatus) SetPersistenceBackend(persistenceBackend api.PersistenceBackendType) {
+	k.PersistenceBackend = persistenceBackend
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +k8s:openapi-gen=true
 // +genclient
 // +kubebuilder:resource:path=kogitosupportingservices,scope=Namespaced
@@ -0,0 +1,54 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import networkingv1 "k8s.io/api/networking/v1"
+
+// NetworkPolicy defines the NetworkPolicy managed by the operator to restrict the traffic of a Kogito service.
+type NetworkPolicy struct {
+	// Enables the NetworkPolicy creation. The service will only accept connections from the Kogito services depending on it,
+	// e.g. the consoles, and will only connect to its KogitoInfra resources and to the supporting services it depends on.
+	// On OpenShift, connections from the router are also accepted. Defaults to false.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Additional sources allowed to connect to the service, e.g. the namespace of the Ingress controller or of Prometheus.
+	// +optional
+	// +listType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	IngressFrom []networkingv1.NetworkPolicyPeer `json:"ingressFrom,omitempty"`
+}
+
+// IsEnabled ...
+func (n *NetworkPolicy) IsEnabled() bool {
+	return n.Enabled
+}
+
+// SetEnabled ...
+func (n *NetworkPolicy) SetEnabled(enabled bool) {
+	n.Enabled = enabled
+}
+
+// GetIngressFrom ...
+func (n *NetworkPolicy) GetIngressFrom() []networkingv1.NetworkPolicyPeer {
+	return n.IngressFrom
+}
+
+// SetIngressFrom ...
+func (n *NetworkPolicy) SetIngressFrom(ingressFrom []networkingv1.NetworkPolicyPeer) {
+	n.IngressFrom = ingressFrom
+}
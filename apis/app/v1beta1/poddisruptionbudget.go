@@ -0,0 +1,67 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import "k8s.io/apimachinery/pkg/util/intstr"
+
+// PodDisruptionBudget defines the PodDisruptionBudget managed by the operator for services running more than one replica.
+type PodDisruptionBudget struct {
+	// Disables the PodDisruptionBudget creation. Defaults to false.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Number or percentage of pods that must remain available during a voluntary disruption. Can't be set along with MaxUnavailable.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// Number or percentage of pods that can be unavailable during a voluntary disruption. Can't be set along with MinAvailable.
+	// Defaults to 1 when MinAvailable is not set.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// IsDisabled ...
+func (p *PodDisruptionBudget) IsDisabled() bool {
+	return p.Disabled
+}
+
+// SetDisabled ...
+func (p *PodDisruptionBudget) SetDisabled(disabled bool) {
+	p.Disabled = disabled
+}
+
+// GetMinAvailable ...
+func (p *PodDisruptionBudget) GetMinAvailable() *intstr.IntOrString {
+	return p.MinAvailable
+}
+
+// SetMinAvailable ...
+func (p *PodDisruptionBudget) SetMinAvailable(minAvailable *intstr.IntOrString) {
+	p.MinAvailable = minAvailable
+}
+
+// GetMaxUnavailable ...
+func (p *PodDisruptionBudget) GetMaxUnavailable() *intstr.IntOrString {
+	return p.MaxUnavailable
+}
+
+// SetMaxUnavailable ...
+func (p *PodDisruptionBudget) SetMaxUnavailable(maxUnavailable *intstr.IntOrString) {
+	p.MaxUnavailable = maxUnavailable
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	api "github.com/kiegroup/kogito-operator/apis"
+)
+
+// SupportingServiceReference points a KogitoRuntime to a Kogito Supporting Service deployed in another namespace.
+type SupportingServiceReference struct {
+	// Type of the referenced Supporting Service.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Service Type"
+	// +kubebuilder:validation:Enum=DataIndex;JobsService;TrustyAI
+	ServiceType api.ServiceType `json:"serviceType"`
+
+	// Namespace where the Supporting Service is deployed.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace"
+	Namespace string `json:"namespace"`
+}
+
+// GetServiceType ...
+func (s *SupportingServiceReference) GetServiceType() api.ServiceType {
+	return s.ServiceType
+}
+
+// SetServiceType ...
+func (s *SupportingServiceReference) SetServiceType(serviceType api.ServiceType) {
+	s.ServiceType = serviceType
+}
+
+// GetNamespace ...
+func (s *SupportingServiceReference) GetNamespace() string {
+	return s.Namespace
+}
+
+// SetNamespace ...
+func (s *SupportingServiceReference) SetNamespace(namespace string) {
+	s.Namespace = namespace
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import api "github.com/kiegroup/kogito-operator/apis"
+
+const (
+	// defaultIssuerKind is the kind of the cert-manager issuer used when not set
+	defaultIssuerKind = "Issuer"
+)
+
+// TLS defines the serving certificate of a service serving HTTPS.
+// The operator mounts the certificate in the pods, switches the probes, the Service, the Route and the ServiceMonitor to HTTPS
+// and adds the CA of the certificate to the truststore of the service, unless a custom TrustStoreSecret is set.
+type TLS struct {
+	// Provider of the serving certificate. OpenShift requests it to the OpenShift service CA,
+	// CertManager requests it to the cert-manager issuer set in IssuerName.
+	//
+	// Default value: CertManager when IssuerName is set, OpenShift otherwise
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Provider"
+	// +kubebuilder:validation:Enum=OpenShift;CertManager
+	Provider api.CertificateProviderType `json:"provider,omitempty"`
+
+	// Name of the cert-manager issuer signing the serving certificate. Required by the CertManager provider.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Issuer Name"
+	IssuerName string `json:"issuerName,omitempty"`
+
+	// Kind of the cert-manager issuer signing the serving certificate.
+	//
+	// Default value: Issuer
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Issuer Kind"
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	IssuerKind string `json:"issuerKind,omitempty"`
+}
+
+// GetProvider ...
+func (t *TLS) GetProvider() api.CertificateProviderType {
+	if len(t.Provider) > 0 {
+		return t.Provider
+	}
+	if len(t.IssuerName) > 0 {
+		return api.CertManagerCertificateProvider
+	}
+	return api.OpenShiftCertificateProvider
+}
+
+// SetProvider ...
+func (t *TLS) SetProvider(provider api.CertificateProviderType) {
+	t.Provider = provider
+}
+
+// GetIssuerName ...
+func (t *TLS) GetIssuerName() string {
+	return t.IssuerName
+}
+
+// SetIssuerName ...
+func (t *TLS) SetIssuerName(issuerName string) {
+	t.IssuerName = issuerName
+}
+
+// GetIssuerKind ...
+func (t *TLS) GetIssuerKind() string {
+	if len(t.IssuerKind) == 0 {
+		return defaultIssuerKind
+	}
+	return t.IssuerKind
+}
+
+// SetIssuerKind ...
+func (t *TLS) SetIssuerKind(issuerKind string) {
+	t.IssuerKind = issuerKind
+}
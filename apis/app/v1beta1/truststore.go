@@ -0,0 +1,85 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import api "github.com/kiegroup/kogito-operator/apis"
+
+const (
+	// defaultConfigMapTrustStoreKey is the key of the CA bundle injected by OpenShift in the ConfigMaps labeled with config.openshift.io/inject-trusted-cabundle
+	defaultConfigMapTrustStoreKey = "ca-bundle.crt"
+	// defaultSecretTrustStoreKey is the key of the CA certificate in the Secrets storing cert-manager Certificates
+	defaultSecretTrustStoreKey = "ca.crt"
+)
+
+// TrustStoreSource defines a resource holding PEM encoded CA certificates trusted by the service.
+// The operator builds the PKCS12 truststore of the service from the given sources and rolls out the pods when their content changes.
+type TrustStoreSource struct {
+	// Kind of the resource holding the CA certificates. Certificate reads the CA of the issuer of the given cert-manager Certificate.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=ConfigMap;Secret;Certificate
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kind"
+	Kind api.TrustStoreSourceKind `json:"kind"`
+
+	// Name of the resource in the namespace of the service.
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name"
+	Name string `json:"name"`
+
+	// Key holding the PEM encoded CA certificates.
+	//
+	// Default value: ca-bundle.crt for ConfigMaps, ca.crt for Secrets and Certificates
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key"
+	Key string `json:"key,omitempty"`
+}
+
+// GetKind ...
+func (t *TrustStoreSource) GetKind() api.TrustStoreSourceKind {
+	return t.Kind
+}
+
+// SetKind ...
+func (t *TrustStoreSource) SetKind(kind api.TrustStoreSourceKind) {
+	t.Kind = kind
+}
+
+// GetName ...
+func (t *TrustStoreSource) GetName() string {
+	return t.Name
+}
+
+// SetName ...
+func (t *TrustStoreSource) SetName(name string) {
+	t.Name = name
+}
+
+// GetKey ...
+func (t *TrustStoreSource) GetKey() string {
+	if len(t.Key) > 0 {
+		return t.Key
+	}
+	if t.Kind == api.ConfigMapTrustStoreSource {
+		return defaultConfigMapTrustStoreKey
+	}
+	return defaultSecretTrustStoreKey
+}
+
+// SetKey ...
+func (t *TrustStoreSource) SetKey(key string) {
+	t.Key = key
+}
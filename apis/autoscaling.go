@@ -0,0 +1,41 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "k8s.io/apimachinery/pkg/api/resource"
+
+const (
+	// AutoscalingDefaultMinReplicas is the lower limit of replicas used when none is provided
+	AutoscalingDefaultMinReplicas = int32(1)
+)
+
+// AutoscalingInterface defines how a Kogito service is scaled by a HorizontalPodAutoscaler.
+type AutoscalingInterface interface {
+	GetMinReplicas() *int32
+	SetMinReplicas(minReplicas *int32)
+	GetMaxReplicas() int32
+	SetMaxReplicas(maxReplicas int32)
+	GetTargetCPUUtilizationPercentage() *int32
+	SetTargetCPUUtilizationPercentage(target *int32)
+	GetTargetMemoryUtilizationPercentage() *int32
+	SetTargetMemoryUtilizationPercentage(target *int32)
+	GetMetrics() []AutoscalingMetricInterface
+}
+
+// AutoscalingMetricInterface defines a custom per pod metric used to scale a Kogito service.
+type AutoscalingMetricInterface interface {
+	GetName() string
+	GetTargetAverageValue() resource.Quantity
+}
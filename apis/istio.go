@@ -0,0 +1,71 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// IstioTLSModeType defines the TLS mode of the connections to a Kogito service within the mesh.
+type IstioTLSModeType string
+
+const (
+	// IstioDisableTLSMode doesn't setup a TLS connection to the service
+	IstioDisableTLSMode IstioTLSModeType = "DISABLE"
+	// IstioSimpleTLSMode originates a TLS connection to the service
+	IstioSimpleTLSMode IstioTLSModeType = "SIMPLE"
+	// IstioMutualTLSMode secures the connections to the service using mutual TLS with client certificates
+	IstioMutualTLSMode IstioTLSModeType = "MUTUAL"
+	// IstioMeshMutualTLSMode secures the connections to the service using mutual TLS with the certificates generated by Istio
+	IstioMeshMutualTLSMode IstioTLSModeType = "ISTIO_MUTUAL"
+)
+
+// IstioInterface defines the Istio resources managed by the operator for a Kogito service in the mesh.
+type IstioInterface interface {
+	GetTLSMode() IstioTLSModeType
+	SetTLSMode(tlsMode IstioTLSModeType)
+	GetConnectionPool() IstioConnectionPoolInterface
+	SetConnectionPool(connectionPool IstioConnectionPoolInterface)
+	GetOutlierDetection() IstioOutlierDetectionInterface
+	SetOutlierDetection(outlierDetection IstioOutlierDetectionInterface)
+	GetGateway() IstioGatewayInterface
+	SetGateway(gateway IstioGatewayInterface)
+}
+
+// IstioConnectionPoolInterface defines the limits of the connections to a Kogito service.
+type IstioConnectionPoolInterface interface {
+	GetMaxConnections() int32
+	SetMaxConnections(maxConnections int32)
+	GetHTTP1MaxPendingRequests() int32
+	SetHTTP1MaxPendingRequests(http1MaxPendingRequests int32)
+	GetMaxRequestsPerConnection() int32
+	SetMaxRequestsPerConnection(maxRequestsPerConnection int32)
+}
+
+// IstioOutlierDetectionInterface defines how the unhealthy pods of a Kogito service are ejected from the load balancing pool.
+type IstioOutlierDetectionInterface interface {
+	GetConsecutive5xxErrors() int32
+	SetConsecutive5xxErrors(consecutive5xxErrors int32)
+	GetInterval() string
+	SetInterval(interval string)
+	GetBaseEjectionTime() string
+	SetBaseEjectionTime(baseEjectionTime string)
+	GetMaxEjectionPercent() int32
+	SetMaxEjectionPercent(maxEjectionPercent int32)
+}
+
+// IstioGatewayInterface defines the Istio Gateway exposing a Kogito service out of the mesh.
+type IstioGatewayInterface interface {
+	GetHosts() []string
+	SetHosts(hosts []string)
+	GetSelector() map[string]string
+	SetSelector(selector map[string]string)
+}
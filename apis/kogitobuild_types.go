@@ -32,6 +32,16 @@ const (
 	LocalSourceBuildType KogitoBuildType = "LocalSource"
 )
 
+// KogitoBuildBackendType describes the engines that can run the builds of a KogitoBuild CR
+type KogitoBuildBackendType string
+
+const (
+	// OpenShiftBuildBackend runs the builds with OpenShift BuildConfigs and ImageStreams.
+	OpenShiftBuildBackend KogitoBuildBackendType = "OpenShift"
+	// TektonBuildBackend runs the builds with Tekton PipelineRuns and pushes the final image to an external registry.
+	TektonBuildBackend KogitoBuildBackendType = "Tekton"
+)
+
 // KogitoBuildConditionType ...
 type KogitoBuildConditionType string
 
@@ -118,6 +128,12 @@ type KogitoBuildSpecInterface interface {
 	SetArtifact(artifact ArtifactInterface)
 	IsEnableMavenDownloadOutput() bool
 	SetEnableMavenDownloadOutput(enableMavenDownloadOutput bool)
+	GetBackend() KogitoBuildBackendType
+	SetBackend(backend KogitoBuildBackendType)
+	GetRegistry() ImageRegistryInterface
+	SetRegistry(registry ImageRegistryInterface)
+	GetSourceVolumeClaim() string
+	SetSourceVolumeClaim(sourceVolumeClaim string)
 }
 
 // KogitoBuildStatusInterface ...
@@ -17,6 +17,7 @@ package api
 import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -44,6 +45,8 @@ const (
 	ResourceNotReady KogitoInfraConditionReason = "ResourceNotReady"
 	// ResourceConfigError related resource is not configured properly
 	ResourceConfigError KogitoInfraConditionReason = "ResourceConfigError"
+	// ResourceAccessDenied the operator is not granted to watch the related resource
+	ResourceAccessDenied KogitoInfraConditionReason = "ResourceAccessDenied"
 	// ResourceMissingResourceConfig related resource is missing a config information to continue
 	ResourceMissingResourceConfig KogitoInfraConditionReason = "ResourceMissingConfig"
 	// ResourceSuccessfullyConfigured ..
@@ -59,6 +62,13 @@ type KogitoInfraInterface interface {
 	GetStatus() KogitoInfraStatusInterface
 }
 
+// KogitoInfraListInterface ...
+type KogitoInfraListInterface interface {
+	runtime.Object
+	// GetItems gets all items
+	GetItems() []KogitoInfraInterface
+}
+
 // KogitoInfraSpecInterface ...
 type KogitoInfraSpecInterface interface {
 	GetResource() ResourceInterface
@@ -70,6 +80,37 @@ type KogitoInfraSpecInterface interface {
 	GetConfigMapVolumeReferences() []VolumeReferenceInterface
 	GetSecretEnvFromReferences() []string
 	GetSecretVolumeReferences() []VolumeReferenceInterface
+	GetKafkaTopics() []KafkaTopicInterface
+	GetExternal() ExternalInfraInterface
+	IsExternalEmpty() bool
+}
+
+// ExternalInfraKind is the kind of infrastructure provided by an endpoint not managed in the cluster
+type ExternalInfraKind string
+
+const (
+	// KafkaExternalInfra Kafka cluster, e.g. a managed Kafka service
+	KafkaExternalInfra ExternalInfraKind = "Kafka"
+	// InfinispanExternalInfra Infinispan server
+	InfinispanExternalInfra ExternalInfraKind = "Infinispan"
+	// MongoDBExternalInfra MongoDB database
+	MongoDBExternalInfra ExternalInfraKind = "MongoDB"
+	// PostgreSQLExternalInfra PostgreSQL database
+	PostgreSQLExternalInfra ExternalInfraKind = "PostgreSQL"
+	// KeycloakExternalInfra Keycloak realm
+	KeycloakExternalInfra ExternalInfraKind = "Keycloak"
+)
+
+// ExternalInfraInterface ...
+type ExternalInfraInterface interface {
+	GetKind() ExternalInfraKind
+	SetKind(kind ExternalInfraKind)
+	GetURI() string
+	SetURI(uri string)
+	GetCredentialsSecret() string
+	SetCredentialsSecret(credentialsSecret string)
+	GetTrustStoreSecret() string
+	SetTrustStoreSecret(trustStoreSecret string)
 }
 
 // ResourceInterface ...
@@ -18,6 +18,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeploymentModeType defines the kind of resources a Kogito Runtime is deployed with
+type DeploymentModeType string
+
+const (
+	// DeploymentDeploymentMode deploys the service with a Deployment, exposed through a Service and a Route
+	DeploymentDeploymentMode DeploymentModeType = "Deployment"
+	// KnativeServiceDeploymentMode deploys the service as a Knative Serving Service
+	KnativeServiceDeploymentMode DeploymentModeType = "KnativeService"
+)
+
 // KogitoRuntimeInterface ...
 type KogitoRuntimeInterface interface {
 	KogitoService
@@ -37,8 +47,20 @@ type KogitoRuntimeListInterface interface {
 // KogitoRuntimeSpecInterface ...
 type KogitoRuntimeSpecInterface interface {
 	KogitoServiceSpecInterface
-	IsEnableIstio() bool
-	SetEnableIstio(enableIstio bool)
+	GetRollout() RolloutInterface
+	SetRollout(rollout RolloutInterface)
+	GetDeploymentMode() DeploymentModeType
+	SetDeploymentMode(deploymentMode DeploymentModeType)
+	GetSupportingServiceRefs() []SupportingServiceReferenceInterface
+	SetSupportingServiceRefs(supportingServiceRefs []SupportingServiceReferenceInterface)
+}
+
+// SupportingServiceReferenceInterface ...
+type SupportingServiceReferenceInterface interface {
+	GetServiceType() ServiceType
+	SetServiceType(serviceType ServiceType)
+	GetNamespace() string
+	SetNamespace(namespace string)
 }
 
 // KogitoRuntimeStatusInterface ...
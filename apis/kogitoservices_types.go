@@ -32,6 +32,8 @@ const (
 	ProvisioningConditionType KogitoServiceConditionType = "Provisioning"
 	// FailedConditionType - The KogitoService is in a failed state
 	FailedConditionType KogitoServiceConditionType = "Failed"
+	// RollingOutConditionType - A new image of the KogitoService is deployed next to the current one, waiting to be promoted
+	RollingOutConditionType KogitoServiceConditionType = "RollingOut"
 )
 
 // KogitoService defines the interface for any Kogito service that the operator can handle, e.g. Data Index, Jobs Service, Runtimes, etc.
@@ -54,6 +56,21 @@ type KogitoServiceList interface {
 type KogitoServiceSpecInterface interface {
 	GetReplicas() *int32
 	SetReplicas(replicas int32)
+	GetAutoscaling() AutoscalingInterface
+	IsAutoscalingEnabled() bool
+	GetPodDisruptionBudget() PodDisruptionBudgetInterface
+	SetPodDisruptionBudget(podDisruptionBudget PodDisruptionBudgetInterface)
+	GetNetworkPolicy() NetworkPolicyInterface
+	SetNetworkPolicy(networkPolicy NetworkPolicyInterface)
+	IsNetworkPolicyEnabled() bool
+	IsEnableIstio() bool
+	SetEnableIstio(enableIstio bool)
+	GetIstio() IstioInterface
+	SetIstio(istio IstioInterface)
+	GetTLS() TLSInterface
+	SetTLS(tls TLSInterface)
+	GetKafkaTopics() []KafkaTopicInterface
+	SetKafkaTopics(kafkaTopics []KafkaTopicInterface)
 	GetEnvs() []corev1.EnvVar
 	SetEnvs(envs []corev1.EnvVar)
 	AddEnvironmentVariable(name, value string)
@@ -66,6 +83,10 @@ type KogitoServiceSpecInterface interface {
 	AddResourceLimit(name, value string)
 	GetDeploymentLabels() map[string]string
 	SetDeploymentLabels(labels map[string]string)
+	GetPodTemplate() *corev1.PodTemplateSpec
+	SetPodTemplate(podTemplate *corev1.PodTemplateSpec)
+	GetVolumes() []VolumeInterface
+	SetVolumes(volumes []VolumeInterface)
 	AddDeploymentLabel(name, value string)
 	GetServiceLabels() map[string]string
 	SetServiceLabels(labels map[string]string)
@@ -73,6 +94,8 @@ type KogitoServiceSpecInterface interface {
 	GetRuntime() RuntimeType
 	IsRouteDisabled() bool
 	SetDisableRoute(disableRoute bool)
+	GetIngress() IngressInterface
+	SetIngress(ingress IngressInterface)
 	IsInsecureImageRegistry() bool
 	GetPropertiesConfigMap() string
 	GetInfra() []string
@@ -84,6 +107,8 @@ type KogitoServiceSpecInterface interface {
 	SetProbes(probes KogitoProbeInterface)
 	GetTrustStoreSecret() string
 	SetTrustStoreSecret(trustStore string)
+	GetTrustStoreSources() []TrustStoreSourceInterface
+	SetTrustStoreSources(trustStoreSources []TrustStoreSourceInterface)
 }
 
 // KogitoServiceStatusInterface defines the basic interface for the Kogito Service status.
@@ -38,6 +38,18 @@ const (
 	TrustyUI ServiceType = "TrustyUI"
 )
 
+// PersistenceBackendType defines the storage used by a supporting service to persist its data
+type PersistenceBackendType string
+
+const (
+	// InfinispanPersistenceBackend persists data in Infinispan
+	InfinispanPersistenceBackend PersistenceBackendType = "infinispan"
+	// MongoDBPersistenceBackend persists data in MongoDB
+	MongoDBPersistenceBackend PersistenceBackendType = "mongodb"
+	// PostgreSQLPersistenceBackend persists data in PostgreSQL
+	PostgreSQLPersistenceBackend PersistenceBackendType = "postgresql"
+)
+
 // KogitoSupportingServiceInterface ...
 type KogitoSupportingServiceInterface interface {
 	KogitoService
@@ -57,6 +69,8 @@ type KogitoSupportingServiceSpecInterface interface {
 // KogitoSupportingServiceStatusInterface ...
 type KogitoSupportingServiceStatusInterface interface {
 	KogitoServiceStatusInterface
+	GetPersistenceBackend() PersistenceBackendType
+	SetPersistenceBackend(persistenceBackend PersistenceBackendType)
 }
 
 // KogitoSupportingServiceListInterface ...
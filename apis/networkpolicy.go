@@ -0,0 +1,25 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import networkingv1 "k8s.io/api/networking/v1"
+
+// NetworkPolicyInterface defines the NetworkPolicy restricting the traffic of a Kogito service to the services it's bound to.
+type NetworkPolicyInterface interface {
+	IsEnabled() bool
+	SetEnabled(enabled bool)
+	GetIngressFrom() []networkingv1.NetworkPolicyPeer
+	SetIngressFrom(ingressFrom []networkingv1.NetworkPolicyPeer)
+}
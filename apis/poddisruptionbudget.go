@@ -0,0 +1,27 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "k8s.io/apimachinery/pkg/util/intstr"
+
+// PodDisruptionBudgetInterface defines how many pods of a Kogito service can be evicted at once during voluntary disruptions.
+type PodDisruptionBudgetInterface interface {
+	IsDisabled() bool
+	SetDisabled(disabled bool)
+	GetMinAvailable() *intstr.IntOrString
+	SetMinAvailable(minAvailable *intstr.IntOrString)
+	GetMaxUnavailable() *intstr.IntOrString
+	SetMaxUnavailable(maxUnavailable *intstr.IntOrString)
+}
@@ -69,6 +69,16 @@ type KogitoInfraList struct {
 	Items           []KogitoInfra `json:"items"`
 }
 
+// GetItems ...
+func (k *KogitoInfraList) GetItems() []api.KogitoInfraInterface {
+	models := make([]api.KogitoInfraInterface, len(k.Items))
+	for i, v := range k.Items {
+		item := v
+		models[i] = &item
+	}
+	return models
+}
+
 func init() {
 	SchemeBuilder.Register(&KogitoInfra{}, &KogitoInfraList{})
 }
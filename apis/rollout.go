@@ -0,0 +1,37 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// RolloutType defines how a new image of a KogitoRuntime is rolled out.
+type RolloutType string
+
+const (
+	// RollingUpdateRolloutType replaces the pods of the service with the new image, the default
+	RollingUpdateRolloutType RolloutType = "RollingUpdate"
+	// CanaryRolloutType deploys the new image next to the current one and routes a share of the traffic to it until it's promoted
+	CanaryRolloutType RolloutType = "Canary"
+	// BlueGreenRolloutType deploys the new image next to the current one, without traffic, and switches the traffic to it once promoted
+	BlueGreenRolloutType RolloutType = "BlueGreen"
+)
+
+// RolloutInterface defines the rollout strategy of the new images of a KogitoRuntime.
+type RolloutInterface interface {
+	GetType() RolloutType
+	SetType(rolloutType RolloutType)
+	GetCanaryWeight() int32
+	SetCanaryWeight(canaryWeight int32)
+	GetPromotedImage() string
+	SetPromotedImage(promotedImage string)
+}
@@ -0,0 +1,35 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// CertificateProviderType defines the provider of the serving certificate of a Kogito service.
+type CertificateProviderType string
+
+const (
+	// OpenShiftCertificateProvider requests the serving certificate to the OpenShift service CA through an annotation of the Service
+	OpenShiftCertificateProvider CertificateProviderType = "OpenShift"
+	// CertManagerCertificateProvider requests the serving certificate to cert-manager through a Certificate
+	CertManagerCertificateProvider CertificateProviderType = "CertManager"
+)
+
+// TLSInterface defines the serving certificate of a Kogito service serving HTTPS.
+type TLSInterface interface {
+	GetProvider() CertificateProviderType
+	SetProvider(provider CertificateProviderType)
+	GetIssuerName() string
+	SetIssuerName(issuerName string)
+	GetIssuerKind() string
+	SetIssuerKind(issuerKind string)
+}
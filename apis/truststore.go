@@ -0,0 +1,37 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// TrustStoreSourceKind defines the kind of resource holding PEM encoded CA certificates trusted by a Kogito service.
+type TrustStoreSourceKind string
+
+const (
+	// ConfigMapTrustStoreSource reads the CA certificates from a ConfigMap, like the OpenShift trusted CA bundle injected in ConfigMaps
+	ConfigMapTrustStoreSource TrustStoreSourceKind = "ConfigMap"
+	// SecretTrustStoreSource reads the CA certificates from a Secret
+	SecretTrustStoreSource TrustStoreSourceKind = "Secret"
+	// CertificateTrustStoreSource reads the CA of the issuer of a cert-manager Certificate from the Secret storing the Certificate
+	CertificateTrustStoreSource TrustStoreSourceKind = "Certificate"
+)
+
+// TrustStoreSourceInterface defines a resource holding PEM encoded CA certificates added to the truststore of a Kogito service.
+type TrustStoreSourceInterface interface {
+	GetKind() TrustStoreSourceKind
+	SetKind(kind TrustStoreSourceKind)
+	GetName() string
+	SetName(name string)
+	GetKey() string
+	SetKey(key string)
+}
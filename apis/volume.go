@@ -0,0 +1,46 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// VolumeInterface defines a storage volume mounted in the container of a Kogito service.
+type VolumeInterface interface {
+	GetName() string
+	SetName(name string)
+	GetMountPath() string
+	SetMountPath(mountPath string)
+	IsReadOnly() bool
+	SetReadOnly(readOnly bool)
+	GetClaimName() string
+	SetClaimName(claimName string)
+	GetClaimTemplate() VolumeClaimTemplateInterface
+	SetClaimTemplate(claimTemplate VolumeClaimTemplateInterface)
+	GetEmptyDir() *corev1.EmptyDirVolumeSource
+	SetEmptyDir(emptyDir *corev1.EmptyDirVolumeSource)
+}
+
+// VolumeClaimTemplateInterface defines the PersistentVolumeClaim created by the operator for a volume of a Kogito service.
+type VolumeClaimTemplateInterface interface {
+	GetSize() resource.Quantity
+	SetSize(size resource.Quantity)
+	GetStorageClassName() *string
+	SetStorageClassName(storageClassName *string)
+	GetAccessModes() []corev1.PersistentVolumeAccessMode
+	SetAccessModes(accessModes []corev1.PersistentVolumeAccessMode)
+}
@@ -64,10 +64,10 @@ func (i runtimeService) InstallRuntimeService(cli *client.Client, flags *flag.Ru
 			Namespace: flags.Project,
 		},
 		Spec: v1beta1.KogitoRuntimeSpec{
-			EnableIstio: flags.EnableIstio,
-			Runtime:     converter.FromRuntimeFlagsToRuntimeType(&flags.RuntimeTypeFlags),
+			Runtime: converter.FromRuntimeFlagsToRuntimeType(&flags.RuntimeTypeFlags),
 			KogitoServiceSpec: v1beta1.KogitoServiceSpec{
 				Replicas:              &flags.Replicas,
+				EnableIstio:           flags.EnableIstio,
 				Env:                   converter.FromStringArrayToEnvs(flags.Env, flags.SecretEnv),
 				Image:                 flags.ImageFlags.Image,
 				Resources:             converter.FromPodResourceFlagsToResourceRequirement(&flags.PodResourceFlags),
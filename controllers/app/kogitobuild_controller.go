@@ -30,6 +30,7 @@ import (
 //+kubebuilder:rbac:groups=apps,resources=deployments/finalizers,verbs=update
 //+kubebuilder:rbac:groups=build.openshift.io,resources=builds;buildconfigs,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams;imagestreamtags,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=tekton.dev,resources=pipelineruns,verbs=get;create;list;watch;delete;update
 
 // NewKogitoBuildReconciler ...
 func NewKogitoBuildReconciler(client *client.Client, scheme *runtime.Scheme) *common.KogitoBuildReconciler {
@@ -38,6 +39,7 @@ func NewKogitoBuildReconciler(client *client.Client, scheme *runtime.Scheme) *co
 		Scheme:            scheme,
 		Version:           app2.Version,
 		BuildHandler:      app.NewKogitoBuildHandler,
+		RuntimeHandler:    app.NewKogitoRuntimeHandler,
 		ReconcilingObject: &v1beta1.KogitoBuild{},
 	}
 }
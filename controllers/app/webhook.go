@@ -0,0 +1,37 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/controllers/common"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+//+kubebuilder:webhook:path=/mutate-app-kiegroup-org-v1beta1-kogitoruntime,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitoruntimes,verbs=create;update,versions=v1beta1,name=mkogitoruntime.app.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/validate-app-kiegroup-org-v1beta1-kogitoruntime,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitoruntimes,verbs=create;update,versions=v1beta1,name=vkogitoruntime.app.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/mutate-app-kiegroup-org-v1beta1-kogitobuild,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitobuilds,verbs=create;update,versions=v1beta1,name=mkogitobuild.app.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/validate-app-kiegroup-org-v1beta1-kogitobuild,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitobuilds,verbs=create;update,versions=v1beta1,name=vkogitobuild.app.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/mutate-app-kiegroup-org-v1beta1-kogitoinfra,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitoinfras,verbs=create;update,versions=v1beta1,name=mkogitoinfra.app.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/validate-app-kiegroup-org-v1beta1-kogitoinfra,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitoinfras,verbs=create;update,versions=v1beta1,name=vkogitoinfra.app.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/mutate-app-kiegroup-org-v1beta1-kogitosupportingservice,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitosupportingservices,verbs=create;update,versions=v1beta1,name=mkogitosupportingservice.app.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/validate-app-kiegroup-org-v1beta1-kogitosupportingservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitosupportingservices,verbs=create;update,versions=v1beta1,name=vkogitosupportingservice.app.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+
+// SetupWebhooksWithManager registers the admission webhooks and the v1beta1 <-> v1 conversion webhook for the app.kiegroup.org resources
+func SetupWebhooksWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register("/convert", &conversion.Webhook{})
+	return common.SetupWebhooksWithManager(mgr, &v1beta1.KogitoRuntime{}, &v1beta1.KogitoBuild{}, &v1beta1.KogitoInfra{}, &v1beta1.KogitoSupportingService{})
+}
@@ -20,9 +20,11 @@ import (
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	tektonv1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/tekton/v1beta1"
 	"github.com/kiegroup/kogito-operator/core/kogitobuild"
 	"github.com/kiegroup/kogito-operator/core/logger"
 	"github.com/kiegroup/kogito-operator/core/manager"
+	"github.com/kiegroup/kogito-operator/core/metrics"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	buildv1 "github.com/openshift/api/build/v1"
 	imagev1 "github.com/openshift/api/image/v1"
@@ -46,6 +48,7 @@ type KogitoBuildReconciler struct {
 	Scheme            *runtime.Scheme
 	Version           string
 	BuildHandler      func(context kogitobuild.BuildContext) manager.KogitoBuildHandler
+	RuntimeHandler    func(context operator.Context) manager.KogitoRuntimeHandler
 	ReconcilingObject client.Object
 	Labels            map[string]string
 }
@@ -74,6 +77,7 @@ func (r *KogitoBuildReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return
 	} else if instance == nil {
 		log.Warn("Kogito Build not found")
+		metrics.DeleteKogitoBuild(req.Namespace, req.Name)
 		return
 	}
 
@@ -88,16 +92,22 @@ func (r *KogitoBuildReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if len(instance.GetSpec().GetTargetKogitoRuntime()) == 0 {
 		instance.GetSpec().SetTargetKogitoRuntime(instance.GetName())
 	}
-
-	// create the Kogito Image Streams to build the service if needed
-	buildImageHandler := kogitobuild.NewImageSteamHandler(buildContext)
-	created, resultErr := buildImageHandler.CreateRequiredKogitoImageStreams(instance)
-	if resultErr != nil {
-		return result, fmt.Errorf("Error while creating Kogito ImageStreams: %s ", resultErr)
+	if len(instance.GetSpec().GetBackend()) == 0 {
+		instance.GetSpec().SetBackend(kogitobuild.GetDefaultBackend(r.Client))
 	}
-	if created {
-		result = reconcile.Result{RequeueAfter: imageStreamCreationReconcileTimeout, Requeue: true}
-		return
+
+	// create the Kogito Image Streams to build the service if needed, Tekton builds pull the images straight from the registry
+	if instance.GetSpec().GetBackend() != api.TektonBuildBackend {
+		buildImageHandler := kogitobuild.NewImageSteamHandler(buildContext)
+		var created bool
+		created, resultErr = buildImageHandler.CreateRequiredKogitoImageStreams(instance)
+		if resultErr != nil {
+			return result, fmt.Errorf("Error while creating Kogito ImageStreams: %s ", resultErr)
+		}
+		if created {
+			result = reconcile.Result{RequeueAfter: imageStreamCreationReconcileTimeout, Requeue: true}
+			return result, nil
+		}
 	}
 
 	// get the build manager to start the reconciliation logic
@@ -105,7 +115,14 @@ func (r *KogitoBuildReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if resultErr != nil {
 		return
 	}
-	resultErr = deltaProcessor.ProcessDelta()
+	if resultErr = deltaProcessor.ProcessDelta(); resultErr != nil {
+		return
+	}
+
+	// without ImageStream triggers, the images built by Tekton are deployed by setting them in the target KogitoRuntime
+	if instance.GetSpec().GetBackend() == api.TektonBuildBackend {
+		resultErr = kogitobuild.DeployBuiltImage(buildContext, instance, r.RuntimeHandler(buildContext.Context))
+	}
 	return
 }
 
@@ -115,5 +132,8 @@ func (r *KogitoBuildReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.IsOpenshift() {
 		b.Owns(&buildv1.BuildConfig{}).Owns(&imagev1.ImageStream{})
 	}
+	if r.HasServerGroup(tektonv1beta1.GroupVersion.Group) {
+		b.Owns(&tektonv1beta1.PipelineRun{})
+	}
 	return b.Complete(r)
 }
@@ -17,19 +17,30 @@ package common
 import (
 	"context"
 	"reflect"
+	"sync"
 
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/kogitoinfra"
 	"github.com/kiegroup/kogito-operator/core/logger"
 	"github.com/kiegroup/kogito-operator/core/manager"
+	"github.com/kiegroup/kogito-operator/core/metrics"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -40,6 +51,10 @@ type KogitoInfraReconciler struct {
 	Version           string
 	InfraHandler      func(context operator.Context) manager.KogitoInfraHandler
 	ReconcilingObject client.Object
+	// controller is used to watch the resources declared by the infra providers once they're referenced
+	controller controller.Controller
+	// providedResourceKinds holds the group version kinds of the resources declared by infra providers already watched
+	providedResourceKinds sync.Map
 }
 
 //+kubebuilder:rbac:groups=app.kiegroup.org,resources=kogitoinfras,verbs=get;list;watch;create;update;patch;delete
@@ -49,12 +64,14 @@ type KogitoInfraReconciler struct {
 //+kubebuilder:rbac:groups=infinispan.org,resources=infinispans,verbs=get;create;list;delete;watch
 //+kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkas;kafkatopics,verbs=get;create;list;delete;watch
 //+kubebuilder:rbac:groups=keycloak.org,resources=keycloaks,verbs=get;create;list;delete;watch
+//+kubebuilder:rbac:groups=keycloak.org,resources=keycloakrealms,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=apps,resources=deployments/finalizers,verbs=update
 //+kubebuilder:rbac:groups=eventing.knative.dev,resources=brokers,verbs=get;list;watch
 //+kubebuilder:rbac:groups=eventing.knative.dev,resources=triggers,verbs=get;list;watch;create;delete;update
 //+kubebuilder:rbac:groups=sources.knative.dev,resources=sinkbindings,verbs=get;list;watch;create;delete;update
 //+kubebuilder:rbac:groups=integreatly.org,resources=grafanadashboards,verbs=get;create;list;watch;create;delete;update
 //+kubebuilder:rbac:groups=mongodbcommunity.mongodb.com,resources=mongodbcommunity,verbs=get;create;list;watch;delete
+//+kubebuilder:rbac:groups=postgres-operator.crunchydata.com,resources=postgresclusters,verbs=get;list;watch
 
 // Reconcile reads that state of the cluster for a KogitoInfra object and makes changes based on the state read
 // and what is in the KogitoInfra.Spec
@@ -78,6 +95,8 @@ func (r *KogitoInfraReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 	if instance == nil {
 		log.Debug("KogitoInfra instance not found")
+		metrics.DeleteKogitoInfra(req.Namespace, req.Name)
+		kogitoinfra.ForgetInfraProvider(req.NamespacedName)
 		return reconcile.Result{}, nil
 	}
 	var resultErr error
@@ -91,7 +110,7 @@ func (r *KogitoInfraReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	instance.GetStatus().SetSecretVolumeReferences(nil)
 
 	reconcilerHandler := kogitoinfra.NewReconcilerHandler(kogitoContext)
-	if !instance.GetSpec().IsResourceEmpty() {
+	if !instance.GetSpec().IsResourceEmpty() || !instance.GetSpec().IsExternalEmpty() {
 		var reconciler kogitoinfra.Reconciler
 		reconciler, resultErr = reconcilerHandler.GetInfraReconciler(instance)
 		if resultErr != nil {
@@ -102,6 +121,9 @@ func (r *KogitoInfraReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		if resultErr != nil {
 			return reconcilerHandler.GetReconcileResultFor(resultErr, false)
 		}
+		if resultErr = r.watchProvidedResource(kogitoContext, instance); resultErr != nil {
+			return reconcilerHandler.GetReconcileResultFor(resultErr, false)
+		}
 	}
 
 	appConfigMapReconciler := reconcilerHandler.GetInfraPropertiesReconciler(instance)
@@ -140,7 +162,96 @@ func (r *KogitoInfraReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	b = kogitoinfra.AppendKafkaWatchedObjects(b)
 	b = kogitoinfra.AppendKeycloakWatchedObjects(b)
 	b = kogitoinfra.AppendMongoDBWatchedObjects(b)
+	b = kogitoinfra.AppendPostgreSQLWatchedObjects(b)
 	b = kogitoinfra.AppendConfigMapWatchedObjects(b)
 	b = kogitoinfra.AppendSecretWatchedObjects(b)
-	return b.Complete(r)
+	// the credentials and the CA certificates of the external endpoints are copied for the services, rotations are picked up along with them
+	b.Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.mapExternalInfraSecret))
+	// infra providers can be edited at any time, the properties rendered from them must follow
+	b.Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(r.mapInfraProvider))
+	c, err := b.Build(r)
+	if err != nil {
+		return err
+	}
+	r.controller = c
+	return nil
+}
+
+// watchProvidedResource watches the kind of the resource referenced by the KogitoInfra if it's declared by an infra provider.
+// These kinds are only known at runtime, so the watches are added by the first reconciliation referencing them.
+// A kind the operator is not granted to watch fails the KogitoInfra, the informer would otherwise retry forever.
+func (r *KogitoInfraReconciler) watchProvidedResource(context operator.Context, instance api.KogitoInfraInterface) error {
+	if r.controller == nil || instance.GetSpec().IsResourceEmpty() || kogitoinfra.IsBuiltInResourceGroup(instance.GetSpec().GetResource().GetAPIVersion()) {
+		return nil
+	}
+	gvk := schema.FromAPIVersionAndKind(instance.GetSpec().GetResource().GetAPIVersion(), instance.GetSpec().GetResource().GetKind())
+	if _, watched := r.providedResourceKinds.Load(gvk); watched {
+		return nil
+	}
+	if err := kogitoinfra.CheckProvidedResourceAccess(context, instance); err != nil {
+		return err
+	}
+	if _, watched := r.providedResourceKinds.LoadOrStore(gvk, true); watched {
+		return nil
+	}
+	resource := &unstructured.Unstructured{}
+	resource.SetGroupVersionKind(gvk)
+	if err := r.controller.Watch(&source.Kind{Type: resource}, handler.EnqueueRequestsFromMapFunc(r.mapProvidedResource)); err != nil {
+		r.providedResourceKinds.Delete(gvk)
+		return err
+	}
+	return nil
+}
+
+// mapInfraProvider enqueues the KogitoInfras referencing a resource declared by the given infra provider ConfigMap
+func (r *KogitoInfraReconciler) mapInfraProvider(object client.Object) []reconcile.Request {
+	configMap, ok := object.(*corev1.ConfigMap)
+	if !ok || configMap.Labels[kogitoinfra.InfraProviderLabelKey] != "true" {
+		return nil
+	}
+	// the providers of the operator namespace are used by every namespace
+	namespace := configMap.Namespace
+	if namespace == infrastructure.GetOperatorNamespace() {
+		namespace = ""
+	}
+	return r.mapKogitoInfras("infra_provider_mapper", namespace, object, func(instance api.KogitoInfraInterface) bool {
+		return kogitoinfra.IsInfraProviderFor(instance, configMap)
+	})
+}
+
+// mapProvidedResource enqueues the KogitoInfras referencing the given resource declared by an infra provider
+func (r *KogitoInfraReconciler) mapProvidedResource(object client.Object) []reconcile.Request {
+	// the KogitoInfras might reference a resource of another namespace
+	return r.mapKogitoInfras("provided_resource_mapper", "", object, func(instance api.KogitoInfraInterface) bool {
+		return kogitoinfra.IsProvidedResourceOf(instance, object)
+	})
+}
+
+// mapKogitoInfras enqueues the KogitoInfras of the namespace, of all namespaces if empty, matching the given predicate
+func (r *KogitoInfraReconciler) mapKogitoInfras(loggerName, namespace string, object client.Object, matches func(instance api.KogitoInfraInterface) bool) []reconcile.Request {
+	log := logger.GetLogger(loggerName)
+	kogitoContext := operator.Context{
+		Client: r.Client,
+		Log:    log,
+		Scheme: r.Scheme,
+	}
+	instances, err := r.InfraHandler(kogitoContext).FetchKogitoInfraList(namespace)
+	if err != nil {
+		log.Error(err, "Failed to fetch the KogitoInfras", "namespace", object.GetNamespace(), "name", object.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, instance := range instances.GetItems() {
+		if matches(instance) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}})
+		}
+	}
+	return requests
+}
+
+// mapExternalInfraSecret enqueues the KogitoInfras describing an external endpoint with the credentials or the CA certificate of the given Secret
+func (r *KogitoInfraReconciler) mapExternalInfraSecret(object client.Object) []reconcile.Request {
+	return r.mapKogitoInfras("external_infra_secret_mapper", object.GetNamespace(), object, func(instance api.KogitoInfraInterface) bool {
+		return kogitoinfra.IsExternalInfraSecret(instance, object)
+	})
 }
@@ -19,6 +19,10 @@ import (
 
 	kogitocli "github.com/kiegroup/kogito-operator/core/client"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	certmanagerv1 "github.com/kiegroup/kogito-operator/core/infrastructure/certmanager/v1"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	servingv1 "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
 	"github.com/kiegroup/kogito-operator/core/kogitoservice"
 	"github.com/kiegroup/kogito-operator/core/logger"
 	"github.com/kiegroup/kogito-operator/core/manager"
@@ -27,13 +31,20 @@ import (
 	imagev1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // KogitoRuntimeReconciler reconciles a KogitoRuntime object
@@ -58,7 +69,11 @@ type KogitoRuntimeReconciler struct {
 //+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams;imagestreamtags,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings;roles,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;create;list;watch;delete;update
-//+kubebuilder:rbac:groups=core,resources=configmaps;events;pods;secrets;serviceaccounts;services,verbs=create;delete;get;list;patch;update;watch
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses;networkpolicies,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=core,resources=configmaps;events;persistentvolumeclaims;pods;secrets;serviceaccounts;services,verbs=create;delete;get;list;patch;update;watch
 
 // Reconcile reads that state of the cluster for a KogitoRuntime object and makes changes based on the state read
 // and what is in the KogitoRuntime.Spec
@@ -85,6 +100,10 @@ func (r *KogitoRuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		log.Debug("KogitoRuntime instance not found")
 		return
 	}
+	if !instance.GetDeletionTimestamp().IsZero() {
+		err = kogitoservice.NewKafkaUserFinalizerHandler(kogitoContext).HandleFinalization(instance)
+		return
+	}
 
 	rbacHandler := infrastructure.NewRBACHandler(kogitoContext)
 	if err = rbacHandler.SetupRBAC(req.Namespace); err != nil {
@@ -94,11 +113,12 @@ func (r *KogitoRuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	supportingServiceHandler := r.SupportServiceHandler(kogitoContext)
 	deploymentHandler := NewRuntimeDeployerHandler(kogitoContext, instance, supportingServiceHandler, runtimeHandler)
 	definition := kogitoservice.ServiceDefinition{
-		Request:            req,
-		DefaultImageTag:    infrastructure.LatestTag,
-		SingleReplica:      false,
-		OnDeploymentCreate: deploymentHandler.OnDeploymentCreate,
-		CustomService:      true,
+		Request:               req,
+		DefaultImageTag:       infrastructure.LatestTag,
+		SingleReplica:         false,
+		OnDeploymentCreate:    deploymentHandler.OnDeploymentCreate,
+		OnNetworkPolicyCreate: deploymentHandler.OnNetworkPolicyCreate,
+		CustomService:         true,
 	}
 	infraHandler := r.InfraHandler(kogitoContext)
 	err = kogitoservice.NewServiceDeployer(kogitoContext, definition, instance, infraHandler).Deploy()
@@ -132,16 +152,65 @@ func (r *KogitoRuntimeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			return false
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return e.ObjectNew.GetDeletionTimestamp().IsZero()
+			// deleted resources are reconciled only to run their finalizers
+			return e.ObjectNew.GetDeletionTimestamp().IsZero() || len(e.ObjectNew.GetFinalizers()) > 0
 		},
 	}
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(r.ReconcilingObject, builder.WithPredicates(pred)).
-		Owns(&corev1.Service{}).Owns(&appsv1.Deployment{}).Owns(&corev1.ConfigMap{})
+		Owns(&corev1.Service{}).Owns(&appsv1.Deployment{}).Owns(&corev1.ConfigMap{}).
+		Owns(&autoscalingv2beta2.HorizontalPodAutoscaler{}).
+		Owns(&policyv1beta1.PodDisruptionBudget{}).Owns(&networkingv1.NetworkPolicy{}).
+		Owns(&corev1.PersistentVolumeClaim{})
 
 	if r.IsOpenshift() {
 		b.Owns(&routev1.Route{}).Owns(&imagev1.ImageStream{})
+	} else {
+		b.Owns(&networkingv1.Ingress{})
+	}
+
+	if r.HasServerGroup(servingv1.GroupVersion.Group) {
+		// runtimes deployed as Knative Services become deployed once their Revisions are ready
+		b.Owns(&servingv1.Service{})
+	}
+
+	if r.HasServerGroup(keycloakv1alpha1.SchemeGroupVersion.Group) {
+		b.Owns(&keycloakv1alpha1.KeycloakClient{})
+	}
+
+	if r.HasServerGroup(v1beta2.SchemeGroupVersion.Group) {
+		b.Owns(&v1beta2.KafkaUser{})
+	}
+
+	if r.HasServerGroup(certmanagerv1.GroupVersion.Group) {
+		b.Owns(&certmanagerv1.Certificate{})
 	}
 
+	// the truststores built from the CA certificates of shared ConfigMaps and Secrets are rotated along with them
+	b.Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(r.mapTrustStoreSource)).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.mapTrustStoreSource))
+
 	return b.Complete(r)
 }
+
+// mapTrustStoreSource enqueues the KogitoRuntimes trusting the CA certificates of the given ConfigMap or Secret
+func (r *KogitoRuntimeReconciler) mapTrustStoreSource(object client.Object) []reconcile.Request {
+	log := logger.GetLogger("truststore_source_mapper")
+	kogitoContext := operator.Context{
+		Client: r.Client,
+		Log:    log,
+		Scheme: r.Scheme,
+	}
+	instances, err := r.RuntimeHandler(kogitoContext).FetchAllKogitoRuntimeInstances(object.GetNamespace())
+	if err != nil {
+		log.Error(err, "Failed to fetch the runtimes trusting the CA certificates", "namespace", object.GetNamespace(), "name", object.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, instance := range instances.GetItems() {
+		if kogitoservice.IsTrustStoreSource(instance, object) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}})
+		}
+	}
+	return requests
+}
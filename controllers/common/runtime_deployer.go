@@ -22,6 +22,7 @@ import (
 	"github.com/kiegroup/kogito-operator/core/manager"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	v1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 )
 
 const (
@@ -32,6 +33,7 @@ const (
 // RuntimeDeployerHandler ...
 type RuntimeDeployerHandler interface {
 	OnDeploymentCreate(deployment *v1.Deployment) error
+	OnNetworkPolicyCreate(networkPolicy *networkingv1.NetworkPolicy) error
 }
 
 type runtimeDeployerHandler struct {
@@ -61,23 +63,13 @@ func (d *runtimeDeployerHandler) OnDeploymentCreate(deployment *v1.Deployment) e
 	}
 	// sa
 	deployment.Spec.Template.Spec.ServiceAccountName = infrastructure.RuntimeServiceAccountName
-	// istio
-	if d.instance.GetRuntimeSpec().IsEnableIstio() {
-		framework.AddIstioInjectSidecarAnnotation(&deployment.Spec.Template.ObjectMeta)
-	}
 
 	urlHandler := connector.NewURLHandler(d.Context, d.runtimeHandler, d.supportingServiceHandler)
-	if err := urlHandler.InjectDataIndexURLIntoDeployment(d.instance.GetNamespace(), deployment); err != nil {
-		return err
-	}
-
-	if err := urlHandler.InjectJobsServiceURLIntoKogitoRuntimeDeployment(d.instance.GetNamespace(), deployment); err != nil {
-		return err
-	}
-
-	if err := urlHandler.InjectTrustyURLIntoDeployment(d.instance.GetNamespace(), deployment); err != nil {
-		return err
-	}
+	return urlHandler.InjectSupportingServicesURLIntoKogitoRuntimeDeployment(d.instance, deployment)
+}
 
-	return nil
+// OnNetworkPolicyCreate allows the connections between the runtime and the supporting services in the NetworkPolicy
+func (d *runtimeDeployerHandler) OnNetworkPolicyCreate(networkPolicy *networkingv1.NetworkPolicy) error {
+	topologyHandler := connector.NewTopologyHandler(d.Context, d.runtimeHandler, d.supportingServiceHandler)
+	return topologyHandler.InjectKogitoRuntimeTopologyIntoNetworkPolicy(d.instance, networkPolicy)
 }
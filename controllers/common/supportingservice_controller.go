@@ -17,8 +17,14 @@ package common
 import (
 	"context"
 
+	"github.com/kiegroup/kogito-operator/apis"
 	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/connector"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	certmanagerv1 "github.com/kiegroup/kogito-operator/core/infrastructure/certmanager/v1"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	"github.com/kiegroup/kogito-operator/core/kogitoservice"
 	"github.com/kiegroup/kogito-operator/core/kogitosupportingservice"
 	"github.com/kiegroup/kogito-operator/core/logger"
 	"github.com/kiegroup/kogito-operator/core/manager"
@@ -27,13 +33,20 @@ import (
 	imgv1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // KogitoSupportingServiceReconciler reconciles a KogitoSupportingService object
@@ -47,6 +60,7 @@ type KogitoSupportingServiceReconciler struct {
 	SupportingServiceHandler func(context operator.Context) manager.KogitoSupportingServiceHandler
 	InfraHandler             func(context operator.Context) manager.KogitoInfraHandler
 	ReconcilingObject        client.Object
+	RuntimeObject            client.Object
 	Labels                   map[string]string
 }
 
@@ -59,7 +73,10 @@ type KogitoSupportingServiceReconciler struct {
 //+kubebuilder:rbac:groups=integreatly.org,resources=grafanadashboards,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams;imagestreamtags,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;create;list;watch;delete;update
-//+kubebuilder:rbac:groups=core,resources=configmaps;events;pods;secrets;services,verbs=create;delete;get;list;patch;update;watch
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses;networkpolicies,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=core,resources=configmaps;events;persistentvolumeclaims;pods;secrets;services,verbs=create;delete;get;list;patch;update;watch
 
 // Reconcile reads that state of the cluster for a KogitoSupportingService object and makes changes based on the state read
 // and what is in the KogitoSupportingService.Spec
@@ -86,6 +103,10 @@ func (r *KogitoSupportingServiceReconciler) Reconcile(ctx context.Context, req c
 		log.Debug("kogitoSupportingService Instance not found")
 		return
 	}
+	if !instance.GetDeletionTimestamp().IsZero() {
+		resultErr = kogitoservice.NewKafkaUserFinalizerHandler(kogitoContext).HandleFinalization(instance)
+		return
+	}
 
 	supportingServiceManager := manager.NewKogitoSupportingServiceManager(kogitoContext, supportingServiceHandler)
 	if resultErr = supportingServiceManager.EnsureSingletonService(req.Namespace, instance.GetSupportingServiceSpec().GetServiceType()); resultErr != nil {
@@ -111,16 +132,117 @@ func (r *KogitoSupportingServiceReconciler) SetupWithManager(mgr ctrl.Manager) e
 			return false
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return e.ObjectNew.GetDeletionTimestamp().IsZero()
+			// deleted resources are reconciled only to run their finalizers
+			return e.ObjectNew.GetDeletionTimestamp().IsZero() || len(e.ObjectNew.GetFinalizers()) > 0
 		},
 	}
 
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(r.ReconcilingObject, builder.WithPredicates(pred)).
-		Owns(&corev1.Service{}).Owns(&appsv1.Deployment{}).Owns(&corev1.ConfigMap{})
+		Owns(&corev1.Service{}).Owns(&appsv1.Deployment{}).Owns(&corev1.ConfigMap{}).
+		Owns(&autoscalingv2beta2.HorizontalPodAutoscaler{}).
+		Owns(&policyv1beta1.PodDisruptionBudget{}).Owns(&networkingv1.NetworkPolicy{}).
+		Owns(&corev1.PersistentVolumeClaim{})
 
 	if r.IsOpenshift() {
 		b.Owns(&routev1.Route{}).Owns(&imgv1.ImageStream{})
+	} else {
+		b.Owns(&networkingv1.Ingress{})
+	}
+
+	if r.HasServerGroup(keycloakv1alpha1.SchemeGroupVersion.Group) {
+		b.Owns(&keycloakv1alpha1.KeycloakClient{})
+	}
+
+	if r.HasServerGroup(v1beta2.SchemeGroupVersion.Group) {
+		b.Owns(&v1beta2.KafkaUser{})
+	}
+
+	if r.HasServerGroup(certmanagerv1.GroupVersion.Group) {
+		b.Owns(&certmanagerv1.Certificate{})
+	}
+
+	// the truststores built from the CA certificates of shared ConfigMaps and Secrets are rotated along with them
+	b.Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(r.mapTrustStoreSource)).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.mapTrustStoreSource))
+
+	// the NetworkPolicies of the supporting services allow the connections from the KogitoRuntimes using them, in any namespace
+	runtimePred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// the supporting services referenced by the KogitoRuntime might have changed
+			return e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration()
+		},
 	}
+	b.Watches(&source.Kind{Type: r.RuntimeObject}, handler.EnqueueRequestsFromMapFunc(r.mapKogitoRuntime), builder.WithPredicates(runtimePred))
+	// as well as the connections from the supporting services of other namespaces falling back to the default ones
+	supportingServicePred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return false
+		},
+	}
+	b.Watches(&source.Kind{Type: r.ReconcilingObject}, handler.EnqueueRequestsFromMapFunc(r.mapDefaultSupportingServices), builder.WithPredicates(supportingServicePred))
+
 	return b.Complete(r)
 }
+
+// mapKogitoRuntime enqueues the KogitoSupportingServices the given KogitoRuntime might use: the ones of its namespace and of the namespaces it references
+func (r *KogitoSupportingServiceReconciler) mapKogitoRuntime(object client.Object) []reconcile.Request {
+	runtime, ok := object.(api.KogitoRuntimeInterface)
+	if !ok {
+		return nil
+	}
+	return r.mapSupportingServices("kogito_runtime_mapper", connector.GetSupportingServiceNamespaces(runtime)...)
+}
+
+// mapDefaultSupportingServices enqueues the KogitoSupportingServices of the default namespace when a supporting service of another namespace is created or deleted
+func (r *KogitoSupportingServiceReconciler) mapDefaultSupportingServices(object client.Object) []reconcile.Request {
+	defaultNamespace := connector.GetDefaultSupportingServicesNamespace()
+	if len(defaultNamespace) == 0 || defaultNamespace == object.GetNamespace() {
+		return nil
+	}
+	return r.mapSupportingServices("default_supporting_service_mapper", defaultNamespace)
+}
+
+// mapSupportingServices enqueues every KogitoSupportingService of the given namespaces
+func (r *KogitoSupportingServiceReconciler) mapSupportingServices(loggerName string, namespaces ...string) []reconcile.Request {
+	log := logger.GetLogger(loggerName)
+	kogitoContext := operator.Context{
+		Client: r.Client,
+		Log:    log,
+		Scheme: r.Scheme,
+	}
+	var requests []reconcile.Request
+	for _, namespace := range namespaces {
+		instances, err := r.SupportingServiceHandler(kogitoContext).FetchKogitoSupportingServiceList(namespace)
+		if err != nil {
+			log.Error(err, "Failed to fetch the supporting services", "namespace", namespace)
+			continue
+		}
+		for _, instance := range instances.GetItems() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}})
+		}
+	}
+	return requests
+}
+
+// mapTrustStoreSource enqueues the KogitoSupportingServices trusting the CA certificates of the given ConfigMap or Secret
+func (r *KogitoSupportingServiceReconciler) mapTrustStoreSource(object client.Object) []reconcile.Request {
+	log := logger.GetLogger("truststore_source_mapper")
+	kogitoContext := operator.Context{
+		Client: r.Client,
+		Log:    log,
+		Scheme: r.Scheme,
+	}
+	instances, err := r.SupportingServiceHandler(kogitoContext).FetchKogitoSupportingServiceList(object.GetNamespace())
+	if err != nil {
+		log.Error(err, "Failed to fetch the supporting services trusting the CA certificates", "namespace", object.GetNamespace(), "name", object.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, instance := range instances.GetItems() {
+		if kogitoservice.IsTrustStoreSource(instance, object) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,35 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// SetupWebhooksWithManager registers the defaulting and validating admission webhooks for the given Kogito resource types
+func SetupWebhooksWithManager(mgr ctrl.Manager, runtime api.KogitoRuntimeInterface, build api.KogitoBuildInterface, infra api.KogitoInfraInterface, supportingService api.KogitoSupportingServiceInterface) error {
+	if err := webhook.Register(mgr, runtime, webhook.DefaultKogitoRuntime, webhook.ValidateKogitoRuntime); err != nil {
+		return err
+	}
+	if err := webhook.Register(mgr, build, webhook.DefaultKogitoBuild, webhook.ValidateKogitoBuild); err != nil {
+		return err
+	}
+	if err := webhook.Register(mgr, infra, webhook.DefaultKogitoInfra, webhook.ValidateKogitoInfra); err != nil {
+		return err
+	}
+	return webhook.Register(mgr, supportingService, webhook.DefaultKogitoSupportingService, webhook.ValidateKogitoSupportingService)
+}
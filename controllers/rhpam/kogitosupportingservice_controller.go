@@ -32,7 +32,14 @@ import (
 //+kubebuilder:rbac:groups=integreatly.org,resources=grafanadashboards,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams;imagestreamtags,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;create;list;watch;delete;update
-//+kubebuilder:rbac:groups=core,resources=configmaps;events;pods;secrets;services,verbs=create;delete;get;list;patch;update;watch
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses;networkpolicies,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=networking.istio.io,resources=destinationrules;gateways;virtualservices,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkausers,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=keycloak.org,resources=keycloakclients,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=core,resources=configmaps;events;persistentvolumeclaims;pods;secrets;services,verbs=create;delete;get;list;patch;update;watch
 
 // NewKogitoSupportingServiceReconciler ...
 func NewKogitoSupportingServiceReconciler(client *kogitocli.Client, scheme *runtime.Scheme) *common.KogitoSupportingServiceReconciler {
@@ -44,6 +51,7 @@ func NewKogitoSupportingServiceReconciler(client *kogitocli.Client, scheme *runt
 		SupportingServiceHandler: rhpam.NewKogitoSupportingServiceHandler,
 		InfraHandler:             rhpam.NewKogitoInfraHandler,
 		ReconcilingObject:        &v1.KogitoSupportingService{},
+		RuntimeObject:            &v1.KogitoRuntime{},
 		Labels:                   getMeteringLabels(),
 	}
 }
@@ -0,0 +1,35 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rhpam
+
+import (
+	v1 "github.com/kiegroup/kogito-operator/apis/rhpam/v1"
+	"github.com/kiegroup/kogito-operator/controllers/common"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+//+kubebuilder:webhook:path=/mutate-rhpam-kiegroup-org-v1-kogitoruntime,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhpam.kiegroup.org,resources=kogitoruntimes,verbs=create;update,versions=v1,name=mkogitoruntime.rhpam.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/validate-rhpam-kiegroup-org-v1-kogitoruntime,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhpam.kiegroup.org,resources=kogitoruntimes,verbs=create;update,versions=v1,name=vkogitoruntime.rhpam.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/mutate-rhpam-kiegroup-org-v1-kogitobuild,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhpam.kiegroup.org,resources=kogitobuilds,verbs=create;update,versions=v1,name=mkogitobuild.rhpam.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/validate-rhpam-kiegroup-org-v1-kogitobuild,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhpam.kiegroup.org,resources=kogitobuilds,verbs=create;update,versions=v1,name=vkogitobuild.rhpam.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/mutate-rhpam-kiegroup-org-v1-kogitoinfra,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhpam.kiegroup.org,resources=kogitoinfras,verbs=create;update,versions=v1,name=mkogitoinfra.rhpam.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/validate-rhpam-kiegroup-org-v1-kogitoinfra,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhpam.kiegroup.org,resources=kogitoinfras,verbs=create;update,versions=v1,name=vkogitoinfra.rhpam.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/mutate-rhpam-kiegroup-org-v1-kogitosupportingservice,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhpam.kiegroup.org,resources=kogitosupportingservices,verbs=create;update,versions=v1,name=mkogitosupportingservice.rhpam.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/validate-rhpam-kiegroup-org-v1-kogitosupportingservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhpam.kiegroup.org,resources=kogitosupportingservices,verbs=create;update,versions=v1,name=vkogitosupportingservice.rhpam.kiegroup.org,admissionReviewVersions={v1,v1beta1}
+
+// SetupWebhooksWithManager registers the admission webhooks for the rhpam.kiegroup.org resources
+func SetupWebhooksWithManager(mgr ctrl.Manager) error {
+	return common.SetupWebhooksWithManager(mgr, &v1.KogitoRuntime{}, &v1.KogitoBuild{}, &v1.KogitoInfra{}, &v1.KogitoSupportingService{})
+}
@@ -18,9 +18,16 @@ import (
 	"context"
 	"github.com/RHsyseng/operator-utils/pkg/resource/write"
 	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 )
 
+// FieldManager is the field manager used by the operator to apply resources with server-side apply.
+// The operator owns only the fields it renders, the fields set by other controllers are left untouched.
+const FieldManager = operator.Name
+
 // ResourceWriter interface to write kubernetes object
 type ResourceWriter interface {
 	// Create creates a new Kubernetes object in the cluster.
@@ -38,6 +45,10 @@ type ResourceWriter interface {
 	UpdateResources(existing []client.Object, resources []client.Object) (bool, error)
 	// DeleteResources delete provided objects
 	DeleteResources(resources []client.Object) (bool, error)
+	// Apply creates or updates the given object with server-side apply, taking ownership of the fields set in it
+	Apply(resource client.Object) error
+	// ApplyResources applies provided objects with server-side apply
+	ApplyResources(resources []client.Object) (bool, error)
 }
 
 // ResourceWriterC provide ResourceWrite reference
@@ -101,3 +112,37 @@ func (r *resourceWriter) DeleteResources(resources []client.Object) (bool, error
 	writer := write.New(r.client.ControlCli)
 	return writer.RemoveResources(resources)
 }
+
+func (r *resourceWriter) Apply(resource client.Object) error {
+	if err := prepareForApply(resource, r.client.ControlCli.Scheme()); err != nil {
+		return err
+	}
+	log.Debug("Applying resource", "kind", resource.GetObjectKind().GroupVersionKind().Kind, "name", resource.GetName(), "namespace", resource.GetNamespace())
+	if err := r.client.ControlCli.Patch(context.TODO(), resource, client.Apply, client.FieldOwner(FieldManager), client.ForceOwnership); err != nil {
+		log.Error(err, "Failed to apply object. ", "name", resource.GetName())
+		return err
+	}
+	return nil
+}
+
+func (r *resourceWriter) ApplyResources(resources []client.Object) (bool, error) {
+	for _, resource := range resources {
+		if err := r.Apply(resource); err != nil {
+			return false, err
+		}
+	}
+	return len(resources) > 0, nil
+}
+
+// prepareForApply turns the given object into an apply configuration: the type must be explicit,
+// while the resource version and the managed fields are owned by the server
+func prepareForApply(resource client.Object, scheme *runtime.Scheme) error {
+	gvk, err := apiutil.GVKForObject(resource, scheme)
+	if err != nil {
+		return err
+	}
+	resource.GetObjectKind().SetGroupVersionKind(gvk)
+	resource.SetResourceVersion("")
+	resource.SetManagedFields(nil)
+	return nil
+}
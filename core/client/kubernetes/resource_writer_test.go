@@ -0,0 +1,66 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// patchRecorder records the patches sent to the server, since the fake client doesn't support server-side apply
+type patchRecorder struct {
+	client.Client
+	patchTypes []types.PatchType
+	options    []client.PatchOptions
+}
+
+func (p *patchRecorder) Patch(_ context.Context, _ client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	options := client.PatchOptions{}
+	options.ApplyOptions(opts)
+	p.patchTypes = append(p.patchTypes, patch.Type())
+	p.options = append(p.options, options)
+	return nil
+}
+
+func Test_ApplyResources(t *testing.T) {
+	cli := &patchRecorder{Client: fake.NewClientBuilder().Build()}
+	resources := []client.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: t.Name(), ResourceVersion: "12"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: t.Name()}},
+	}
+	applied, err := ResourceWriterC(&kogitocli.Client{ControlCli: cli}).ApplyResources(resources)
+	assert.NoError(t, err)
+	assert.True(t, applied)
+	assert.Equal(t, []types.PatchType{types.ApplyPatchType, types.ApplyPatchType}, cli.patchTypes)
+	for _, options := range cli.options {
+		assert.Equal(t, FieldManager, options.FieldManager)
+		assert.True(t, *options.Force)
+	}
+	assert.Empty(t, resources[0].GetResourceVersion())
+	assert.Equal(t, "ConfigMap", resources[0].GetObjectKind().GroupVersionKind().Kind)
+	assert.Equal(t, "v1", resources[1].GetObjectKind().GroupVersionKind().Version)
+
+	applied, err = ResourceWriterC(&kogitocli.Client{ControlCli: cli}).ApplyResources(nil)
+	assert.NoError(t, err)
+	assert.False(t, applied)
+}
@@ -0,0 +1,279 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connector
+
+import (
+	"sort"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/manager"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// runtimeDependencies are the supporting services every KogitoRuntime connects to, see the URLHandler
+var runtimeDependencies = []api.ServiceType{api.DataIndex, api.JobsService, api.TrustyAI}
+
+// runtimeClients are the supporting services connecting to every KogitoRuntime: the consoles and the Jobs Service callbacks
+var runtimeClients = []api.ServiceType{api.MgmtConsole, api.TaskConsole, api.JobsService}
+
+// supportingServiceDependencies are the supporting services each supporting service connects to, see the URLHandler
+var supportingServiceDependencies = map[api.ServiceType][]api.ServiceType{
+	api.MgmtConsole: {api.DataIndex},
+	api.TaskConsole: {api.DataIndex},
+	api.TrustyUI:    {api.TrustyAI},
+}
+
+// TopologyHandler resolves the connections between the Kogito services of a namespace,
+// and with the Supporting services of other namespaces they use, see the URLHandler
+type TopologyHandler interface {
+	InjectKogitoRuntimeTopologyIntoNetworkPolicy(runtime api.KogitoRuntimeInterface, networkPolicy *networkingv1.NetworkPolicy) error
+	InjectSupportingServiceTopologyIntoNetworkPolicy(namespace string, serviceType api.ServiceType, networkPolicy *networkingv1.NetworkPolicy) error
+}
+
+type topologyHandler struct {
+	operator.Context
+	runtimeHandler           manager.KogitoRuntimeHandler
+	supportingServiceHandler manager.KogitoSupportingServiceHandler
+}
+
+// NewTopologyHandler ...
+func NewTopologyHandler(context operator.Context, runtimeHandler manager.KogitoRuntimeHandler, supportingServiceHandler manager.KogitoSupportingServiceHandler) TopologyHandler {
+	return &topologyHandler{
+		Context:                  context,
+		runtimeHandler:           runtimeHandler,
+		supportingServiceHandler: supportingServiceHandler,
+	}
+}
+
+// InjectKogitoRuntimeTopologyIntoNetworkPolicy allows the connections between a KogitoRuntime and the supporting services it uses in its NetworkPolicy:
+// the ones of its namespace and the ones of other namespaces it references or falls back to
+func (t *topologyHandler) InjectKogitoRuntimeTopologyIntoNetworkPolicy(runtime api.KogitoRuntimeInterface, networkPolicy *networkingv1.NetworkPolicy) error {
+	namespace := runtime.GetNamespace()
+	supportingServices, err := t.fetchSupportingServiceNames(namespace)
+	if err != nil {
+		return err
+	}
+	ingressPeers := getSupportingServicePeers(supportingServices, runtimeClients...)
+	egressPeers := getSupportingServicePeers(supportingServices, runtimeDependencies...)
+	for _, serviceType := range runtimeDependencies {
+		supportingService, err := resolveSupportingService(t.Context, t.supportingServiceHandler, namespace, runtime.GetRuntimeSpec().GetSupportingServiceRefs(), serviceType)
+		if err != nil {
+			return err
+		}
+		if supportingService == nil || supportingService.GetNamespace() == namespace {
+			continue
+		}
+		peer := framework.NewNetworkPolicyNamespacedPodPeer(supportingService.GetNamespace(), supportingService.GetName())
+		egressPeers = append(egressPeers, peer)
+		if containsServiceType(runtimeClients, serviceType) {
+			ingressPeers = append(ingressPeers, peer)
+		}
+	}
+	framework.AddNetworkPolicyIngressPeers(networkPolicy, ingressPeers...)
+	framework.AddNetworkPolicyEgressPeers(networkPolicy, egressPeers...)
+	return nil
+}
+
+// InjectSupportingServiceTopologyIntoNetworkPolicy allows the connections between a supporting service and the Kogito services using it or used by it in its NetworkPolicy,
+// including the ones of other namespaces
+func (t *topologyHandler) InjectSupportingServiceTopologyIntoNetworkPolicy(namespace string, serviceType api.ServiceType, networkPolicy *networkingv1.NetworkPolicy) error {
+	supportingServices, err := t.fetchSupportingServiceNames(namespace)
+	if err != nil {
+		return err
+	}
+	var clients []api.ServiceType
+	for clientType, dependencies := range supportingServiceDependencies {
+		if containsServiceType(dependencies, serviceType) {
+			clients = append(clients, clientType)
+		}
+	}
+	ingressPeers := getSupportingServicePeers(supportingServices, clients...)
+	egressPeers := getSupportingServicePeers(supportingServices, supportingServiceDependencies[serviceType]...)
+
+	clientPeers, err := t.fetchSupportingServiceClientPeers(namespace, serviceType, clients)
+	if err != nil {
+		return err
+	}
+	ingressPeers = append(ingressPeers, clientPeers...)
+	dependencyPeers, err := t.fetchSupportingServiceDependencyPeers(namespace, supportingServices, supportingServiceDependencies[serviceType])
+	if err != nil {
+		return err
+	}
+	egressPeers = append(egressPeers, dependencyPeers...)
+
+	isRuntimeDependency := containsServiceType(runtimeDependencies, serviceType)
+	isRuntimeClient := containsServiceType(runtimeClients, serviceType)
+	if isRuntimeDependency || isRuntimeClient {
+		runtimePeers, err := t.fetchKogitoRuntimePeers(namespace, serviceType)
+		if err != nil {
+			return err
+		}
+		if isRuntimeDependency {
+			ingressPeers = append(ingressPeers, runtimePeers...)
+		}
+		if isRuntimeClient {
+			egressPeers = append(egressPeers, runtimePeers...)
+		}
+	}
+	framework.AddNetworkPolicyIngressPeers(networkPolicy, ingressPeers...)
+	framework.AddNetworkPolicyEgressPeers(networkPolicy, egressPeers...)
+	return nil
+}
+
+// fetchSupportingServiceNames gets the sorted names of the supporting services deployed in the given namespace, by type
+func (t *topologyHandler) fetchSupportingServiceNames(namespace string) (map[api.ServiceType][]string, error) {
+	supportingServiceList, err := t.supportingServiceHandler.FetchKogitoSupportingServiceList(namespace)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[api.ServiceType][]string)
+	for _, supportingService := range supportingServiceList.GetItems() {
+		serviceType := supportingService.GetSupportingServiceSpec().GetServiceType()
+		names[serviceType] = append(names[serviceType], supportingService.GetName())
+	}
+	for _, serviceNames := range names {
+		sort.Strings(serviceNames)
+	}
+	return names, nil
+}
+
+// fetchSupportingServiceDependencyPeers gets the peers of the supporting services of other namespaces used by a supporting service of the given namespace,
+// when the namespace has none of their type
+func (t *topologyHandler) fetchSupportingServiceDependencyPeers(namespace string, supportingServices map[api.ServiceType][]string, dependencies []api.ServiceType) ([]networkingv1.NetworkPolicyPeer, error) {
+	var peers []networkingv1.NetworkPolicyPeer
+	for _, dependency := range dependencies {
+		if len(supportingServices[dependency]) > 0 {
+			continue
+		}
+		supportingService, err := resolveSupportingService(t.Context, t.supportingServiceHandler, namespace, nil, dependency)
+		if err != nil {
+			return nil, err
+		}
+		if supportingService != nil && supportingService.GetNamespace() != namespace {
+			peers = append(peers, framework.NewNetworkPolicyNamespacedPodPeer(supportingService.GetNamespace(), supportingService.GetName()))
+		}
+	}
+	return peers, nil
+}
+
+// fetchSupportingServiceClientPeers gets the peers of the supporting services of other namespaces falling back to the supporting service of the given namespace
+func (t *topologyHandler) fetchSupportingServiceClientPeers(namespace string, serviceType api.ServiceType, clients []api.ServiceType) ([]networkingv1.NetworkPolicyPeer, error) {
+	if len(clients) == 0 || GetDefaultSupportingServicesNamespace() != namespace {
+		return nil, nil
+	}
+	supportingServiceList, err := t.supportingServiceHandler.FetchKogitoSupportingServiceList(metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	var namespacedNames []types.NamespacedName
+	for _, supportingService := range supportingServiceList.GetItems() {
+		if supportingService.GetNamespace() == namespace || !containsServiceType(clients, supportingService.GetSupportingServiceSpec().GetServiceType()) {
+			continue
+		}
+		dependency, err := resolveSupportingService(t.Context, t.supportingServiceHandler, supportingService.GetNamespace(), nil, serviceType)
+		if err != nil {
+			return nil, err
+		}
+		if dependency != nil && dependency.GetNamespace() == namespace {
+			namespacedNames = append(namespacedNames, types.NamespacedName{Namespace: supportingService.GetNamespace(), Name: supportingService.GetName()})
+		}
+	}
+	return getNamespacedPodPeers(namespacedNames), nil
+}
+
+// fetchKogitoRuntimePeers gets the peers of the KogitoRuntimes of the given namespace,
+// and of the ones of other namespaces using the supporting service of the given type of the namespace
+func (t *topologyHandler) fetchKogitoRuntimePeers(namespace string, serviceType api.ServiceType) ([]networkingv1.NetworkPolicyPeer, error) {
+	runtimeList, err := t.runtimeHandler.FetchAllKogitoRuntimeInstances(metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	var namespacedNames []types.NamespacedName
+	for _, runtime := range runtimeList.GetItems() {
+		if runtime.GetNamespace() == namespace {
+			names = append(names, runtime.GetName())
+			continue
+		}
+		supportingService, err := resolveSupportingService(t.Context, t.supportingServiceHandler, runtime.GetNamespace(), runtime.GetRuntimeSpec().GetSupportingServiceRefs(), serviceType)
+		if err != nil {
+			return nil, err
+		}
+		if supportingService != nil && supportingService.GetNamespace() == namespace {
+			namespacedNames = append(namespacedNames, types.NamespacedName{Namespace: runtime.GetNamespace(), Name: runtime.GetName()})
+		}
+	}
+	sort.Strings(names)
+	var peers []networkingv1.NetworkPolicyPeer
+	for _, name := range names {
+		peers = append(peers, framework.NewNetworkPolicyPodPeer(name))
+	}
+	return append(peers, getNamespacedPodPeers(namespacedNames)...), nil
+}
+
+// GetSupportingServiceNamespaces gets the namespaces of the supporting services a KogitoRuntime might use, see the URLHandler
+func GetSupportingServiceNamespaces(runtime api.KogitoRuntimeInterface) []string {
+	namespaces := []string{runtime.GetNamespace()}
+	for _, ref := range runtime.GetRuntimeSpec().GetSupportingServiceRefs() {
+		namespaces = appendNamespace(namespaces, ref.GetNamespace())
+	}
+	return appendNamespace(namespaces, GetDefaultSupportingServicesNamespace())
+}
+
+func appendNamespace(namespaces []string, namespace string) []string {
+	if len(namespace) == 0 {
+		return namespaces
+	}
+	for _, ns := range namespaces {
+		if ns == namespace {
+			return namespaces
+		}
+	}
+	return append(namespaces, namespace)
+}
+
+func getNamespacedPodPeers(namespacedNames []types.NamespacedName) []networkingv1.NetworkPolicyPeer {
+	sort.Slice(namespacedNames, func(i, j int) bool { return namespacedNames[i].String() < namespacedNames[j].String() })
+	var peers []networkingv1.NetworkPolicyPeer
+	for _, namespacedName := range namespacedNames {
+		peers = append(peers, framework.NewNetworkPolicyNamespacedPodPeer(namespacedName.Namespace, namespacedName.Name))
+	}
+	return peers
+}
+
+func getSupportingServicePeers(supportingServices map[api.ServiceType][]string, serviceTypes ...api.ServiceType) []networkingv1.NetworkPolicyPeer {
+	sortedTypes := append([]api.ServiceType(nil), serviceTypes...)
+	sort.Slice(sortedTypes, func(i, j int) bool { return sortedTypes[i] < sortedTypes[j] })
+	var peers []networkingv1.NetworkPolicyPeer
+	for _, serviceType := range sortedTypes {
+		for _, name := range supportingServices[serviceType] {
+			peers = append(peers, framework.NewNetworkPolicyPodPeer(name))
+		}
+	}
+	return peers
+}
+
+func containsServiceType(serviceTypes []api.ServiceType, serviceType api.ServiceType) bool {
+	for _, s := range serviceTypes {
+		if s == serviceType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connector
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/internal/app"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+func TestInjectKogitoRuntimeTopologyIntoNetworkPolicy(t *testing.T) {
+	ns := t.Name()
+	kogitoRuntime := test.CreateFakeKogitoRuntime(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(
+		kogitoRuntime,
+		test.CreateFakeDataIndex(ns),
+		test.CreateFakeJobsService(ns),
+		test.CreateFakeMgmtConsole(ns),
+		test.CreateFakeTrustyUIService(ns)).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	topologyHandler := NewTopologyHandler(context, app.NewKogitoRuntimeHandler(context), app.NewKogitoSupportingServiceHandler(context))
+	networkPolicy := &networkingv1.NetworkPolicy{}
+	err := topologyHandler.InjectKogitoRuntimeTopologyIntoNetworkPolicy(kogitoRuntime, networkPolicy)
+	assert.NoError(t, err)
+
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		framework.NewNetworkPolicyPodPeer("jobs-service"),
+		framework.NewNetworkPolicyPodPeer("mgmt-console"),
+	}, networkPolicy.Spec.Ingress[0].From)
+	assert.Len(t, networkPolicy.Spec.Egress, 1)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		framework.NewNetworkPolicyPodPeer("data-index"),
+		framework.NewNetworkPolicyPodPeer("jobs-service"),
+	}, networkPolicy.Spec.Egress[0].To)
+}
+
+func TestInjectSupportingServiceTopologyIntoNetworkPolicy(t *testing.T) {
+	ns := t.Name()
+	kogitoRuntime := test.CreateFakeKogitoRuntime(ns)
+	dataIndex := test.CreateFakeDataIndex(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(
+		kogitoRuntime,
+		dataIndex,
+		test.CreateFakeMgmtConsole(ns),
+		test.CreateFakeTaskConsole(ns)).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	topologyHandler := NewTopologyHandler(context, app.NewKogitoRuntimeHandler(context), app.NewKogitoSupportingServiceHandler(context))
+
+	// Data Index accepts the connections from the consoles and runtimes, and connects to none of them
+	networkPolicy := &networkingv1.NetworkPolicy{}
+	err := topologyHandler.InjectSupportingServiceTopologyIntoNetworkPolicy(ns, dataIndex.Spec.ServiceType, networkPolicy)
+	assert.NoError(t, err)
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		framework.NewNetworkPolicyPodPeer("mgmt-console"),
+		framework.NewNetworkPolicyPodPeer("task-console"),
+		framework.NewNetworkPolicyPodPeer(kogitoRuntime.Name),
+	}, networkPolicy.Spec.Ingress[0].From)
+	assert.Empty(t, networkPolicy.Spec.Egress)
+
+	// Management Console connects to the Data Index and runtimes
+	networkPolicy = &networkingv1.NetworkPolicy{}
+	err = topologyHandler.InjectSupportingServiceTopologyIntoNetworkPolicy(ns, api.MgmtConsole, networkPolicy)
+	assert.NoError(t, err)
+	assert.Empty(t, networkPolicy.Spec.Ingress)
+	assert.Len(t, networkPolicy.Spec.Egress, 1)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		framework.NewNetworkPolicyPodPeer(dataIndex.Name),
+		framework.NewNetworkPolicyPodPeer(kogitoRuntime.Name),
+	}, networkPolicy.Spec.Egress[0].To)
+}
+
+func TestInjectTopologyIntoNetworkPolicy_OtherNamespaces(t *testing.T) {
+	deferFn := test.SetSharedEnv(supportingServicesNamespaceEnvVar, "shared")
+	defer deferFn()
+	dataIndex := newFakeSupportingService("data-index", "shared", api.DataIndex, "http://data-index-shared.com")
+	jobsService := newFakeSupportingService("jobs-service", "jobs", api.JobsService, "http://jobs-service-jobs.com")
+	referencingRuntime, _ := newFakeRuntimeWithDeployment("referencing-app", "tenant-a", v1beta1.SupportingServiceReference{ServiceType: api.JobsService, Namespace: "jobs"})
+	defaultRuntime, _ := newFakeRuntimeWithDeployment("default-app", "tenant-b")
+	localRuntime, _ := newFakeRuntimeWithDeployment("local-app", "tenant-c")
+	localDataIndex := newFakeSupportingService("data-index", "tenant-c", api.DataIndex, "http://data-index-tenant.com")
+	console := newFakeSupportingService("mgmt-console", "tenant-d", api.MgmtConsole, "http://mgmt-console-tenant.com")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(dataIndex, jobsService, referencingRuntime, defaultRuntime, localRuntime, localDataIndex, console).Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+	topologyHandler := NewTopologyHandler(context, app.NewKogitoRuntimeHandler(context), app.NewKogitoSupportingServiceHandler(context))
+
+	// the runtime connects to the Jobs Service it references and to the default Data Index, the Jobs Service calls it back
+	networkPolicy := &networkingv1.NetworkPolicy{}
+	assert.NoError(t, topologyHandler.InjectKogitoRuntimeTopologyIntoNetworkPolicy(referencingRuntime, networkPolicy))
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		framework.NewNetworkPolicyNamespacedPodPeer("jobs", "jobs-service"),
+	}, networkPolicy.Spec.Ingress[0].From)
+	assert.Len(t, networkPolicy.Spec.Egress, 1)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		framework.NewNetworkPolicyNamespacedPodPeer("shared", "data-index"),
+		framework.NewNetworkPolicyNamespacedPodPeer("jobs", "jobs-service"),
+	}, networkPolicy.Spec.Egress[0].To)
+
+	// the runtime using the Data Index of its namespace gets no peer of other namespaces
+	networkPolicy = &networkingv1.NetworkPolicy{}
+	assert.NoError(t, topologyHandler.InjectKogitoRuntimeTopologyIntoNetworkPolicy(localRuntime, networkPolicy))
+	assert.Len(t, networkPolicy.Spec.Egress, 1)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		framework.NewNetworkPolicyPodPeer("data-index"),
+	}, networkPolicy.Spec.Egress[0].To)
+
+	// the default Data Index accepts the connections from the runtimes and consoles falling back to it
+	networkPolicy = &networkingv1.NetworkPolicy{}
+	assert.NoError(t, topologyHandler.InjectSupportingServiceTopologyIntoNetworkPolicy("shared", api.DataIndex, networkPolicy))
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		framework.NewNetworkPolicyNamespacedPodPeer("tenant-d", "mgmt-console"),
+		framework.NewNetworkPolicyNamespacedPodPeer("tenant-a", "referencing-app"),
+		framework.NewNetworkPolicyNamespacedPodPeer("tenant-b", "default-app"),
+	}, networkPolicy.Spec.Ingress[0].From)
+	assert.Empty(t, networkPolicy.Spec.Egress)
+
+	// the referenced Jobs Service accepts the connections from the runtime and calls it back
+	networkPolicy = &networkingv1.NetworkPolicy{}
+	assert.NoError(t, topologyHandler.InjectSupportingServiceTopologyIntoNetworkPolicy("jobs", api.JobsService, networkPolicy))
+	referencingPeers := []networkingv1.NetworkPolicyPeer{framework.NewNetworkPolicyNamespacedPodPeer("tenant-a", "referencing-app")}
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	assert.Equal(t, referencingPeers, networkPolicy.Spec.Ingress[0].From)
+	assert.Len(t, networkPolicy.Spec.Egress, 1)
+	assert.Equal(t, referencingPeers, networkPolicy.Spec.Egress[0].To)
+
+	// the console connects to the default Data Index
+	networkPolicy = &networkingv1.NetworkPolicy{}
+	assert.NoError(t, topologyHandler.InjectSupportingServiceTopologyIntoNetworkPolicy("tenant-d", api.MgmtConsole, networkPolicy))
+	assert.Len(t, networkPolicy.Spec.Egress, 1)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		framework.NewNetworkPolicyNamespacedPodPeer("shared", "data-index"),
+	}, networkPolicy.Spec.Egress[0].To)
+}
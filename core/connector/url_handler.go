@@ -19,9 +19,13 @@ import (
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/manager"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"net/url"
 )
 
@@ -37,6 +41,10 @@ const (
 	// Trusty WS URL env
 	trustyWSRouteEnv = "KOGITO_TRUSTY_WS_URL"
 )
+const (
+	// supportingServicesNamespaceEnvVar is the operator env holding the namespace of the Supporting Services used by default by the Kogito services
+	supportingServicesNamespaceEnvVar = "KOGITO_SUPPORTING_SERVICES_NAMESPACE"
+)
 const (
 	webSocketScheme       = "ws"
 	webSocketSecureScheme = "wss"
@@ -49,7 +57,7 @@ type URLHandler interface {
 	InjectDataIndexURLIntoDeployment(namespace string, deployment *appsv1.Deployment) error
 	InjectDataIndexURLIntoSupportingService(namespace string, serviceTypes ...api.ServiceType) error
 	InjectJobsServicesURLIntoKogitoRuntimeServices(namespace string) error
-	InjectJobsServiceURLIntoKogitoRuntimeDeployment(namespace string, deployment *appsv1.Deployment) error
+	InjectSupportingServicesURLIntoKogitoRuntimeDeployment(runtime api.KogitoRuntimeInterface, deployment *appsv1.Deployment) error
 	InjectTrustyURLIntoKogitoRuntimeServices(namespace string) error
 	InjectTrustyURLIntoDeployment(namespace string, deployment *appsv1.Deployment) error
 }
@@ -124,10 +132,30 @@ func (u *urlHandler) InjectJobsServicesURLIntoKogitoRuntimeServices(namespace st
 	return u.injectSupportingServiceURLIntoKogitoRuntime(namespace, jobsServicesHTTPRouteEnv, "", api.JobsService)
 }
 
-// InjectJobsServiceURLIntoKogitoRuntimeDeployment will inject jobs-service route URL in to kogito runtime deployment env var
-func (u *urlHandler) InjectJobsServiceURLIntoKogitoRuntimeDeployment(namespace string, deployment *appsv1.Deployment) error {
-	u.Log.Debug("Injecting Jobs Service URL in kogito Runtime deployment")
-	return u.injectSupportingServiceURLIntoDeployment(namespace, jobsServicesHTTPRouteEnv, "", deployment, api.JobsService)
+// InjectSupportingServicesURLIntoKogitoRuntimeDeployment will inject the URLs of the Data Index, Jobs Service and Trusty used by the given KogitoRuntime into its deployment env vars
+// Supporting services referenced by the KogitoRuntime take precedence over the ones of its namespace
+func (u *urlHandler) InjectSupportingServicesURLIntoKogitoRuntimeDeployment(runtime api.KogitoRuntimeInterface, deployment *appsv1.Deployment) error {
+	u.Log.Debug("Injecting Supporting Services URL in kogito Runtime deployment")
+	refs := runtime.GetRuntimeSpec().GetSupportingServiceRefs()
+	for _, supportingService := range []struct {
+		serviceType api.ServiceType
+		httpEnv     string
+		wsEnv       string
+	}{
+		{api.DataIndex, dataIndexHTTPRouteEnv, dataIndexWSRouteEnv},
+		{api.JobsService, jobsServicesHTTPRouteEnv, ""},
+		{api.TrustyAI, trustyHTTPRouteEnv, trustyWSRouteEnv},
+	} {
+		serviceEndpoints, err := u.getSupportingServiceEndpointsForRefs(runtime.GetNamespace(), refs, supportingService.httpEnv, supportingService.wsEnv, supportingService.serviceType)
+		if err != nil {
+			return err
+		}
+		if serviceEndpoints != nil {
+			u.Log.Debug("", "resourceType", supportingService.serviceType, "route", serviceEndpoints.HTTPRouteURI)
+			u.updateServiceEndpointIntoDeploymentEnv(deployment, serviceEndpoints)
+		}
+	}
+	return nil
 }
 
 // InjectTrustyURLIntoKogitoRuntimeServices will query for every KogitoRuntime in the given namespace to inject the Trusty route to each one
@@ -143,32 +171,52 @@ func (u *urlHandler) InjectTrustyURLIntoDeployment(namespace string, deployment
 	return u.injectSupportingServiceURLIntoDeployment(namespace, trustyHTTPRouteEnv, trustyWSRouteEnv, deployment, api.TrustyAI)
 }
 
-// injectSupportingServiceURLIntoKogitoRuntime will query for every KogitoApp in the given namespace to inject the Supporting service route to each one
-// Won't trigger an update if the KogitoApp already has the route set to avoid unnecessary reconciliation triggers
+// injectSupportingServiceURLIntoKogitoRuntime will query for every KogitoRuntime relying on the Supporting service of the given namespace to inject its route to each one.
+// KogitoRuntimes of other namespaces referencing it, or falling back to it as the default one, are updated too.
+// Won't trigger an update if the KogitoRuntime already has the route set to avoid unnecessary reconciliation triggers
 // it will call when supporting service reconcile
 func (u *urlHandler) injectSupportingServiceURLIntoKogitoRuntime(namespace string, serviceHTTPRouteEnv string, serviceWSRouteEnv string, resourceType api.ServiceType) error {
-	serviceEndpoints, err := u.getSupportingServiceEndpoints(namespace, serviceHTTPRouteEnv, serviceWSRouteEnv, resourceType)
+	u.Log.Debug("Querying KogitoRuntime instances to inject a route", "resourceType", resourceType)
+	runtimes, err := u.runtimeHandler.FetchAllKogitoRuntimeInstances(metav1.NamespaceAll)
 	if err != nil {
 		return err
 	}
-	if serviceEndpoints != nil {
-
-		u.Log.Debug("", "resourceType", resourceType, "route", serviceEndpoints.HTTPRouteURI)
-
-		u.Log.Debug("Querying KogitoRuntime instances to inject a route ", "namespace", namespace)
-		runtimeManager := manager.NewKogitoRuntimeManager(u.Context, u.runtimeHandler)
-		deployments, err := runtimeManager.FetchKogitoRuntimeDeployments(namespace)
+	endpointsByRuntime := make(map[types.UID]*ServiceEndpoints)
+	runtimeNamespaces := make(map[string]bool)
+	for _, runtime := range runtimes.GetItems() {
+		supportingService, err := resolveSupportingService(u.Context, u.supportingServiceHandler, runtime.GetNamespace(), runtime.GetRuntimeSpec().GetSupportingServiceRefs(), resourceType)
 		if err != nil {
 			return err
 		}
-		u.Log.Debug("", "Found KogitoRuntime instances", len(deployments), "namespace", namespace)
-		if len(deployments) == 0 {
-			u.Log.Debug("No deployment found for KogitoRuntime, skipping to inject request resource type URL into KogitoRuntime", "request resource type", resourceType)
-			return nil
+		if supportingService == nil || supportingService.GetNamespace() != namespace {
+			continue
+		}
+		serviceEndpoints, err := u.newServiceEndpoints(runtime.GetNamespace(), supportingService, serviceHTTPRouteEnv, serviceWSRouteEnv)
+		if err != nil {
+			return err
+		}
+		if serviceEndpoints != nil {
+			endpointsByRuntime[runtime.GetUID()] = serviceEndpoints
+			runtimeNamespaces[runtime.GetNamespace()] = true
 		}
-		u.Log.Debug("Querying resource route to inject into KogitoRuntimes", "resource", resourceType)
+	}
+	if len(endpointsByRuntime) == 0 {
+		u.Log.Debug("No KogitoRuntime found, skipping to inject request resource type URL into KogitoRuntime", "request resource type", resourceType)
+		return nil
+	}
 
+	runtimeManager := manager.NewKogitoRuntimeManager(u.Context, u.runtimeHandler)
+	for runtimeNamespace := range runtimeNamespaces {
+		deployments, err := runtimeManager.FetchKogitoRuntimeDeployments(runtimeNamespace)
+		if err != nil {
+			return err
+		}
+		u.Log.Debug("", "Found KogitoRuntime instances", len(deployments), "namespace", runtimeNamespace)
 		for _, dep := range deployments {
+			serviceEndpoints := getEndpointsForOwner(&dep, endpointsByRuntime)
+			if serviceEndpoints == nil {
+				continue
+			}
 			updateHTTP, updateWS := u.updateServiceEndpointIntoDeploymentEnv(&dep, serviceEndpoints)
 			// update only once
 			if updateWS || updateHTTP {
@@ -178,7 +226,15 @@ func (u *urlHandler) injectSupportingServiceURLIntoKogitoRuntime(namespace strin
 			}
 		}
 	}
-	u.Log.Debug("Service Endpoint is nil")
+	return nil
+}
+
+func getEndpointsForOwner(deployment *appsv1.Deployment, endpointsByOwner map[types.UID]*ServiceEndpoints) *ServiceEndpoints {
+	for _, owner := range deployment.OwnerReferences {
+		if serviceEndpoints, ok := endpointsByOwner[owner.UID]; ok {
+			return serviceEndpoints
+		}
+	}
 	return nil
 }
 
@@ -197,12 +253,49 @@ func (u *urlHandler) injectSupportingServiceURLIntoDeployment(namespace string,
 	return nil
 }
 
+// getSupportingServiceEndpoints gets the endpoints of the Supporting service used by the services of the given namespace
 func (u *urlHandler) getSupportingServiceEndpoints(namespace string, serviceHTTPRouteEnv string, serviceWSRouteEnv string, resourceType api.ServiceType) (endpoints *ServiceEndpoints, err error) {
-	route := ""
-	supportingServiceManager := manager.NewKogitoSupportingServiceManager(u.Context, u.supportingServiceHandler)
-	route, err = supportingServiceManager.FetchKogitoSupportingServiceRoute(namespace, resourceType)
-	if err != nil {
-		return
+	return u.getSupportingServiceEndpointsForRefs(namespace, nil, serviceHTTPRouteEnv, serviceWSRouteEnv, resourceType)
+}
+
+func (u *urlHandler) getSupportingServiceEndpointsForRefs(namespace string, refs []api.SupportingServiceReferenceInterface, serviceHTTPRouteEnv string, serviceWSRouteEnv string, resourceType api.ServiceType) (endpoints *ServiceEndpoints, err error) {
+	supportingService, err := resolveSupportingService(u.Context, u.supportingServiceHandler, namespace, refs, resourceType)
+	if err != nil || supportingService == nil {
+		return nil, err
+	}
+	return u.newServiceEndpoints(namespace, supportingService, serviceHTTPRouteEnv, serviceWSRouteEnv)
+}
+
+// resolveSupportingService gets the Supporting service of the given type used by a service of the namespace:
+// the one referenced by the service if any, otherwise the one of the namespace or eventually the one of the default Supporting Services namespace
+func resolveSupportingService(context operator.Context, supportingServiceHandler manager.KogitoSupportingServiceHandler, namespace string, refs []api.SupportingServiceReferenceInterface, resourceType api.ServiceType) (api.KogitoSupportingServiceInterface, error) {
+	supportingServiceManager := manager.NewKogitoSupportingServiceManager(context, supportingServiceHandler)
+	for _, ref := range refs {
+		if ref.GetServiceType() == resourceType {
+			return supportingServiceManager.FetchKogitoSupportingServiceForServiceType(ref.GetNamespace(), resourceType)
+		}
+	}
+	supportingService, err := supportingServiceManager.FetchKogitoSupportingServiceForServiceType(namespace, resourceType)
+	if err != nil || supportingService != nil {
+		return supportingService, err
+	}
+	if defaultNamespace := GetDefaultSupportingServicesNamespace(); len(defaultNamespace) > 0 && defaultNamespace != namespace {
+		return supportingServiceManager.FetchKogitoSupportingServiceForServiceType(defaultNamespace, resourceType)
+	}
+	return nil, nil
+}
+
+// GetDefaultSupportingServicesNamespace gets the namespace of the Supporting Services used by default, empty if none
+func GetDefaultSupportingServicesNamespace() string {
+	return util.GetOSEnv(supportingServicesNamespaceEnvVar, "")
+}
+
+// newServiceEndpoints creates the endpoints used by a service of the given namespace to call the Supporting service.
+// Supporting services of other namespaces are called through their internal URL.
+func (u *urlHandler) newServiceEndpoints(namespace string, supportingService api.KogitoSupportingServiceInterface, serviceHTTPRouteEnv string, serviceWSRouteEnv string) (endpoints *ServiceEndpoints, err error) {
+	route := supportingService.GetStatus().GetExternalURI()
+	if supportingService.GetNamespace() != namespace {
+		route = infrastructure.GetKogitoServiceInternalURL(supportingService)
 	}
 	if len(route) > 0 {
 		endpoints = &ServiceEndpoints{
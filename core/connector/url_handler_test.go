@@ -355,3 +355,71 @@ func Test_getKogitoDataIndexURLs(t *testing.T) {
 		})
 	}
 }
+
+func newFakeSupportingService(name, namespace string, serviceType api.ServiceType, route string) *v1beta1.KogitoSupportingService {
+	return &v1beta1.KogitoSupportingService{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       v1beta1.KogitoSupportingServiceSpec{ServiceType: serviceType},
+		Status:     v1beta1.KogitoSupportingServiceStatus{KogitoServiceStatus: v1beta1.KogitoServiceStatus{ExternalURI: route}},
+	}
+}
+
+func newFakeRuntimeWithDeployment(name, namespace string, refs ...v1beta1.SupportingServiceReference) (*v1beta1.KogitoRuntime, *appsv1.Deployment) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID(uuid.New().String())},
+		Spec:       v1beta1.KogitoRuntimeSpec{SupportingServiceRefs: refs},
+	}
+	dc := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, OwnerReferences: []metav1.OwnerReference{{Name: name, UID: kogitoRuntime.UID}}},
+		Spec: appsv1.DeploymentSpec{
+			Template: v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "the-app"}}}},
+		},
+	}
+	return kogitoRuntime, dc
+}
+
+func TestInjectSupportingServicesURLIntoKogitoRuntimeDeployment(t *testing.T) {
+	kogitoRuntime, dc := newFakeRuntimeWithDeployment("kogito-app", "tenant", v1beta1.SupportingServiceReference{ServiceType: api.DataIndex, Namespace: "shared"})
+	sharedDataIndex := newFakeSupportingService("data-index", "shared", api.DataIndex, "http://data-index-shared.com")
+	localDataIndex := newFakeSupportingService("data-index", "tenant", api.DataIndex, "http://data-index-tenant.com")
+	localJobsService := newFakeSupportingService("jobs-service", "tenant", api.JobsService, "http://jobs-service-tenant.com")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoRuntime, sharedDataIndex, localDataIndex, localJobsService).Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+	urlHandler := NewURLHandler(context, app.NewKogitoRuntimeHandler(context), app.NewKogitoSupportingServiceHandler(context))
+
+	err := urlHandler.InjectSupportingServicesURLIntoKogitoRuntimeDeployment(kogitoRuntime, dc)
+	assert.NoError(t, err)
+	env := dc.Spec.Template.Spec.Containers[0].Env
+	// the referenced Data Index is called internally, even if there's one in the namespace
+	assert.Contains(t, env, v1.EnvVar{Name: dataIndexHTTPRouteEnv, Value: "http://data-index.shared"})
+	assert.Contains(t, env, v1.EnvVar{Name: dataIndexWSRouteEnv, Value: "ws://data-index.shared"})
+	assert.Contains(t, env, v1.EnvVar{Name: jobsServicesHTTPRouteEnv, Value: "http://jobs-service-tenant.com"})
+	assert.NotContains(t, env, v1.EnvVar{Name: trustyHTTPRouteEnv})
+}
+
+func TestInjectDataIndexURLIntoKogitoRuntime_OtherNamespaces(t *testing.T) {
+	deferFn := test.SetSharedEnv(supportingServicesNamespaceEnvVar, "shared")
+	defer deferFn()
+	dataIndex := newFakeSupportingService("data-index", "shared", api.DataIndex, "http://data-index-shared.com")
+	referencingRuntime, referencingDC := newFakeRuntimeWithDeployment("referencing-app", "tenant-a", v1beta1.SupportingServiceReference{ServiceType: api.DataIndex, Namespace: "shared"})
+	defaultRuntime, defaultDC := newFakeRuntimeWithDeployment("default-app", "tenant-b")
+	localRuntime, localDC := newFakeRuntimeWithDeployment("local-app", "tenant-c")
+	localDataIndex := newFakeSupportingService("data-index", "tenant-c", api.DataIndex, "http://data-index-tenant.com")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(dataIndex, referencingRuntime, referencingDC, defaultRuntime, defaultDC, localRuntime, localDC, localDataIndex).Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+	urlHandler := NewURLHandler(context, app.NewKogitoRuntimeHandler(context), app.NewKogitoSupportingServiceHandler(context))
+
+	err := urlHandler.InjectDataIndexURLIntoKogitoRuntimeServices("shared")
+	assert.NoError(t, err)
+
+	for _, dc := range []*appsv1.Deployment{referencingDC, defaultDC} {
+		exists, err := kubernetes.ResourceC(cli).Fetch(dc)
+		assert.NoError(t, err)
+		assert.True(t, exists)
+		assert.Contains(t, dc.Spec.Template.Spec.Containers[0].Env, v1.EnvVar{Name: dataIndexHTTPRouteEnv, Value: "http://data-index.shared"})
+	}
+	exists, err := kubernetes.ResourceC(cli).Fetch(localDC)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Empty(t, localDC.Spec.Template.Spec.Containers[0].Env)
+}
@@ -20,14 +20,24 @@ import (
 
 	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
 	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	certmanagerv1 "github.com/kiegroup/kogito-operator/core/infrastructure/certmanager/v1"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	kafkav1beta2 "github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	servingv1 "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
 	appsv1 "github.com/openshift/api/apps/v1"
 	buildv1 "github.com/openshift/api/build/v1"
 	imgv1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	monv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	apps "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 
 	"reflect"
 )
@@ -151,6 +161,10 @@ func CreateDeploymentConfigComparator() func(deployed client.Object, requested c
 // CreateDeploymentComparator creates a new comparator for Deployment sorting volumes
 func CreateDeploymentComparator() func(deployed client.Object, requested client.Object) bool {
 	return func(deployed client.Object, requested client.Object) bool {
+		if requested.(*apps.Deployment).Spec.Replicas == nil {
+			// replicas not defined means they're managed elsewhere (e.g. by a HorizontalPodAutoscaler)
+			requested.(*apps.Deployment).Spec.Replicas = deployed.(*apps.Deployment).Spec.Replicas
+		}
 		sortVolumes(&deployed.(*apps.Deployment).Spec.Template.Spec)
 		sortVolumes(&requested.(*apps.Deployment).Spec.Template.Spec)
 		ignoreInjectedVariables(
@@ -198,13 +212,49 @@ func CreateBuildConfigComparator() func(deployed client.Object, requested client
 	}
 }
 
-// CreateRouteComparator creates a new comparator for Route using Label
+// CreateRouteComparator creates a new comparator for Route using Label, Port, TLS and the backends receiving the traffic
 func CreateRouteComparator() func(deployed client.Object, requested client.Object) bool {
 	return func(deployed client.Object, requested client.Object) bool {
 		rtDeployed := deployed.(*routev1.Route)
-		rtRequested := requested.(*routev1.Route).DeepCopy()
+		rtRequested := requested.(*routev1.Route)
+		// the host is generated by the server when not set
+		if len(rtRequested.Spec.Host) == 0 {
+			rtRequested.Spec.Host = rtDeployed.Spec.Host
+		}
+
+		if !containAllLabels(rtDeployed, rtRequested) {
+			return false
+		}
+		if !reflect.DeepEqual(rtDeployed.Spec.Port, rtRequested.Spec.Port) || !reflect.DeepEqual(rtDeployed.Spec.TLS, rtRequested.Spec.TLS) {
+			return false
+		}
+		// the weight of the main backend is defaulted by the server when not set
+		if rtRequested.Spec.To.Weight != nil && !reflect.DeepEqual(rtDeployed.Spec.To.Weight, rtRequested.Spec.To.Weight) {
+			return false
+		}
+		if len(rtDeployed.Spec.AlternateBackends) == 0 && len(rtRequested.Spec.AlternateBackends) == 0 {
+			return rtDeployed.Spec.To.Name == rtRequested.Spec.To.Name
+		}
+		return rtDeployed.Spec.To.Name == rtRequested.Spec.To.Name &&
+			reflect.DeepEqual(rtDeployed.Spec.AlternateBackends, rtRequested.Spec.AlternateBackends)
+	}
+}
+
+// CreateIngressComparator creates a new comparator for Ingress using Label, Annotation and Spec
+func CreateIngressComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		ingDeployed := deployed.(*networkingv1.Ingress)
+		ingRequested := requested.(*networkingv1.Ingress).DeepCopy()
 
-		return containAllLabels(rtDeployed, rtRequested)
+		if !containAllLabels(ingDeployed, ingRequested) {
+			return false
+		}
+		for key, value := range ingRequested.GetAnnotations() {
+			if ingDeployed.GetAnnotations()[key] != value {
+				return false
+			}
+		}
+		return reflect.DeepEqual(ingDeployed.Spec, ingRequested.Spec)
 	}
 }
 
@@ -254,17 +304,20 @@ func CreateSharedImageStreamComparator() func(deployed client.Object, requested
 // Pods with containers with different sizes will be ignored.
 // For an example for such scenario, see: https://knative.dev/docs/eventing/samples/sinkbinding/ which is another operator injecting
 // variables in a given Deployment object. That object could be us.
+// Containers are matched by name, their order is kept since the service container must stay the first one next to the sidecars.
 func ignoreInjectedVariables(deployed *v1.PodTemplateSpec, requested *v1.PodTemplateSpec) {
 	if len(deployed.Spec.Containers) != len(requested.Spec.Containers) {
 		return
 	}
-	sortContainersByName(deployed)
-	sortContainersByName(requested)
-	for i := range deployed.Spec.Containers {
+	for i := range requested.Spec.Containers {
+		deployedContainer := getContainerByName(deployed, requested.Spec.Containers[i].Name)
+		if deployedContainer == nil {
+			continue
+		}
 		// there's more envs in the deployed object, let's take them to the requested one.
 		// all other scenarios (requested with more envs or equal elements are ignored since the equality will consider them not equal anyway)
-		if len(deployed.Spec.Containers[i].Env) > len(requested.Spec.Containers[i].Env) {
-			diff := DiffEnvVar(deployed.Spec.Containers[i].Env, requested.Spec.Containers[i].Env)
+		if len(deployedContainer.Env) > len(requested.Spec.Containers[i].Env) {
+			diff := DiffEnvVar(deployedContainer.Env, requested.Spec.Containers[i].Env)
 			if len(diff) > 0 {
 				requested.Spec.Containers[i].Env = append(requested.Spec.Containers[i].Env, diff...)
 			}
@@ -272,10 +325,34 @@ func ignoreInjectedVariables(deployed *v1.PodTemplateSpec, requested *v1.PodTemp
 	}
 }
 
-func sortContainersByName(pod *v1.PodTemplateSpec) {
-	sort.Slice(pod.Spec.Containers, func(i, j int) bool {
-		return pod.Spec.Containers[i].Name < pod.Spec.Containers[j].Name
-	})
+func getContainerByName(pod *v1.PodTemplateSpec, name string) *v1.Container {
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == name {
+			return &pod.Spec.Containers[i]
+		}
+	}
+	return nil
+}
+
+// CreatePersistentVolumeClaimComparator creates a new comparator for PersistentVolumeClaim using Label and requested storage.
+// Only the storage of a bound claim can be changed, the requested claim takes the deployed spec so that it can be expanded.
+func CreatePersistentVolumeClaimComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		claimDeployed := deployed.(*v1.PersistentVolumeClaim)
+		claimRequested := requested.(*v1.PersistentVolumeClaim)
+		requestedStorage := claimRequested.Spec.Resources.Requests[v1.ResourceStorage]
+		deployedStorage := claimDeployed.Spec.Resources.Requests[v1.ResourceStorage]
+		claimDeployed.Spec.DeepCopyInto(&claimRequested.Spec)
+		// volumes can't be shrunk
+		if requestedStorage.Cmp(deployedStorage) <= 0 {
+			return containAllLabels(deployed, requested)
+		}
+		if claimRequested.Spec.Resources.Requests == nil {
+			claimRequested.Spec.Resources.Requests = v1.ResourceList{}
+		}
+		claimRequested.Spec.Resources.Requests[v1.ResourceStorage] = requestedStorage
+		return false
+	}
 }
 
 // CreateServiceMonitorComparator creates a new comparator for ServiceMonitor using Label
@@ -287,3 +364,196 @@ func CreateServiceMonitorComparator() func(deployed client.Object, requested cli
 		return containAllLabels(smDeployed, smRequested)
 	}
 }
+
+// CreateHorizontalPodAutoscalerComparator creates a new comparator for HorizontalPodAutoscaler using Label and Spec.
+// Fields defaulted by the cluster when not requested (behavior and metrics) are ignored.
+func CreateHorizontalPodAutoscalerComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		hpaDeployed := deployed.(*autoscalingv2beta2.HorizontalPodAutoscaler)
+		hpaRequested := requested.(*autoscalingv2beta2.HorizontalPodAutoscaler).DeepCopy()
+
+		if !containAllLabels(hpaDeployed, hpaRequested) {
+			return false
+		}
+		if hpaRequested.Spec.Behavior == nil {
+			hpaRequested.Spec.Behavior = hpaDeployed.Spec.Behavior
+		}
+		if len(hpaRequested.Spec.Metrics) == 0 {
+			hpaRequested.Spec.Metrics = hpaDeployed.Spec.Metrics
+		}
+		return equality.Semantic.DeepEqual(hpaDeployed.Spec, hpaRequested.Spec)
+	}
+}
+
+// CreatePodDisruptionBudgetComparator creates a new comparator for PodDisruptionBudget using Label and Spec
+func CreatePodDisruptionBudgetComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		pdbDeployed := deployed.(*policyv1beta1.PodDisruptionBudget)
+		pdbRequested := requested.(*policyv1beta1.PodDisruptionBudget)
+		return containAllLabels(pdbDeployed, pdbRequested) &&
+			equality.Semantic.DeepEqual(pdbDeployed.Spec, pdbRequested.Spec)
+	}
+}
+
+// CreateNetworkPolicyComparator creates a new comparator for NetworkPolicy using Label and Spec
+func CreateNetworkPolicyComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		networkPolicyDeployed := deployed.(*networkingv1.NetworkPolicy)
+		networkPolicyRequested := requested.(*networkingv1.NetworkPolicy)
+		return containAllLabels(networkPolicyDeployed, networkPolicyRequested) &&
+			equality.Semantic.DeepEqual(networkPolicyDeployed.Spec, networkPolicyRequested.Spec)
+	}
+}
+
+// CreateVirtualServiceComparator creates a new comparator for Istio VirtualService using Label and Spec
+func CreateVirtualServiceComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		vsDeployed := deployed.(*istiov1beta1.VirtualService)
+		vsRequested := requested.(*istiov1beta1.VirtualService)
+		return containAllLabels(vsDeployed, vsRequested) &&
+			reflect.DeepEqual(vsDeployed.Spec, vsRequested.Spec)
+	}
+}
+
+// CreateDestinationRuleComparator creates a new comparator for Istio DestinationRule using Label and Spec
+func CreateDestinationRuleComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		drDeployed := deployed.(*istiov1beta1.DestinationRule)
+		drRequested := requested.(*istiov1beta1.DestinationRule)
+		return containAllLabels(drDeployed, drRequested) &&
+			reflect.DeepEqual(drDeployed.Spec, drRequested.Spec)
+	}
+}
+
+// CreateGatewayComparator creates a new comparator for Istio Gateway using Label and Spec
+func CreateGatewayComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		gwDeployed := deployed.(*istiov1beta1.Gateway)
+		gwRequested := requested.(*istiov1beta1.Gateway)
+		return containAllLabels(gwDeployed, gwRequested) &&
+			reflect.DeepEqual(gwDeployed.Spec, gwRequested.Spec)
+	}
+}
+
+// CreateKnativeServiceComparator creates a new comparator for Knative Service using Label and the pod template of its Revisions.
+// Fields defaulted by Knative when not requested (traffic, timeouts, concurrency and probe settings) are ignored.
+func CreateKnativeServiceComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		ksvcDeployed := deployed.(*servingv1.Service)
+		ksvcRequested := requested.(*servingv1.Service)
+		if !containAllLabels(ksvcDeployed, ksvcRequested) {
+			return false
+		}
+		templateDeployed := &v1.PodTemplateSpec{
+			ObjectMeta: *ksvcDeployed.Spec.Template.ObjectMeta.DeepCopy(),
+			Spec:       *ksvcDeployed.Spec.Template.Spec.PodSpec.DeepCopy(),
+		}
+		templateRequested := &v1.PodTemplateSpec{
+			ObjectMeta: *ksvcRequested.Spec.Template.ObjectMeta.DeepCopy(),
+			Spec:       *ksvcRequested.Spec.Template.Spec.PodSpec.DeepCopy(),
+		}
+		if !containAllEntries(templateDeployed.Labels, templateRequested.Labels) ||
+			!containAllEntries(templateDeployed.Annotations, templateRequested.Annotations) ||
+			len(templateDeployed.Spec.Containers) != len(templateRequested.Spec.Containers) {
+			return false
+		}
+		sortVolumes(&templateDeployed.Spec)
+		sortVolumes(&templateRequested.Spec)
+		ignoreInjectedVariables(templateDeployed, templateRequested)
+		for i := range templateDeployed.Spec.Containers {
+			containerDeployed := templateDeployed.Spec.Containers[i]
+			containerRequested := templateRequested.Spec.Containers[i]
+			if containerDeployed.Image != containerRequested.Image ||
+				!equality.Semantic.DeepEqual(containerDeployed.Env, containerRequested.Env) ||
+				!equality.Semantic.DeepEqual(containerDeployed.EnvFrom, containerRequested.EnvFrom) ||
+				!equality.Semantic.DeepEqual(containerDeployed.VolumeMounts, containerRequested.VolumeMounts) ||
+				!equality.Semantic.DeepEqual(containerDeployed.Resources, containerRequested.Resources) {
+				return false
+			}
+		}
+		return equality.Semantic.DeepEqual(templateDeployed.Spec.Volumes, templateRequested.Spec.Volumes)
+	}
+}
+
+func containAllEntries(deployed map[string]string, requested map[string]string) bool {
+	for key, value := range requested {
+		if deployed[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// CreateTriggerComparator creates a new comparator for Knative Trigger using Label, Broker, Filter and Subscriber
+func CreateTriggerComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		triggerDeployed := deployed.(*eventingv1.Trigger)
+		triggerRequested := requested.(*eventingv1.Trigger)
+		return containAllLabels(triggerDeployed, triggerRequested) &&
+			triggerDeployed.Spec.Broker == triggerRequested.Spec.Broker &&
+			equality.Semantic.DeepEqual(triggerDeployed.Spec.Filter, triggerRequested.Spec.Filter) &&
+			equality.Semantic.DeepEqual(triggerDeployed.Spec.Subscriber.Ref, triggerRequested.Spec.Subscriber.Ref)
+	}
+}
+
+// CreateKafkaUserComparator creates a new comparator for KafkaUser using Label and Spec
+func CreateKafkaUserComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		userDeployed := deployed.(*kafkav1beta2.KafkaUser)
+		userRequested := requested.(*kafkav1beta2.KafkaUser)
+		return containAllLabels(userDeployed, userRequested) &&
+			reflect.DeepEqual(userDeployed.Spec, userRequested.Spec)
+	}
+}
+
+// CreateCertificateComparator creates a new comparator for cert-manager Certificate using Label and Spec
+func CreateCertificateComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		certificateDeployed := deployed.(*certmanagerv1.Certificate)
+		certificateRequested := requested.(*certmanagerv1.Certificate)
+		return containAllLabels(certificateDeployed, certificateRequested) &&
+			reflect.DeepEqual(certificateDeployed.Spec, certificateRequested.Spec)
+	}
+}
+
+// CreateKeycloakRealmComparator creates a new comparator for KeycloakRealm using Label and Spec
+func CreateKeycloakRealmComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		realmDeployed := deployed.(*keycloakv1alpha1.KeycloakRealm)
+		realmRequested := requested.(*keycloakv1alpha1.KeycloakRealm)
+		return containAllLabels(realmDeployed, realmRequested) &&
+			reflect.DeepEqual(realmDeployed.Spec, realmRequested.Spec)
+	}
+}
+
+// CreateKeycloakClientComparator creates a new comparator for KeycloakClient using Label, RealmSelector and the client settings managed by the operator
+func CreateKeycloakClientComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		clientDeployed := deployed.(*keycloakv1alpha1.KeycloakClient)
+		clientRequested := requested.(*keycloakv1alpha1.KeycloakClient)
+		if !containAllLabels(clientDeployed, clientRequested) ||
+			!reflect.DeepEqual(clientDeployed.Spec.RealmSelector, clientRequested.Spec.RealmSelector) {
+			return false
+		}
+		if clientDeployed.Spec.Client == nil || clientRequested.Spec.Client == nil {
+			return clientDeployed.Spec.Client == clientRequested.Spec.Client
+		}
+		apiClientDeployed := clientDeployed.Spec.Client
+		apiClientRequested := clientRequested.Spec.Client
+		return apiClientDeployed.ClientID == apiClientRequested.ClientID &&
+			apiClientDeployed.Enabled == apiClientRequested.Enabled &&
+			apiClientDeployed.PublicClient == apiClientRequested.PublicClient &&
+			apiClientDeployed.StandardFlowEnabled == apiClientRequested.StandardFlowEnabled &&
+			apiClientDeployed.DirectAccessGrantsEnabled == apiClientRequested.DirectAccessGrantsEnabled &&
+			reflect.DeepEqual(apiClientDeployed.RedirectUris, apiClientRequested.RedirectUris) &&
+			reflect.DeepEqual(apiClientDeployed.WebOrigins, apiClientRequested.WebOrigins)
+	}
+}
+
+// CreatePipelineRunComparator creates a new comparator for Tekton PipelineRun using Label.
+// The spec of a PipelineRun can't be changed once it's started, a new PipelineRun is created instead.
+func CreatePipelineRunComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		return containAllLabels(deployed, requested)
+	}
+}
@@ -19,11 +19,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"testing"
 
+	servingv1 "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
 	appsv1 "github.com/openshift/api/apps/v1"
 	buildv1 "github.com/openshift/api/build/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -709,6 +712,94 @@ func Test_CreateDeploymentComparator(t *testing.T) {
 			reflect.TypeOf(apps.Deployment{}),
 			false,
 		},
+		{
+			"Replicas managed by autoscaler",
+			args{
+				deployed: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Replicas: &[]int32{3}[0],
+					},
+				},
+				requested: &apps.Deployment{
+					Spec: apps.DeploymentSpec{},
+				},
+			},
+			reflect.TypeOf(apps.Deployment{}),
+			true,
+		},
+		{
+			"Injected variables with sidecar",
+			args{
+				deployed: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Template: v1.PodTemplateSpec{
+							Spec: v1.PodSpec{
+								Containers: []v1.Container{
+									{Name: "service", Env: []v1.EnvVar{{Name: "app", Value: "test"}, {Name: "injected", Value: "test"}}},
+									{Name: "a-sidecar"},
+								},
+							},
+						},
+					},
+				},
+				requested: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Template: v1.PodTemplateSpec{
+							Spec: v1.PodSpec{
+								Containers: []v1.Container{
+									{Name: "service", Env: []v1.EnvVar{{Name: "app", Value: "test"}}},
+									{Name: "a-sidecar"},
+								},
+							},
+						},
+					},
+				},
+			},
+			reflect.TypeOf(apps.Deployment{}),
+			true,
+		},
+		{
+			"Sidecar before the service container",
+			args{
+				deployed: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Template: v1.PodTemplateSpec{
+							Spec: v1.PodSpec{
+								Containers: []v1.Container{{Name: "a-sidecar"}, {Name: "service"}},
+							},
+						},
+					},
+				},
+				requested: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Template: v1.PodTemplateSpec{
+							Spec: v1.PodSpec{
+								Containers: []v1.Container{{Name: "service"}, {Name: "a-sidecar"}},
+							},
+						},
+					},
+				},
+			},
+			reflect.TypeOf(apps.Deployment{}),
+			false,
+		},
+		{
+			"Different replicas",
+			args{
+				deployed: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Replicas: &[]int32{3}[0],
+					},
+				},
+				requested: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Replicas: &[]int32{1}[0],
+					},
+				},
+			},
+			reflect.TypeOf(apps.Deployment{}),
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -785,6 +876,42 @@ func Test_CreateRouteComparator(t *testing.T) {
 			reflect.TypeOf(routev1.Route{}),
 			false,
 		},
+		{
+			"HostAndWeightSetByTheServer",
+			args{
+				deployed: &routev1.Route{
+					Spec: routev1.RouteSpec{
+						Host: "test.apps.example.com",
+						To:   routev1.RouteTargetReference{Kind: "Service", Name: "test", Weight: &[]int32{100}[0]},
+					},
+				},
+				requested: &routev1.Route{
+					Spec: routev1.RouteSpec{
+						To: routev1.RouteTargetReference{Kind: "Service", Name: "test"},
+					},
+				},
+			},
+			reflect.TypeOf(routev1.Route{}),
+			true,
+		},
+		{
+			"AlternateBackendsChanged",
+			args{
+				deployed: &routev1.Route{
+					Spec: routev1.RouteSpec{
+						To: routev1.RouteTargetReference{Kind: "Service", Name: "test", Weight: &[]int32{100}[0]},
+					},
+				},
+				requested: &routev1.Route{
+					Spec: routev1.RouteSpec{
+						To:                routev1.RouteTargetReference{Kind: "Service", Name: "test", Weight: &[]int32{90}[0]},
+						AlternateBackends: []routev1.RouteTargetReference{{Kind: "Service", Name: "test-canary", Weight: &[]int32{10}[0]}},
+					},
+				},
+			},
+			reflect.TypeOf(routev1.Route{}),
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -803,3 +930,199 @@ func Test_CreateRouteComparator(t *testing.T) {
 		})
 	}
 }
+
+func Test_CreateIngressComparator(t *testing.T) {
+	type args struct {
+		deployed  client.Object
+		requested client.Object
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			"Equals",
+			args{
+				deployed: &networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      map[string]string{"app": "test"},
+						Annotations: map[string]string{"kubernetes.io/ingress.class": "nginx", "injected": "true"},
+					},
+					Spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "test.example.com"}}},
+				},
+				requested: &networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      map[string]string{"app": "test"},
+						Annotations: map[string]string{"kubernetes.io/ingress.class": "nginx"},
+					},
+					Spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "test.example.com"}}},
+				},
+			},
+			true,
+		},
+		{
+			"DifferentAnnotations",
+			args{
+				deployed: &networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      map[string]string{"app": "test"},
+						Annotations: map[string]string{"kubernetes.io/ingress.class": "nginx"},
+					},
+				},
+				requested: &networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      map[string]string{"app": "test"},
+						Annotations: map[string]string{"kubernetes.io/ingress.class": "traefik"},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"DifferentHost",
+			args{
+				deployed: &networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+					Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "test.example.com"}}},
+				},
+				requested: &networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+					Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "other.example.com"}}},
+				},
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := NewComparatorBuilder().
+				WithType(reflect.TypeOf(networkingv1.Ingress{})).
+				WithCustomComparator(CreateIngressComparator()).
+				Build()
+			if got(tt.args.deployed, tt.args.requested) != tt.want {
+				t.Errorf("CreateIngressComparator() = %v, want %v", !tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func Test_CreatePodDisruptionBudgetComparator(t *testing.T) {
+	one := intstr.FromInt(1)
+	half := intstr.FromString("50%")
+	type args struct {
+		deployed  client.Object
+		requested client.Object
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			"Equals",
+			args{
+				deployed: &policyv1beta1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test", "injected": "true"}},
+					Spec:       policyv1beta1.PodDisruptionBudgetSpec{MaxUnavailable: &one},
+				},
+				requested: &policyv1beta1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+					Spec:       policyv1beta1.PodDisruptionBudgetSpec{MaxUnavailable: &one},
+				},
+			},
+			true,
+		},
+		{
+			"DifferentBudget",
+			args{
+				deployed: &policyv1beta1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+					Spec:       policyv1beta1.PodDisruptionBudgetSpec{MaxUnavailable: &one},
+				},
+				requested: &policyv1beta1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+					Spec:       policyv1beta1.PodDisruptionBudgetSpec{MinAvailable: &half},
+				},
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := NewComparatorBuilder().
+				WithType(reflect.TypeOf(policyv1beta1.PodDisruptionBudget{})).
+				WithCustomComparator(CreatePodDisruptionBudgetComparator()).
+				Build()
+			if got(tt.args.deployed, tt.args.requested) != tt.want {
+				t.Errorf("CreatePodDisruptionBudgetComparator() = %v, want %v", !tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func Test_CreateKnativeServiceComparator(t *testing.T) {
+	newKnativeService := func(image string, env ...v1.EnvVar) *servingv1.Service {
+		return &servingv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+			Spec: servingv1.ServiceSpec{
+				Template: servingv1.RevisionTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+					Spec: servingv1.RevisionSpec{
+						PodSpec: v1.PodSpec{Containers: []v1.Container{{Name: "test", Image: image, Env: env}}},
+					},
+				},
+			},
+		}
+	}
+	latestRevision := true
+	fullTraffic := int64(100)
+	defaulted := newKnativeService("quay.io/kiegroup/test:1.0", v1.EnvVar{Name: "MY_VAR", Value: "my_value"}, v1.EnvVar{Name: "K_SINK", Value: "http://broker"})
+	defaulted.Spec.Traffic = []servingv1.TrafficTarget{{LatestRevision: &latestRevision, Percent: &fullTraffic}}
+	defaulted.Spec.Template.Spec.Containers[0].ReadinessProbe = &v1.Probe{SuccessThreshold: 1}
+	type args struct {
+		deployed  client.Object
+		requested client.Object
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			"DefaultedAndInjectedFields",
+			args{
+				deployed:  defaulted,
+				requested: newKnativeService("quay.io/kiegroup/test:1.0", v1.EnvVar{Name: "MY_VAR", Value: "my_value"}),
+			},
+			true,
+		},
+		{
+			"DifferentImage",
+			args{
+				deployed:  newKnativeService("quay.io/kiegroup/test:1.0"),
+				requested: newKnativeService("quay.io/kiegroup/test:2.0"),
+			},
+			false,
+		},
+		{
+			"DifferentEnv",
+			args{
+				deployed:  newKnativeService("quay.io/kiegroup/test:1.0", v1.EnvVar{Name: "MY_VAR", Value: "my_value"}),
+				requested: newKnativeService("quay.io/kiegroup/test:1.0", v1.EnvVar{Name: "MY_VAR", Value: "other_value"}),
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := NewComparatorBuilder().
+				WithType(reflect.TypeOf(servingv1.Service{})).
+				WithCustomComparator(CreateKnativeServiceComparator()).
+				Build()
+			if got(tt.args.deployed, tt.args.requested) != tt.want {
+				t.Errorf("CreateKnativeServiceComparator() = %v, want %v", !tt.want, tt.want)
+			}
+		})
+	}
+}
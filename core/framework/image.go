@@ -26,11 +26,18 @@ const (
 	// this is a super relax regexp, since we accept pretty much anything see the test cases on image_test.go
 	// see: https://github.com/docker/distribution/blob/main/reference/regexp.go
 	dockerTagRegx = `(?P<domain>(?:[a-z0-9]?:{0,1}\.?-?_?)+\/(?:(?:[a-z0-9]|[._]|__|[-]*)+\/)?)?(?P<image>[^:]+)(?P<tag>:.+)?`
+	// dockerReferenceRegx strictly matches a docker image reference ([domain[:port]/]name[:tag][@digest])
+	// following the grammar defined in https://github.com/docker/distribution/blob/main/reference/reference.go
+	dockerReferenceRegx = `^(?:(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*(?::[0-9]+)?\/)?` +
+		`[a-z0-9]+(?:(?:[._]|__|[-]*)[a-z0-9]+)*(?:\/[a-z0-9]+(?:(?:[._]|__|[-]*)[a-z0-9]+)*)*` +
+		`(?::[\w][\w.-]{0,127})?` +
+		`(?:@[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,})?$`
 )
 
 var (
 	// DockerTagRegxCompiled is the compiled regex to verify docker tag names
-	DockerTagRegxCompiled = *regexp.MustCompile(dockerTagRegx)
+	DockerTagRegxCompiled       = *regexp.MustCompile(dockerTagRegx)
+	dockerReferenceRegxCompiled = regexp.MustCompile(dockerReferenceRegx)
 )
 
 // ConvertImageTagToImage converts a plain string into an Image structure. For example, see https://regex101.com/r/1YX9rh/1.
@@ -90,3 +97,8 @@ func SplitImageTag(imageTag string) (domain, name, tag string) {
 	}
 	return
 }
+
+// IsValidImage verifies if the given image is a well formed docker image reference, like "quay.io/kiegroup/kogito-service:latest".
+func IsValidImage(image string) bool {
+	return dockerReferenceRegxCompiled.MatchString(image)
+}
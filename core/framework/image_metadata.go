@@ -32,6 +32,10 @@ const (
 	DefaultPortName = "http"
 	// DefaultExposedPort TODO: found an agnostic API to fetch the ImageRaw from the docker image and read this value from there.
 	DefaultExposedPort = 8080
+	// DefaultTLSPortName is the name of the port exposed by the services serving HTTPS
+	DefaultTLSPortName = "https"
+	// DefaultExposedTLSPort is the port exposed by the services serving HTTPS
+	DefaultExposedTLSPort = 8443
 	// LabelKeyOrgKie is the label key for KIE metadata
 	LabelKeyOrgKie = "org.kie" + labelNamespaceSep
 	// LabelKeyOrgKiePersistence is the label key for Persistence metadata
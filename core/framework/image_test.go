@@ -76,3 +76,31 @@ func TestFromImageToString(t *testing.T) {
 		})
 	}
 }
+
+func TestIsValidImage(t *testing.T) {
+	tests := []struct {
+		name  string
+		image string
+		want  bool
+	}{
+		{"name only", "myimage", true},
+		{"name and tag", "myimage:1.0", true},
+		{"registry, namespace and tag", "quay.io/kiegroup/kogito-data-index-infinispan:1.5", true},
+		{"registry with port", "localhost:6000/namespace/image", true},
+		{"IP and port", "10.10.2.1:5000/namespace/image:latest", true},
+		{"digest", "quay.io/kiegroup/image@sha256:9d1ee11c8c46e2b0b6e7e3a8e8a9dc3f9e2a5e2d95d0c1e4c7c4f2d6b1a0e3f4", true},
+		{"empty", "", false},
+		{"just tag", ":1.0", false},
+		{"uppercase name", "quay.io/kiegroup/MyImage", false},
+		{"empty tag", "myimage:", false},
+		{"whitespaces", "quay.io/kiegroup/my image", false},
+		{"double slash", "quay.io//image", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidImage(tt.image); got != tt.want {
+				t.Errorf("IsValidImage(%s) = %v, want %v", tt.image, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NamespaceNameLabelKey is the label set by Kubernetes on every namespace with its name
+const NamespaceNameLabelKey = "kubernetes.io/metadata.name"
+
+// NewNetworkPolicyPodPeer creates a NetworkPolicyPeer selecting the pods of the given Kogito service
+func NewNetworkPolicyPodPeer(serviceName string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{LabelAppKey: serviceName}},
+	}
+}
+
+// NewNetworkPolicyNamespacedPodPeer creates a NetworkPolicyPeer selecting the pods of the given Kogito service deployed in another namespace
+func NewNetworkPolicyNamespacedPodPeer(namespace, serviceName string) networkingv1.NetworkPolicyPeer {
+	peer := NewNetworkPolicyPodPeer(serviceName)
+	peer.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{NamespaceNameLabelKey: namespace}}
+	return peer
+}
+
+// AddNetworkPolicyIngressPeers allows the connections from the given peers in the NetworkPolicy.
+// Nothing is added without peers, since an ingress rule with no peers would allow every connection.
+func AddNetworkPolicyIngressPeers(networkPolicy *networkingv1.NetworkPolicy, peers ...networkingv1.NetworkPolicyPeer) {
+	if len(peers) == 0 {
+		return
+	}
+	networkPolicy.Spec.Ingress = append(networkPolicy.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{From: peers})
+}
+
+// AddNetworkPolicyEgressPeers allows the connections to the given peers in the NetworkPolicy.
+// Nothing is added without peers, since an egress rule with no peers would allow every connection.
+func AddNetworkPolicyEgressPeers(networkPolicy *networkingv1.NetworkPolicy, peers ...networkingv1.NetworkPolicyPeer) {
+	if len(peers) == 0 {
+		return
+	}
+	networkPolicy.Spec.Egress = append(networkPolicy.Spec.Egress, networkingv1.NetworkPolicyEgressRule{To: peers})
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"encoding/json"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// PatchPodTemplate strategic-merge-patches the given override onto the pod template.
+// Lists like containers, volumes or env are merged by name, fields left empty in the override are kept from the pod template.
+func PatchPodTemplate(template *v1.PodTemplateSpec, override *v1.PodTemplateSpec) error {
+	if override == nil {
+		return nil
+	}
+	original, err := json.Marshal(template)
+	if err != nil {
+		return err
+	}
+	patch, err := marshalWithoutNulls(override)
+	if err != nil {
+		return err
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, patch, v1.PodTemplateSpec{})
+	if err != nil {
+		return err
+	}
+	patchedTemplate := v1.PodTemplateSpec{}
+	if err = json.Unmarshal(patched, &patchedTemplate); err != nil {
+		return err
+	}
+	patchedTemplate.Spec.Containers = keepContainersOrder(template.Spec.Containers, patchedTemplate.Spec.Containers)
+	*template = patchedTemplate
+	return nil
+}
+
+// keepContainersOrder puts the rendered containers back in front of the added ones, the service container must stay the first one.
+// The strategic merge puts the containers in the order of the patch.
+func keepContainersOrder(rendered []v1.Container, patched []v1.Container) []v1.Container {
+	ordered := make([]v1.Container, 0, len(patched))
+	for _, container := range rendered {
+		for _, patchedContainer := range patched {
+			if patchedContainer.Name == container.Name {
+				ordered = append(ordered, patchedContainer)
+			}
+		}
+	}
+	for _, patchedContainer := range patched {
+		if !containsContainer(rendered, patchedContainer.Name) {
+			ordered = append(ordered, patchedContainer)
+		}
+	}
+	return ordered
+}
+
+func containsContainer(containers []v1.Container, name string) bool {
+	for _, container := range containers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// marshalWithoutNulls marshals the given object dropping the null fields, like containers not set in a typed pod template.
+// A null field in a strategic merge patch deletes the field instead of keeping it.
+func marshalWithoutNulls(object interface{}) ([]byte, error) {
+	marshaled, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err = json.Unmarshal(marshaled, &fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(dropNulls(fields))
+}
+
+func dropNulls(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key, field := range typedValue {
+			if field == nil {
+				delete(typedValue, key)
+			} else {
+				typedValue[key] = dropNulls(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range typedValue {
+			typedValue[i] = dropNulls(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPatchPodTemplate(t *testing.T) {
+	template := &v1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{LabelAppKey: "service"}},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "service", Image: "quay.io/kiegroup/service:latest", Env: []v1.EnvVar{{Name: "app", Value: "test"}}},
+			},
+		},
+	}
+	runAsNonRoot := true
+	override := &v1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "kogito"}},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{Name: "init", Image: "busybox"}},
+			Containers: []v1.Container{
+				{Name: "a-sidecar", Image: "envoy"},
+				{Name: "service", SecurityContext: &v1.SecurityContext{RunAsNonRoot: &runAsNonRoot}, Env: []v1.EnvVar{{Name: "extra", Value: "test"}}},
+			},
+			NodeSelector:      map[string]string{"zone": "a"},
+			Tolerations:       []v1.Toleration{{Key: "dedicated", Operator: v1.TolerationOpEqual, Value: "kogito"}},
+			PriorityClassName: "high",
+		},
+	}
+
+	assert.NoError(t, PatchPodTemplate(template, override))
+	assert.Equal(t, "service", template.Labels[LabelAppKey])
+	assert.Equal(t, "kogito", template.Labels["team"])
+	assert.Len(t, template.Spec.Containers, 2)
+	// the service container stays the first one
+	assert.Equal(t, "service", template.Spec.Containers[0].Name)
+	assert.Equal(t, "quay.io/kiegroup/service:latest", template.Spec.Containers[0].Image)
+	assert.Equal(t, &runAsNonRoot, template.Spec.Containers[0].SecurityContext.RunAsNonRoot)
+	assert.Len(t, template.Spec.Containers[0].Env, 2)
+	assert.Equal(t, "a-sidecar", template.Spec.Containers[1].Name)
+	assert.Len(t, template.Spec.InitContainers, 1)
+	assert.Equal(t, "a", template.Spec.NodeSelector["zone"])
+	assert.Len(t, template.Spec.Tolerations, 1)
+	assert.Equal(t, "high", template.Spec.PriorityClassName)
+}
+
+func TestPatchPodTemplate_NoOverride(t *testing.T) {
+	template := &v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "service"}}}}
+	assert.NoError(t, PatchPodTemplate(template, nil))
+	assert.NoError(t, PatchPodTemplate(template, &v1.PodTemplateSpec{}))
+	assert.Len(t, template.Spec.Containers, 1)
+	assert.Equal(t, "service", template.Spec.Containers[0].Name)
+}
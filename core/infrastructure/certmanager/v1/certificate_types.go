@@ -0,0 +1,98 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CertificateConditionReady indicates that the certificate is issued and stored in its Secret
+	CertificateConditionReady = "Ready"
+)
+
+// CertificateSpec defines the desired state of a Certificate.
+type CertificateSpec struct {
+	// CommonName is the common name to be used on the Certificate.
+	CommonName string `json:"commonName,omitempty"`
+	// DNSNames is a list of DNS subjectAltNames to be set on the Certificate.
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// SecretName is the name of the Secret resource that will be automatically created and managed by this Certificate resource.
+	SecretName string `json:"secretName"`
+	// IssuerRef is a reference to the issuer for this certificate.
+	IssuerRef ObjectReference `json:"issuerRef"`
+}
+
+// ObjectReference is a reference to an object with a given name, kind and group.
+type ObjectReference struct {
+	// Name of the resource being referred to.
+	Name string `json:"name"`
+	// Kind of the resource being referred to.
+	Kind string `json:"kind,omitempty"`
+	// Group of the resource being referred to.
+	Group string `json:"group,omitempty"`
+}
+
+// CertificateCondition contains condition information for a Certificate.
+type CertificateCondition struct {
+	// Type of the condition, known values are ('Ready', `Issuing`).
+	Type string `json:"type"`
+	// Status of the condition, one of ('True', 'False', 'Unknown').
+	Status metav1.ConditionStatus `json:"status"`
+	// Reason is a brief machine readable explanation for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of the details of the last transition, complementing reason.
+	Message string `json:"message,omitempty"`
+}
+
+// CertificateStatus defines the observed state of a Certificate.
+type CertificateStatus struct {
+	// List of status conditions to indicate the status of certificates.
+	Conditions []CertificateCondition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Certificate is the Schema for the certificates API
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateSpec   `json:"spec,omitempty"`
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// IsReady returns true when the certificate is issued and stored in its Secret
+func (c *Certificate) IsReady() bool {
+	for _, condition := range c.Status.Conditions {
+		if condition.Type == CertificateConditionReady {
+			return condition.Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateList contains a list of Certificate
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Certificate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Certificate{}, &CertificateList{})
+}
@@ -17,11 +17,17 @@ package infrastructure
 import (
 	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"reflect"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// serverSideApplyEnvVar enables the server-side apply of the added and updated resources, instead of full object writes
+	serverSideApplyEnvVar = "SERVER_SIDE_APPLY"
+)
+
 // DeltaProcessor ...
 type DeltaProcessor interface {
 	ProcessDelta(comparator compare.MapComparator, requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (isDeltaProcessed bool, err error)
@@ -39,6 +45,9 @@ func NewDeltaProcessor(context operator.Context) DeltaProcessor {
 }
 
 func (d *deltaProcessor) ProcessDelta(comparator compare.MapComparator, requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (isDeltaProcessed bool, err error) {
+	// comparators copy the deployed values (injected variables, autoscaled replicas, ...) into the requested objects,
+	// so keep the rendered objects around to avoid taking ownership of those fields when applying
+	renderedResources := copyResources(requestedResources)
 	deltas := comparator.Compare(deployedResources, requestedResources)
 	for resourceType, delta := range deltas {
 		if !delta.HasChanges() {
@@ -47,12 +56,19 @@ func (d *deltaProcessor) ProcessDelta(comparator compare.MapComparator, requeste
 		}
 		d.Log.Info("Will", "create", len(delta.Added), "update", len(delta.Updated), "delete", len(delta.Removed), "resourceType", resourceType)
 
-		if _, err = kubernetes.ResourceC(d.Client).CreateResources(delta.Added); err != nil {
-			return
-		}
+		if IsServerSideApplyEnabled() {
+			appliedResources := getRenderedResources(renderedResources[resourceType], append(delta.Added, delta.Updated...))
+			if _, err = kubernetes.ResourceC(d.Client).ApplyResources(appliedResources); err != nil {
+				return
+			}
+		} else {
+			if _, err = kubernetes.ResourceC(d.Client).CreateResources(delta.Added); err != nil {
+				return
+			}
 
-		if _, err = kubernetes.ResourceC(d.Client).UpdateResources(deployedResources[resourceType], delta.Updated); err != nil {
-			return
+			if _, err = kubernetes.ResourceC(d.Client).UpdateResources(deployedResources[resourceType], delta.Updated); err != nil {
+				return
+			}
 		}
 
 		if _, err = kubernetes.ResourceC(d.Client).DeleteResources(delta.Removed); err != nil {
@@ -63,3 +79,35 @@ func (d *deltaProcessor) ProcessDelta(comparator compare.MapComparator, requeste
 	}
 	return
 }
+
+func copyResources(resources map[reflect.Type][]client.Object) map[reflect.Type][]client.Object {
+	copied := make(map[reflect.Type][]client.Object, len(resources))
+	for resourceType, objects := range resources {
+		for _, object := range objects {
+			copied[resourceType] = append(copied[resourceType], object.DeepCopyObject().(client.Object))
+		}
+	}
+	return copied
+}
+
+// getRenderedResources returns the rendered version of each of the given objects
+func getRenderedResources(renderedResources []client.Object, objects []client.Object) []client.Object {
+	var resources []client.Object
+	for _, object := range objects {
+		rendered := object
+		for _, renderedResource := range renderedResources {
+			if renderedResource.GetName() == object.GetName() && renderedResource.GetNamespace() == object.GetNamespace() {
+				rendered = renderedResource
+				break
+			}
+		}
+		resources = append(resources, rendered)
+	}
+	return resources
+}
+
+// IsServerSideApplyEnabled returns true when the operator applies its resources with server-side apply,
+// owning only the fields it renders instead of overwriting the whole objects
+func IsServerSideApplyEnabled() bool {
+	return util.GetBoolOSEnv(serverSideApplyEnvVar)
+}
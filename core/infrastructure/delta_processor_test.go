@@ -0,0 +1,79 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type applyRecorder struct {
+	client.Client
+	applied []client.Object
+}
+
+func (a *applyRecorder) Patch(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	a.applied = append(a.applied, obj)
+	return nil
+}
+
+func Test_deltaProcessor_ProcessDelta_ServerSideApply(t *testing.T) {
+	assert.NoError(t, os.Setenv(serverSideApplyEnvVar, "true"))
+	defer os.Unsetenv(serverSideApplyEnvVar)
+
+	deployed := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: t.Name(), Labels: map[string]string{"injected": "true"}},
+		Data:       map[string]string{"key": "old"},
+	}
+	requested := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: t.Name()},
+		Data:       map[string]string{"key": "new"},
+	}
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(corev1.ConfigMap{})).
+			WithCustomComparator(func(deployed client.Object, requested client.Object) bool {
+				// keeps the values injected by third parties, like most of the comparators do
+				requested.SetLabels(deployed.GetLabels())
+				return false
+			}).
+			Build())
+
+	cli := &applyRecorder{Client: test.NewFakeClientBuilder().Build().ControlCli}
+	context := operator.Context{Client: &kogitocli.Client{ControlCli: cli}, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+	processed, err := NewDeltaProcessor(context).ProcessDelta(
+		compare.MapComparator{Comparator: resourceComparator},
+		map[reflect.Type][]client.Object{reflect.TypeOf(corev1.ConfigMap{}): {requested}},
+		map[reflect.Type][]client.Object{reflect.TypeOf(corev1.ConfigMap{}): {deployed}})
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Len(t, cli.applied, 1)
+	assert.Equal(t, "new", cli.applied[0].(*corev1.ConfigMap).Data["key"])
+	assert.Empty(t, cli.applied[0].GetLabels())
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// DestinationRuleHandler ...
+type DestinationRuleHandler interface {
+	FetchDestinationRule(key types.NamespacedName) (*istiov1beta1.DestinationRule, error)
+	CreateDestinationRule(instance api.KogitoService, host string) *istiov1beta1.DestinationRule
+	GetComparator() compare.MapComparator
+}
+
+type destinationRuleHandler struct {
+	operator.Context
+}
+
+// NewDestinationRuleHandler ...
+func NewDestinationRuleHandler(context operator.Context) DestinationRuleHandler {
+	return &destinationRuleHandler{
+		context,
+	}
+}
+
+func (d *destinationRuleHandler) FetchDestinationRule(key types.NamespacedName) (*istiov1beta1.DestinationRule, error) {
+	destinationRule := &istiov1beta1.DestinationRule{}
+	exists, err := kubernetes.ResourceC(d.Client).FetchWithKey(key, destinationRule)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return destinationRule, nil
+}
+
+// CreateDestinationRule creates the DestinationRule applying the traffic policy of the given instance to the given Service host
+func (d *destinationRuleHandler) CreateDestinationRule(instance api.KogitoService, host string) *istiov1beta1.DestinationRule {
+	trafficPolicy := &istiov1beta1.TrafficPolicy{
+		TLS: &istiov1beta1.ClientTLSSettings{Mode: string(api.IstioMeshMutualTLSMode)},
+	}
+	if istio := instance.GetSpec().GetIstio(); istio != nil {
+		trafficPolicy.TLS.Mode = string(istio.GetTLSMode())
+		if connectionPool := istio.GetConnectionPool(); connectionPool != nil {
+			trafficPolicy.ConnectionPool = createConnectionPoolSettings(connectionPool)
+		}
+		if outlierDetection := istio.GetOutlierDetection(); outlierDetection != nil {
+			trafficPolicy.OutlierDetection = &istiov1beta1.OutlierDetection{
+				Consecutive5xxErrors: outlierDetection.GetConsecutive5xxErrors(),
+				Interval:             outlierDetection.GetInterval(),
+				BaseEjectionTime:     outlierDetection.GetBaseEjectionTime(),
+				MaxEjectionPercent:   outlierDetection.GetMaxEjectionPercent(),
+			}
+		}
+	}
+	return &istiov1beta1.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      host,
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: istiov1beta1.DestinationRuleSpec{
+			Host:          host,
+			TrafficPolicy: trafficPolicy,
+		},
+	}
+}
+
+func createConnectionPoolSettings(connectionPool api.IstioConnectionPoolInterface) *istiov1beta1.ConnectionPoolSettings {
+	settings := &istiov1beta1.ConnectionPoolSettings{}
+	if connectionPool.GetMaxConnections() > 0 {
+		settings.TCP = &istiov1beta1.TCPSettings{MaxConnections: connectionPool.GetMaxConnections()}
+	}
+	if connectionPool.GetHTTP1MaxPendingRequests() > 0 || connectionPool.GetMaxRequestsPerConnection() > 0 {
+		settings.HTTP = &istiov1beta1.HTTPSettings{
+			HTTP1MaxPendingRequests:  connectionPool.GetHTTP1MaxPendingRequests(),
+			MaxRequestsPerConnection: connectionPool.GetMaxRequestsPerConnection(),
+		}
+	}
+	return settings
+}
+
+func (d *destinationRuleHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(istiov1beta1.DestinationRule{})).
+			WithCustomComparator(framework.CreateDestinationRuleComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	istioGatewayPort     = 80
+	istioGatewayProtocol = "HTTP"
+)
+
+// defaultIstioGatewaySelector selects the default ingress gateway deployed by Istio
+var defaultIstioGatewaySelector = map[string]string{"istio": "ingressgateway"}
+
+// GatewayHandler ...
+type GatewayHandler interface {
+	FetchGateway(key types.NamespacedName) (*istiov1beta1.Gateway, error)
+	CreateGateway(instance api.KogitoService) *istiov1beta1.Gateway
+	GetComparator() compare.MapComparator
+}
+
+type gatewayHandler struct {
+	operator.Context
+}
+
+// NewGatewayHandler ...
+func NewGatewayHandler(context operator.Context) GatewayHandler {
+	return &gatewayHandler{
+		context,
+	}
+}
+
+func (g *gatewayHandler) FetchGateway(key types.NamespacedName) (*istiov1beta1.Gateway, error) {
+	gateway := &istiov1beta1.Gateway{}
+	exists, err := kubernetes.ResourceC(g.Client).FetchWithKey(key, gateway)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return gateway, nil
+}
+
+// CreateGateway creates the Gateway exposing the hosts of the given instance out of the mesh. Nil if the instance has no Gateway configured.
+func (g *gatewayHandler) CreateGateway(instance api.KogitoService) *istiov1beta1.Gateway {
+	istio := instance.GetSpec().GetIstio()
+	if istio == nil || istio.GetGateway() == nil {
+		return nil
+	}
+	selector := istio.GetGateway().GetSelector()
+	if len(selector) == 0 {
+		selector = defaultIstioGatewaySelector
+	}
+	return &istiov1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: istiov1beta1.GatewaySpec{
+			Selector: selector,
+			Servers: []istiov1beta1.Server{
+				{
+					Port:  istiov1beta1.Port{Number: istioGatewayPort, Protocol: istioGatewayProtocol, Name: framework.DefaultPortName},
+					Hosts: istio.GetGateway().GetHosts(),
+				},
+			},
+		},
+	}
+}
+
+func (g *gatewayHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(istiov1beta1.Gateway{})).
+			WithCustomComparator(framework.CreateGatewayComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// HorizontalPodAutoscalerHandler ...
+type HorizontalPodAutoscalerHandler interface {
+	FetchHorizontalPodAutoscaler(key types.NamespacedName) (*autoscalingv2beta2.HorizontalPodAutoscaler, error)
+	CreateHorizontalPodAutoscaler(instance api.KogitoService) *autoscalingv2beta2.HorizontalPodAutoscaler
+	GetComparator() compare.MapComparator
+}
+
+type horizontalPodAutoscalerHandler struct {
+	operator.Context
+}
+
+// NewHorizontalPodAutoscalerHandler ...
+func NewHorizontalPodAutoscalerHandler(context operator.Context) HorizontalPodAutoscalerHandler {
+	return &horizontalPodAutoscalerHandler{
+		context,
+	}
+}
+
+func (h *horizontalPodAutoscalerHandler) FetchHorizontalPodAutoscaler(key types.NamespacedName) (*autoscalingv2beta2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2beta2.HorizontalPodAutoscaler{}
+	exists, err := kubernetes.ResourceC(h.Client).FetchWithKey(key, hpa)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return hpa, nil
+}
+
+// CreateHorizontalPodAutoscaler creates a new HorizontalPodAutoscaler targeting the Deployment of the given instance
+func (h *horizontalPodAutoscalerHandler) CreateHorizontalPodAutoscaler(instance api.KogitoService) *autoscalingv2beta2.HorizontalPodAutoscaler {
+	autoscaling := instance.GetSpec().GetAutoscaling()
+	minReplicas := api.AutoscalingDefaultMinReplicas
+	if autoscaling.GetMinReplicas() != nil {
+		minReplicas = *autoscaling.GetMinReplicas()
+	}
+	var metrics []autoscalingv2beta2.MetricSpec
+	if autoscaling.GetTargetCPUUtilizationPercentage() != nil {
+		metrics = append(metrics, newResourceMetric(corev1.ResourceCPU, autoscaling.GetTargetCPUUtilizationPercentage()))
+	}
+	if autoscaling.GetTargetMemoryUtilizationPercentage() != nil {
+		metrics = append(metrics, newResourceMetric(corev1.ResourceMemory, autoscaling.GetTargetMemoryUtilizationPercentage()))
+	}
+	for _, metric := range autoscaling.GetMetrics() {
+		targetAverageValue := metric.GetTargetAverageValue()
+		metrics = append(metrics, autoscalingv2beta2.MetricSpec{
+			Type: autoscalingv2beta2.PodsMetricSourceType,
+			Pods: &autoscalingv2beta2.PodsMetricSource{
+				Metric: autoscalingv2beta2.MetricIdentifier{Name: metric.GetName()},
+				Target: autoscalingv2beta2.MetricTarget{
+					Type:         autoscalingv2beta2.AverageValueMetricType,
+					AverageValue: &targetAverageValue,
+				},
+			},
+		})
+	}
+	return &autoscalingv2beta2.HorizontalPodAutoscaler{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       instance.GetName(),
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: autoscaling.GetMaxReplicas(),
+			Metrics:     metrics,
+		},
+	}
+}
+
+func newResourceMetric(name corev1.ResourceName, averageUtilization *int32) autoscalingv2beta2.MetricSpec {
+	return autoscalingv2beta2.MetricSpec{
+		Type: autoscalingv2beta2.ResourceMetricSourceType,
+		Resource: &autoscalingv2beta2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2beta2.MetricTarget{
+				Type:               autoscalingv2beta2.UtilizationMetricType,
+				AverageUtilization: averageUtilization,
+			},
+		},
+	}
+}
+
+func (h *horizontalPodAutoscalerHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(autoscalingv2beta2.HorizontalPodAutoscaler{})).
+			WithCustomComparator(framework.CreateHorizontalPodAutoscalerComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
@@ -28,6 +28,8 @@ import (
 const (
 	annotationKeyImageTriggers         = "image.openshift.io/triggers"
 	annotationValueImageTriggersFormat = "[{\"from\":{\"kind\":\"ImageStreamTag\",\"name\":\"%s\"},\"fieldPath\":\"spec.template.spec.containers[?(@.name==\\\"%s\\\")].image\"}]"
+	// annotationValuePausedImageTriggersFormat the trigger doesn't set the new images of the tag to the containers while paused
+	annotationValuePausedImageTriggersFormat = "[{\"from\":{\"kind\":\"ImageStreamTag\",\"name\":\"%s\"},\"fieldPath\":\"spec.template.spec.containers[?(@.name==\\\"%s\\\")].image\",\"paused\":\"true\"}]"
 
 	versionSeparator = "."
 	// LatestTag the default name for latest image tag
@@ -45,6 +47,8 @@ type ImageHandler interface {
 	ResolveImage() (string, error)
 	ResolveImageNameTag() string
 	ResolveImageStreamTriggerAnnotation(containerName string) (key, value string)
+	// ResolvePausedImageStreamTriggerAnnotation resolves the image stream trigger of the given container, keeping its current image
+	ResolvePausedImageStreamTriggerAnnotation(containerName string) (key, value string)
 	CreateImageStreamIfNotExists() (*imgv1.ImageStream, error)
 	ReconcileImageStream(owner client.Object) error
 }
@@ -149,6 +153,13 @@ func (i *imageHandler) ResolveImageStreamTriggerAnnotation(containerName string)
 	return
 }
 
+func (i *imageHandler) ResolvePausedImageStreamTriggerAnnotation(containerName string) (key, value string) {
+	imageNameTag := i.ResolveImageNameTag()
+	key = annotationKeyImageTriggers
+	value = fmt.Sprintf(annotationValuePausedImageTriggersFormat, imageNameTag, containerName)
+	return
+}
+
 // GetKogitoImageVersion gets the Kogito Runtime latest micro version based on the given version
 // E.g. Operator version is 0.9.0, the latest image version is 0.9.x-latest
 // unit test friendly unexported function
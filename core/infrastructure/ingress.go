@@ -0,0 +1,164 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	networkingv1 "k8s.io/api/networking/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	ingressRootPath = "/"
+	httpsScheme     = "https"
+	httpScheme      = "http"
+	// backendProtocolAnnotation sets the protocol used by the NGINX ingress controller to reach the service
+	backendProtocolAnnotation = "nginx.ingress.kubernetes.io/backend-protocol"
+)
+
+// IngressHandler ...
+type IngressHandler interface {
+	FetchIngress(key types.NamespacedName) (*networkingv1.Ingress, error)
+	GetURIFromIngress(ingressKey types.NamespacedName) (string, error)
+	CreateIngress(instance api.KogitoService) *networkingv1.Ingress
+	GetComparator() compare.MapComparator
+}
+
+type ingressHandler struct {
+	operator.Context
+}
+
+// NewIngressHandler ...
+func NewIngressHandler(context operator.Context) IngressHandler {
+	return &ingressHandler{
+		context,
+	}
+}
+
+func (i *ingressHandler) FetchIngress(key types.NamespacedName) (*networkingv1.Ingress, error) {
+	ingress := &networkingv1.Ingress{}
+	exists, err := kubernetes.ResourceC(i.Client).FetchWithKey(key, ingress)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return ingress, nil
+}
+
+// GetURIFromIngress gets the URI where the service is reachable through the given Ingress.
+// The host defined in the Ingress rules takes precedence over the address assigned by the load balancer.
+func (i *ingressHandler) GetURIFromIngress(ingressKey types.NamespacedName) (string, error) {
+	ingress, err := i.FetchIngress(ingressKey)
+	if err != nil || ingress == nil {
+		return "", err
+	}
+	host := ""
+	if len(ingress.Spec.Rules) > 0 {
+		host = ingress.Spec.Rules[0].Host
+	}
+	if len(host) == 0 {
+		for _, lbIngress := range ingress.Status.LoadBalancer.Ingress {
+			if len(lbIngress.Hostname) > 0 {
+				host = lbIngress.Hostname
+			} else {
+				host = lbIngress.IP
+			}
+			if len(host) > 0 {
+				break
+			}
+		}
+	}
+	if len(host) == 0 {
+		return "", nil
+	}
+	scheme := httpScheme
+	if len(ingress.Spec.TLS) > 0 {
+		scheme = httpsScheme
+	}
+	return fmt.Sprintf("%s://%s", scheme, host), nil
+}
+
+// CreateIngress creates a new Ingress resource pointing to the Service of the given instance
+func (i *ingressHandler) CreateIngress(instance api.KogitoService) *networkingv1.Ingress {
+	ingressSpec := instance.GetSpec().GetIngress()
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        instance.GetName(),
+			Namespace:   instance.GetNamespace(),
+			Labels:      map[string]string{framework.LabelAppKey: instance.GetName()},
+			Annotations: ingressSpec.GetAnnotations(),
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ingressSpec.GetIngressClassName(),
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: ingressSpec.GetHost(),
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     ingressRootPath,
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: instance.GetName(),
+											Port: networkingv1.ServiceBackendPort{Name: getServicePortName(instance)},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if IsTLSEnabled(instance) {
+		annotations := map[string]string{backendProtocolAnnotation: "HTTPS"}
+		for key, value := range ingress.Annotations {
+			annotations[key] = value
+		}
+		ingress.Annotations = annotations
+	}
+	if len(ingressSpec.GetTLSSecret()) > 0 {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{
+			{
+				Hosts:      []string{ingressSpec.GetHost()},
+				SecretName: ingressSpec.GetTLSSecret(),
+			},
+		}
+	}
+	return ingress
+}
+
+func (i *ingressHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(networkingv1.Ingress{})).
+			WithCustomComparator(framework.CreateIngressComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
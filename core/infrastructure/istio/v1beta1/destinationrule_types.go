@@ -0,0 +1,100 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DestinationRuleSpec defines the policies applied to the traffic intended for a service after routing has occurred.
+type DestinationRuleSpec struct {
+	// The name of a service from the service registry.
+	Host string `json:"host"`
+	// Traffic policies to apply (load balancing policy, connection pool sizes, outlier detection).
+	TrafficPolicy *TrafficPolicy `json:"trafficPolicy,omitempty"`
+}
+
+// TrafficPolicy defines the traffic policies to apply for a specific destination.
+type TrafficPolicy struct {
+	// Settings controlling the volume of connections to an upstream service.
+	ConnectionPool *ConnectionPoolSettings `json:"connectionPool,omitempty"`
+	// Settings controlling eviction of unhealthy hosts from the load balancing pool.
+	OutlierDetection *OutlierDetection `json:"outlierDetection,omitempty"`
+	// TLS related settings for connections to the upstream service.
+	TLS *ClientTLSSettings `json:"tls,omitempty"`
+}
+
+// ConnectionPoolSettings defines the connection pool settings for an upstream host.
+type ConnectionPoolSettings struct {
+	// Settings common to both HTTP and TCP upstream connections.
+	TCP *TCPSettings `json:"tcp,omitempty"`
+	// HTTP connection pool settings.
+	HTTP *HTTPSettings `json:"http,omitempty"`
+}
+
+// TCPSettings defines the settings common to both HTTP and TCP upstream connections.
+type TCPSettings struct {
+	// Maximum number of HTTP1 /TCP connections to a destination host.
+	MaxConnections int32 `json:"maxConnections,omitempty"`
+}
+
+// HTTPSettings defines the settings applicable to HTTP1.1/HTTP2/GRPC connections.
+type HTTPSettings struct {
+	// Maximum number of requests that will be queued while waiting for a ready connection pool connection.
+	HTTP1MaxPendingRequests int32 `json:"http1MaxPendingRequests,omitempty"`
+	// Maximum number of requests per connection to a backend.
+	MaxRequestsPerConnection int32 `json:"maxRequestsPerConnection,omitempty"`
+}
+
+// OutlierDetection defines the circuit breaker tracking the status of each individual host in the upstream service.
+type OutlierDetection struct {
+	// Number of 5xx errors before a host is ejected from the connection pool.
+	Consecutive5xxErrors int32 `json:"consecutive5xxErrors,omitempty"`
+	// Time interval between ejection sweep analysis.
+	Interval string `json:"interval,omitempty"`
+	// Minimum ejection duration.
+	BaseEjectionTime string `json:"baseEjectionTime,omitempty"`
+	// Maximum % of hosts in the load balancing pool for the upstream service that can be ejected.
+	MaxEjectionPercent int32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// ClientTLSSettings defines the SSL/TLS related settings for upstream connections.
+type ClientTLSSettings struct {
+	// Indicates whether connections to this port should be secured using TLS.
+	Mode string `json:"mode,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DestinationRule is the Schema for the destinationrules API
+type DestinationRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DestinationRuleSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DestinationRuleList contains a list of DestinationRule
+type DestinationRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DestinationRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DestinationRule{}, &DestinationRuleList{})
+}
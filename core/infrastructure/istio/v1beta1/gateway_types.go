@@ -0,0 +1,68 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GatewaySpec describes a load balancer operating at the edge of the mesh receiving incoming HTTP/TCP connections.
+type GatewaySpec struct {
+	// A list of server specifications.
+	Servers []Server `json:"servers,omitempty"`
+	// One or more labels that indicate a specific set of pods/VMs on which this gateway configuration should be applied.
+	Selector map[string]string `json:"selector,omitempty"`
+}
+
+// Server describes the properties of the proxy on a given load balancer port.
+type Server struct {
+	// The Port on which the proxy should listen for incoming connections.
+	Port Port `json:"port"`
+	// One or more hosts exposed by this gateway.
+	Hosts []string `json:"hosts"`
+}
+
+// Port describes the properties of a specific port of a service.
+type Port struct {
+	// A valid non-negative integer port number.
+	Number uint32 `json:"number"`
+	// The protocol exposed on the port.
+	Protocol string `json:"protocol"`
+	// Label assigned to the port.
+	Name string `json:"name,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Gateway is the Schema for the gateways API
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GatewaySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayList contains a list of Gateway
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Gateway `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Gateway{}, &GatewayList{})
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualServiceSpec defines the routing rules applied to the traffic addressed to a set of hosts in the mesh.
+type VirtualServiceSpec struct {
+	// The destination hosts to which traffic is being sent.
+	Hosts []string `json:"hosts,omitempty"`
+	// The names of gateways and sidecars that should apply these routes.
+	Gateways []string `json:"gateways,omitempty"`
+	// An ordered list of route rules for HTTP traffic.
+	HTTP []HTTPRoute `json:"http,omitempty"`
+}
+
+// HTTPRoute describes match conditions and actions for routing HTTP traffic.
+type HTTPRoute struct {
+	// The name assigned to the route for debugging purposes.
+	Name string `json:"name,omitempty"`
+	// A HTTP rule can either redirect or forward (default) traffic, to one or more weighted destinations.
+	Route []HTTPRouteDestination `json:"route,omitempty"`
+}
+
+// HTTPRouteDestination is a destination of the HTTP traffic with the share of the traffic it receives.
+type HTTPRouteDestination struct {
+	// Destination uniquely identifies the instances of a service to which the request should be forwarded to.
+	Destination Destination `json:"destination"`
+	// Weight specifies the relative proportion of traffic to be forwarded to the destination.
+	Weight int32 `json:"weight,omitempty"`
+}
+
+// Destination indicates the network addressable service to which the request will be sent after processing a routing rule.
+type Destination struct {
+	// The name of a service from the service registry.
+	Host string `json:"host"`
+	// The name of a subset within the service, defined by a DestinationRule.
+	Subset string `json:"subset,omitempty"`
+	// Specifies the port on the host that is being addressed.
+	Port *PortSelector `json:"port,omitempty"`
+}
+
+// PortSelector specifies the number of a port to be used for matching or selection for final routing.
+type PortSelector struct {
+	// Valid port number
+	Number uint32 `json:"number,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualService is the Schema for the virtualservices API
+type VirtualService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualServiceSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualServiceList contains a list of VirtualService
+type VirtualServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualService `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VirtualService{}, &VirtualServiceList{})
+}
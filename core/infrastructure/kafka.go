@@ -16,8 +16,12 @@ package infrastructure
 
 import (
 	"fmt"
+	"reflect"
 
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +40,25 @@ const (
 
 	// KafkaInstanceName is the default name for the Kafka cluster managed by KogitoInfra
 	KafkaInstanceName = "kogito-kafka"
+
+	// KafkaDefaultListener is the name of the listener used to connect to the Kafka cluster when none is given
+	KafkaDefaultListener = "plain"
+
+	// KafkaClusterCACertKey is the key holding the cluster CA certificate in the Secret generated by Strimzi
+	KafkaClusterCACertKey = "ca.crt"
+	// KafkaUserSaslJaasConfigKey is the key holding the JAAS configuration in the Secret generated by Strimzi for a SCRAM-SHA-512 KafkaUser
+	KafkaUserSaslJaasConfigKey = "sasl.jaas.config"
+	// KafkaUserKeyStoreKey is the key holding the PKCS12 keystore in the Secret generated by Strimzi for a TLS KafkaUser
+	KafkaUserKeyStoreKey = "user.p12"
+	// KafkaUserKeyStorePasswordKey is the key holding the password of the keystore in the Secret generated by Strimzi for a TLS KafkaUser
+	KafkaUserKeyStorePasswordKey = "user.password"
+
+	kafkaClusterCACertSecretName = "%s-cluster-ca-cert"
+	kafkaACLReadOperation        = "Read"
+)
+
+var (
+	kafkaTopicACLOperations = []string{"Describe", kafkaACLReadOperation, "Write"}
 )
 
 var (
@@ -48,8 +71,12 @@ type KafkaHandler interface {
 	IsStrimziAvailable() bool
 	FetchKafkaInstance(key types.NamespacedName) (*v1beta2.Kafka, error)
 	FetchKafkaTopic(key types.NamespacedName) (*v1beta2.KafkaTopic, error)
-	CreateKafkaTopic(topicName, kafkaName, kafkaNamespace string) (*v1beta2.KafkaTopic, error)
-	ResolveKafkaServerURI(kafka *v1beta2.Kafka) (string, error)
+	CreateKafkaTopic(topicName, kafkaName, kafkaNamespace string, topicConfig api.KafkaTopicInterface) (*v1beta2.KafkaTopic, error)
+	UpdateKafkaTopic(kafkaTopic *v1beta2.KafkaTopic, topicConfig api.KafkaTopicInterface) (updated bool, err error)
+	ResolveKafkaServerURI(kafka *v1beta2.Kafka, listenerName string) (string, error)
+	FetchKafkaUser(key types.NamespacedName) (*v1beta2.KafkaUser, error)
+	CreateKafkaUser(name string, kafka *v1beta2.Kafka, authentication v1beta2.KafkaAuthenticationType, topics []string) *v1beta2.KafkaUser
+	GetKafkaUserComparator() compare.MapComparator
 }
 
 type kafkaHandler struct {
@@ -97,9 +124,10 @@ func (k *kafkaHandler) FetchKafkaTopic(key types.NamespacedName) (*v1beta2.Kafka
 	return nil, nil
 }
 
-func (k *kafkaHandler) CreateKafkaTopic(topicName, kafkaName, kafkaNamespace string) (*v1beta2.KafkaTopic, error) {
+func (k *kafkaHandler) CreateKafkaTopic(topicName, kafkaName, kafkaNamespace string, topicConfig api.KafkaTopicInterface) (*v1beta2.KafkaTopic, error) {
 	k.Log.Debug("Going to create kafka topic", "topicName", topicName)
 	kafkaTopic := getKafkaTopic(topicName, kafkaNamespace, kafkaName)
+	applyKafkaTopicConfig(kafkaTopic, topicConfig)
 	if err := kubernetes.ResourceC(k.Client).Create(kafkaTopic); err != nil {
 		k.Log.Error(err, "Error occurs while creating kogito Kafka topic")
 		return nil, err
@@ -108,6 +136,30 @@ func (k *kafkaHandler) CreateKafkaTopic(topicName, kafkaName, kafkaNamespace str
 	return kafkaTopic, nil
 }
 
+// UpdateKafkaTopic updates the given deployed Kafka topic if its partitions, replicas or config differ from the given configuration.
+// Kafka doesn't support decreasing the number of partitions, the deployed partitions are kept in that case.
+func (k *kafkaHandler) UpdateKafkaTopic(kafkaTopic *v1beta2.KafkaTopic, topicConfig api.KafkaTopicInterface) (updated bool, err error) {
+	if topicConfig == nil {
+		return false, nil
+	}
+	requested := kafkaTopic.DeepCopy()
+	applyKafkaTopicConfig(requested, topicConfig)
+	if requested.Spec.Partitions < kafkaTopic.Spec.Partitions {
+		k.Log.Warn("Kafka topic partitions can't be decreased, keeping the deployed ones", "topicName", kafkaTopic.Name, "partitions", kafkaTopic.Spec.Partitions)
+		requested.Spec.Partitions = kafkaTopic.Spec.Partitions
+	}
+	if reflect.DeepEqual(requested.Spec, kafkaTopic.Spec) {
+		return false, nil
+	}
+	k.Log.Info("Kafka topic configuration changed, updating", "topicName", kafkaTopic.Name)
+	if err := kubernetes.ResourceC(k.Client).Update(requested); err != nil {
+		k.Log.Error(err, "Error occurs while updating kogito Kafka topic")
+		return false, err
+	}
+	requested.DeepCopyInto(kafkaTopic)
+	return true, nil
+}
+
 // getKafkaTopic returns a Kafka topic resource with default configuration
 func getKafkaTopic(name, namespace, kafkaBroker string) *v1beta2.KafkaTopic {
 
@@ -128,35 +180,137 @@ func getKafkaTopic(name, namespace, kafkaBroker string) *v1beta2.KafkaTopic {
 	}
 }
 
-// ResolveKafkaServerURI returns the uri of the kafka instance
-func (k *kafkaHandler) ResolveKafkaServerURI(kafka *v1beta2.Kafka) (string, error) {
-	k.Log.Debug("Resolving kafka URI", "kafka instance", kafka.Name)
-	kafkaURI := ResolveKafkaServerURI(kafka)
+// applyKafkaTopicConfig overrides the spec of the given Kafka topic with the given configuration, unset values fall back to the defaults
+func applyKafkaTopicConfig(kafkaTopic *v1beta2.KafkaTopic, topicConfig api.KafkaTopicInterface) {
+	if topicConfig == nil {
+		return
+	}
+	kafkaTopic.Spec.Partitions = defaultKafkaTopicPartition
+	if topicConfig.GetPartitions() > 0 {
+		kafkaTopic.Spec.Partitions = topicConfig.GetPartitions()
+	}
+	kafkaTopic.Spec.Replicas = defaultKafkaTopicReplicas
+	if topicConfig.GetReplicas() > 0 {
+		kafkaTopic.Spec.Replicas = topicConfig.GetReplicas()
+	}
+	kafkaTopic.Spec.Config = nil
+	if len(topicConfig.GetConfig()) > 0 {
+		kafkaTopic.Spec.Config = make(map[string]string, len(topicConfig.GetConfig()))
+		for key, value := range topicConfig.GetConfig() {
+			kafkaTopic.Spec.Config[key] = value
+		}
+	}
+}
+
+// ResolveKafkaServerURI returns the uri of the given listener of the kafka instance
+func (k *kafkaHandler) ResolveKafkaServerURI(kafka *v1beta2.Kafka, listenerName string) (string, error) {
+	k.Log.Debug("Resolving kafka URI", "kafka instance", kafka.Name, "listener", listenerName)
+	kafkaURI := ResolveKafkaListenerServerURI(kafka, listenerName)
 	if len(kafkaURI) > 0 {
 		k.Log.Debug("Success fetch Kafka URI", "kafka instance", kafka.Name, "kafka URI", kafkaURI)
 		return kafkaURI, nil
 	}
 	k.Log.Debug("Not able resolve URI for given kafka instance")
-	return "", fmt.Errorf("not able resolve URI for given kafka instance %s", kafka.Name)
+	return "", fmt.Errorf("not able resolve URI for listener %s of given kafka instance %s", listenerName, kafka.Name)
 }
 
-// ResolveKafkaServerURI returns the uri of the kafka instance
+// ResolveKafkaServerURI returns the uri of the plain listener of the kafka instance
 func ResolveKafkaServerURI(kafka *v1beta2.Kafka) string {
-	if len(kafka.Status.Listeners) > 0 {
-		for _, listenerStatus := range kafka.Status.Listeners {
-			if listenerStatus.Type == "plain" && len(listenerStatus.Addresses) > 0 {
-				for _, listenerAddress := range listenerStatus.Addresses {
-					if len(listenerAddress.Host) > 0 && listenerAddress.Port > 0 {
-						kafkaURI := fmt.Sprintf("%s:%d", listenerAddress.Host, listenerAddress.Port)
-						return kafkaURI
-					}
-				}
+	return ResolveKafkaListenerServerURI(kafka, KafkaDefaultListener)
+}
+
+// ResolveKafkaListenerServerURI returns the uri of the given listener of the kafka instance.
+// Listeners are identified by their type in the status of Strimzi versions not reporting their name.
+func ResolveKafkaListenerServerURI(kafka *v1beta2.Kafka, listenerName string) string {
+	for _, listenerStatus := range kafka.Status.Listeners {
+		if listenerStatus.Name != listenerName && (len(listenerStatus.Name) > 0 || listenerStatus.Type != listenerName) {
+			continue
+		}
+		for _, listenerAddress := range listenerStatus.Addresses {
+			if len(listenerAddress.Host) > 0 && listenerAddress.Port > 0 {
+				return fmt.Sprintf("%s:%d", listenerAddress.Host, listenerAddress.Port)
 			}
 		}
 	}
 	return ""
 }
 
+// ResolveKafkaListener returns the given listener from the spec of the kafka instance, nil if not defined
+func ResolveKafkaListener(kafka *v1beta2.Kafka, listenerName string) *v1beta2.GenericKafkaListener {
+	for _, listener := range kafka.Spec.Kafka.Listeners {
+		if listener.Name == listenerName {
+			return &listener
+		}
+	}
+	return nil
+}
+
+// GetKafkaClusterCACertSecretName gets the name of the Secret holding the cluster CA certificate generated by Strimzi for the given kafka instance
+func GetKafkaClusterCACertSecretName(kafkaName string) string {
+	return fmt.Sprintf(kafkaClusterCACertSecretName, kafkaName)
+}
+
+func (k *kafkaHandler) FetchKafkaUser(key types.NamespacedName) (*v1beta2.KafkaUser, error) {
+	kafkaUser := &v1beta2.KafkaUser{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, kafkaUser); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return kafkaUser, nil
+}
+
+// CreateKafkaUser creates a KafkaUser with the given authentication, allowed to read and write the given topics when ACLs are enforced by the kafka instance
+func (k *kafkaHandler) CreateKafkaUser(name string, kafka *v1beta2.Kafka, authentication v1beta2.KafkaAuthenticationType, topics []string) *v1beta2.KafkaUser {
+	kafkaUser := &v1beta2.KafkaUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: kafka.Namespace,
+			Labels: map[string]string{
+				strimziBrokerLabel: kafka.Name,
+			},
+		},
+		Spec: v1beta2.KafkaUserSpec{
+			Authentication: v1beta2.KafkaUserAuthentication{AuthenticationType: authentication},
+		},
+	}
+	if len(kafka.Spec.Kafka.Authorization.AuthorizationType) == 0 {
+		return kafkaUser
+	}
+	// consumer groups are named by the services themselves
+	acls := []v1beta2.AclRule{
+		{
+			Resource:  v1beta2.AclRuleResource{ResourceType: v1beta2.AclRuleGroupResource, Name: "*", PatternType: v1beta2.AclRuleLiteralPattern},
+			Operation: kafkaACLReadOperation,
+			Host:      "*",
+		},
+	}
+	for _, topic := range topics {
+		for _, operation := range kafkaTopicACLOperations {
+			acls = append(acls, v1beta2.AclRule{
+				Resource:  v1beta2.AclRuleResource{ResourceType: v1beta2.AclRuleTopicResource, Name: topic, PatternType: v1beta2.AclRuleLiteralPattern},
+				Operation: operation,
+				Host:      "*",
+			})
+		}
+	}
+	kafkaUser.Spec.Authorization = &v1beta2.KafkaUserAuthorization{
+		AuthorizationType: v1beta2.KafkaUserSimpleAuthorization,
+		Acls:              acls,
+	}
+	return kafkaUser
+}
+
+func (k *kafkaHandler) GetKafkaUserComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(v1beta2.KafkaUser{})).
+			WithCustomComparator(framework.CreateKafkaUserComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
 // IsKafkaResource checks if provided KogitoInfra instance is for kafka resource
 func IsKafkaResource(apiVersion, kind string) bool {
 	return apiVersion == KafkaAPIVersion && kind == KafkaKind
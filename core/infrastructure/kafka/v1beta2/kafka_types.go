@@ -58,6 +58,13 @@ type KafkaClusterSpec struct {
 	Storage    KafkaStorage           `json:"storage,omitempty"`
 	Config     KafkaMap               `json:"config,omitempty"`
 	JvmOptions KafkaMap               `json:"jvmOptions,omitempty"`
+	// Authorization configuration for Kafka brokers, ACLs of the KafkaUsers are enforced only when set
+	Authorization KafkaAuthorization `json:"authorization,omitempty"`
+}
+
+// KafkaAuthorization ...
+type KafkaAuthorization struct {
+	AuthorizationType string `json:"type,omitempty"`
 }
 
 // GenericKafkaListener ...
@@ -66,8 +73,25 @@ type GenericKafkaListener struct {
 	Port         int    `json:"port,omitempty"`
 	ListenerType string `json:"type,omitempty"`
 	TLS          bool   `json:"tls"`
+	// Authentication configuration for this listener
+	Authentication KafkaListenerAuthentication `json:"authentication,omitempty"`
+}
+
+// KafkaListenerAuthentication ...
+type KafkaListenerAuthentication struct {
+	AuthenticationType KafkaAuthenticationType `json:"type,omitempty"`
 }
 
+// KafkaAuthenticationType defines the enum for the authentication of Kafka listeners and users
+type KafkaAuthenticationType string
+
+const (
+	// KafkaScramSha512Authentication authenticates clients with SASL SCRAM-SHA-512
+	KafkaScramSha512Authentication KafkaAuthenticationType = "scram-sha-512"
+	// KafkaTLSAuthentication authenticates clients with mutual TLS
+	KafkaTLSAuthentication KafkaAuthenticationType = "tls"
+)
+
 // ZookeeperClusterSpec Representation of a Strimzi-managed ZooKeeper "cluster".
 type ZookeeperClusterSpec struct {
 	Replicas int32        `json:"replicas,omitempty"`
@@ -113,6 +137,7 @@ const (
 
 // ListenerStatus defines a single listener
 type ListenerStatus struct {
+	Name      string            `json:"name,omitempty"`
 	Type      string            `json:"type,omitempty"`
 	Addresses []ListenerAddress `json:"addresses,omitempty"`
 }
@@ -15,11 +15,13 @@
 package infrastructure
 
 import (
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
 	"github.com/kiegroup/kogito-operator/core/client"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/test"
 	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"reflect"
@@ -159,9 +161,52 @@ func Test_resolveKafkaServerURI(t *testing.T) {
 	kafkaHandler := NewKafkaHandler(context)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got, _ := kafkaHandler.ResolveKafkaServerURI(tt.args.kafka); got != tt.want {
+			if got, _ := kafkaHandler.ResolveKafkaServerURI(tt.args.kafka, KafkaDefaultListener); got != tt.want {
 				t.Errorf("ResolveKafkaServerURI() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func Test_kafkaHandler_UpdateKafkaTopic(t *testing.T) {
+	kafkaTopic := getKafkaTopic("kogito-processinstances-events", t.Name(), "kogito-kafka")
+	kafkaTopic.Spec.Partitions = 4
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafkaTopic).Build()
+	kafkaHandler := NewKafkaHandler(operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()})
+
+	updated, err := kafkaHandler.UpdateKafkaTopic(kafkaTopic, nil)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+
+	// partitions can't be decreased
+	topicConfig := &v1beta1.KafkaTopic{Name: kafkaTopic.Name, Partitions: 2, Replicas: 3, Config: map[string]string{"retention.ms": "3600000"}}
+	updated, err = kafkaHandler.UpdateKafkaTopic(kafkaTopic, topicConfig)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	deployedTopic := &v1beta2.KafkaTopic{ObjectMeta: v1.ObjectMeta{Name: kafkaTopic.Name, Namespace: t.Name()}}
+	test.AssertFetchMustExist(t, cli, deployedTopic)
+	assert.Equal(t, int32(4), deployedTopic.Spec.Partitions)
+	assert.Equal(t, int32(3), deployedTopic.Spec.Replicas)
+	assert.Equal(t, "3600000", deployedTopic.Spec.Config["retention.ms"])
+	assert.Equal(t, "kogito-kafka", deployedTopic.Labels[strimziBrokerLabel])
+
+	updated, err = kafkaHandler.UpdateKafkaTopic(deployedTopic, topicConfig)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+}
+
+func Test_resolveKafkaListenerServerURI(t *testing.T) {
+	kafka := &v1beta2.Kafka{
+		Status: v1beta2.KafkaStatus{
+			Listeners: []v1beta2.ListenerStatus{
+				{Name: "plain", Type: "plain", Addresses: []v1beta2.ListenerAddress{{Host: "kafka", Port: 9092}}},
+				{Name: "scram", Type: "tls", Addresses: []v1beta2.ListenerAddress{{Host: "kafka", Port: 9094}}},
+				{Type: "tls", Addresses: []v1beta2.ListenerAddress{{Host: "kafka", Port: 9093}}},
+			},
+		},
+	}
+	assert.Equal(t, "kafka:9092", ResolveKafkaListenerServerURI(kafka, KafkaDefaultListener))
+	assert.Equal(t, "kafka:9094", ResolveKafkaListenerServerURI(kafka, "scram"))
+	assert.Equal(t, "kafka:9093", ResolveKafkaListenerServerURI(kafka, "tls"))
+	assert.Empty(t, ResolveKafkaListenerServerURI(kafka, "external"))
+}
@@ -15,13 +15,26 @@
 package infrastructure
 
 import (
+	"fmt"
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
 	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
 	// KeycloakKind refers to Keycloak Kind
 	KeycloakKind = "Keycloak"
+
+	// KeycloakClientSecretKey is the key holding the client secret in the Secret generated by the Keycloak Operator for a KeycloakClient
+	KeycloakClientSecretKey = "CLIENT_SECRET"
+
+	// keycloakClientSecretName is the name of the Secret generated by the Keycloak Operator for a KeycloakClient
+	keycloakClientSecretName = "keycloak-client-secret-%s"
 )
 
 var (
@@ -34,6 +47,11 @@ var (
 // KeycloakHandler ...
 type KeycloakHandler interface {
 	IsKeycloakAvailable() bool
+	FetchKeycloakInstance(key types.NamespacedName) (*v1alpha1.Keycloak, error)
+	FetchKeycloakRealm(key types.NamespacedName) (*v1alpha1.KeycloakRealm, error)
+	FetchKeycloakClient(key types.NamespacedName) (*v1alpha1.KeycloakClient, error)
+	GetKeycloakRealmComparator() compare.MapComparator
+	GetKeycloakClientComparator() compare.MapComparator
 }
 
 type keycloakHandler struct {
@@ -51,3 +69,67 @@ func NewKeycloakHandler(context operator.Context) KeycloakHandler {
 func (k *keycloakHandler) IsKeycloakAvailable() bool {
 	return k.Client.HasServerGroup(keycloakServerGroup)
 }
+
+func (k *keycloakHandler) FetchKeycloakInstance(key types.NamespacedName) (*v1alpha1.Keycloak, error) {
+	k.Log.Debug("fetching deployed kogito Keycloak instance")
+	keycloakInstance := &v1alpha1.Keycloak{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, keycloakInstance); err != nil {
+		k.Log.Error(err, "Error occurs while fetching kogito Keycloak instance")
+		return nil, err
+	} else if !exists {
+		k.Log.Debug("Kogito Keycloak instance is not exists")
+		return nil, nil
+	}
+	k.Log.Debug("Kogito Keycloak instance found")
+	return keycloakInstance, nil
+}
+
+func (k *keycloakHandler) FetchKeycloakRealm(key types.NamespacedName) (*v1alpha1.KeycloakRealm, error) {
+	realm := &v1alpha1.KeycloakRealm{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, realm); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return realm, nil
+}
+
+func (k *keycloakHandler) FetchKeycloakClient(key types.NamespacedName) (*v1alpha1.KeycloakClient, error) {
+	keycloakClient := &v1alpha1.KeycloakClient{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, keycloakClient); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return keycloakClient, nil
+}
+
+func (k *keycloakHandler) GetKeycloakRealmComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(v1alpha1.KeycloakRealm{})).
+			WithCustomComparator(framework.CreateKeycloakRealmComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
+func (k *keycloakHandler) GetKeycloakClientComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(v1alpha1.KeycloakClient{})).
+			WithCustomComparator(framework.CreateKeycloakClientComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
+// GetKeycloakClientSecretName gets the name of the Secret holding the credentials generated by the Keycloak Operator for the given client
+func GetKeycloakClientSecretName(clientID string) string {
+	return fmt.Sprintf(keycloakClientSecretName, clientID)
+}
+
+// IsKeycloakResource checks if provided KogitoInfra instance is for Keycloak resource
+func IsKeycloakResource(apiVersion, kind string) bool {
+	return apiVersion == KeycloakAPIVersion && kind == KeycloakKind
+}
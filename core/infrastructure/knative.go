@@ -15,7 +15,11 @@
 package infrastructure
 
 import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"k8s.io/apimachinery/pkg/types"
 	"knative.dev/eventing/pkg/apis/eventing"
@@ -36,6 +40,8 @@ var (
 type KnativeHandler interface {
 	IsKnativeEventingAvailable() bool
 	FetchBroker(key types.NamespacedName) (*eventingv1.Broker, error)
+	FetchTriggers(namespace string, labels map[string]string) (*eventingv1.TriggerList, error)
+	GetTriggerComparator() compare.MapComparator
 }
 
 type knativeHandler struct {
@@ -64,6 +70,24 @@ func (k *knativeHandler) FetchBroker(key types.NamespacedName) (*eventingv1.Brok
 	return broker, nil
 }
 
+func (k *knativeHandler) FetchTriggers(namespace string, labels map[string]string) (*eventingv1.TriggerList, error) {
+	triggers := &eventingv1.TriggerList{}
+	if err := kubernetes.ResourceC(k.Client).ListWithNamespaceAndLabel(namespace, triggers, labels); err != nil {
+		return nil, err
+	}
+	return triggers, nil
+}
+
+func (k *knativeHandler) GetTriggerComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(eventingv1.Trigger{})).
+			WithCustomComparator(framework.CreateTriggerComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
 // IsKnativeEventingResource checks if provided KogitoInfra instance is for Knative eventing resource
 func IsKnativeEventingResource(apiVersion, kind string) bool {
 	return apiVersion == KnativeEventingAPIVersion && kind == KnativeEventingBrokerKind
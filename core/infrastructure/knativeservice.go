@@ -0,0 +1,103 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	servingv1 "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// KnativeServiceHandler ...
+type KnativeServiceHandler interface {
+	IsKnativeServingAvailable() bool
+	FetchKnativeService(key types.NamespacedName) (*servingv1.Service, error)
+	CreateKnativeService(deployment *appsv1.Deployment) *servingv1.Service
+	GetComparator() compare.MapComparator
+}
+
+type knativeServiceHandler struct {
+	operator.Context
+}
+
+// NewKnativeServiceHandler ...
+func NewKnativeServiceHandler(context operator.Context) KnativeServiceHandler {
+	return &knativeServiceHandler{
+		context,
+	}
+}
+
+// IsKnativeServingAvailable checks if Knative Serving CRDs are available in the cluster
+func (k *knativeServiceHandler) IsKnativeServingAvailable() bool {
+	return k.Client.HasServerGroup(servingv1.GroupVersion.Group)
+}
+
+func (k *knativeServiceHandler) FetchKnativeService(key types.NamespacedName) (*servingv1.Service, error) {
+	service := &servingv1.Service{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, service); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return service, nil
+}
+
+// CreateKnativeService creates the Knative Service running the pods of the given Deployment.
+// Knative scales the pods by itself, so the replicas and the strategy of the Deployment are dropped.
+func (k *knativeServiceHandler) CreateKnativeService(deployment *appsv1.Deployment) *servingv1.Service {
+	template := deployment.Spec.Template.DeepCopy()
+	for i := range template.Spec.Containers {
+		container := &template.Spec.Containers[i]
+		// Knative routes the traffic to a single unnamed port of the first container
+		if i == 0 && len(container.Ports) > 0 {
+			container.Ports = []corev1.ContainerPort{{ContainerPort: container.Ports[0].ContainerPort, Protocol: corev1.ProtocolTCP}}
+		} else {
+			container.Ports = nil
+		}
+		// startup probes are not supported by Knative, the readiness probe holds the traffic until the container is started
+		container.StartupProbe = nil
+	}
+	return &servingv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+			Labels:    deployment.Labels,
+		},
+		Spec: servingv1.ServiceSpec{
+			Template: servingv1.RevisionTemplateSpec{
+				ObjectMeta: template.ObjectMeta,
+				Spec:       servingv1.RevisionSpec{PodSpec: template.Spec},
+			},
+		},
+	}
+}
+
+func (k *knativeServiceHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(servingv1.Service{})).
+			WithCustomComparator(framework.CreateKnativeServiceComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
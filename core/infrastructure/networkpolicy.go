@@ -0,0 +1,197 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// openShiftIngressPolicyGroupLabelKey is the label set by OpenShift on the namespaces of the router
+	openShiftIngressPolicyGroupLabelKey = "network.openshift.io/policy-group"
+	openShiftIngressPolicyGroup         = "ingress"
+
+	openShiftMonitoringPolicyGroup = "monitoring"
+
+	// knativeEventingNamespace is the namespace of the Knative Eventing components receiving the events sent to the Brokers
+	knativeEventingNamespace = "knative-eventing"
+
+	// operatorNamespaceEnvVar is the namespace of the operator pod, set from the downward API
+	operatorNamespaceEnvVar = "OPERATOR_NAMESPACE"
+	// operatorPodLabelKey is the label of the operator pod
+	operatorPodLabelKey   = "control-plane"
+	operatorPodLabelValue = "controller-manager"
+	// monitoringNamespaceEnvVar is the namespace of the Prometheus scraping the services on Kubernetes
+	monitoringNamespaceEnvVar  = "MONITORING_NAMESPACE"
+	defaultMonitoringNamespace = "monitoring"
+	// ingressControllerNamespaceEnvVar is the namespace of the Ingress controller exposing the services on Kubernetes
+	ingressControllerNamespaceEnvVar  = "INGRESS_CONTROLLER_NAMESPACE"
+	defaultIngressControllerNamespace = "ingress-nginx"
+
+	dnsPort = 53
+)
+
+// infraPodLabels are the labels set on the pods of the third party infrastructure deployed by their operators, by kind
+var infraPodLabels = map[string]func(name string) map[string]string{
+	InfinispanKind: func(name string) map[string]string { return map[string]string{"clusterName": name} },
+	KafkaKind:      func(name string) map[string]string { return map[string]string{"strimzi.io/cluster": name} },
+	KeycloakKind:   func(name string) map[string]string { return map[string]string{"app": "keycloak"} },
+	MongoDBKind:    func(name string) map[string]string { return map[string]string{"app": name + "-svc"} },
+	PostgreSQLKind: func(name string) map[string]string {
+		return map[string]string{"postgres-operator.crunchydata.com/cluster": name}
+	},
+}
+
+// NetworkPolicyHandler ...
+type NetworkPolicyHandler interface {
+	FetchNetworkPolicy(key types.NamespacedName) (*networkingv1.NetworkPolicy, error)
+	CreateNetworkPolicy(instance api.KogitoService) *networkingv1.NetworkPolicy
+	GetInfraNetworkPolicyPeer(namespace string, resource api.ResourceInterface) networkingv1.NetworkPolicyPeer
+	GetNamespaceNetworkPolicyPeer(namespace string) networkingv1.NetworkPolicyPeer
+	GetComparator() compare.MapComparator
+}
+
+type networkPolicyHandler struct {
+	operator.Context
+}
+
+// NewNetworkPolicyHandler ...
+func NewNetworkPolicyHandler(context operator.Context) NetworkPolicyHandler {
+	return &networkPolicyHandler{
+		context,
+	}
+}
+
+func (n *networkPolicyHandler) FetchNetworkPolicy(key types.NamespacedName) (*networkingv1.NetworkPolicy, error) {
+	networkPolicy := &networkingv1.NetworkPolicy{}
+	exists, err := kubernetes.ResourceC(n.Client).FetchWithKey(key, networkPolicy)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return networkPolicy, nil
+}
+
+// CreateNetworkPolicy creates a new NetworkPolicy isolating the pods of the given instance.
+// Only DNS lookups, connections from the operator, from Prometheus, from the router or the Ingress controller exposing the service
+// and from the sources configured in the instance are allowed.
+func (n *networkPolicyHandler) CreateNetworkPolicy(instance api.KogitoService) *networkingv1.NetworkPolicy {
+	udp := corev1.ProtocolUDP
+	tcp := corev1.ProtocolTCP
+	port := intstr.FromInt(dnsPort)
+	networkPolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: v1.LabelSelector{MatchLabels: map[string]string{framework.LabelAppKey: instance.GetName()}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Egress: []networkingv1.NetworkPolicyEgressRule{
+				{Ports: []networkingv1.NetworkPolicyPort{{Protocol: &udp, Port: &port}, {Protocol: &tcp, Port: &port}}},
+			},
+		},
+	}
+	// the operator calls the services, e.g. to fetch their messaging topics
+	framework.AddNetworkPolicyIngressPeers(networkPolicy, getOperatorNetworkPolicyPeer(), n.getMonitoringNetworkPolicyPeer())
+	if n.Client.IsOpenshift() && !instance.GetSpec().IsRouteDisabled() {
+		framework.AddNetworkPolicyIngressPeers(networkPolicy, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &v1.LabelSelector{MatchLabels: map[string]string{openShiftIngressPolicyGroupLabelKey: openShiftIngressPolicyGroup}},
+		})
+	}
+	if !n.Client.IsOpenshift() && !instance.GetSpec().IsRouteDisabled() && len(instance.GetSpec().GetIngress().GetHost()) > 0 {
+		framework.AddNetworkPolicyIngressPeers(networkPolicy,
+			n.GetNamespaceNetworkPolicyPeer(util.GetOSEnv(ingressControllerNamespaceEnvVar, defaultIngressControllerNamespace)))
+	}
+	if instance.GetSpec().GetNetworkPolicy() != nil {
+		framework.AddNetworkPolicyIngressPeers(networkPolicy, instance.GetSpec().GetNetworkPolicy().GetIngressFrom()...)
+	}
+	return networkPolicy
+}
+
+// GetInfraNetworkPolicyPeer gets the NetworkPolicyPeer selecting the pods of the given infrastructure resource.
+// Events sent to a Knative Broker go through the Knative Eventing namespace.
+// When the pods of the resource kind are unknown, every pod in the resource namespace is selected.
+func (n *networkPolicyHandler) GetInfraNetworkPolicyPeer(namespace string, resource api.ResourceInterface) networkingv1.NetworkPolicyPeer {
+	peer := networkingv1.NetworkPolicyPeer{PodSelector: &v1.LabelSelector{}}
+	if resource.GetKind() == KnativeEventingBrokerKind {
+		return n.GetNamespaceNetworkPolicyPeer(knativeEventingNamespace)
+	}
+	if podLabels, ok := infraPodLabels[resource.GetKind()]; ok {
+		peer.PodSelector.MatchLabels = podLabels(resource.GetName())
+	}
+	if len(resource.GetNamespace()) > 0 && resource.GetNamespace() != namespace {
+		peer.NamespaceSelector = &v1.LabelSelector{MatchLabels: map[string]string{framework.NamespaceNameLabelKey: resource.GetNamespace()}}
+	}
+	return peer
+}
+
+// GetNamespaceNetworkPolicyPeer gets the NetworkPolicyPeer selecting every pod of the given namespace
+func (n *networkPolicyHandler) GetNamespaceNetworkPolicyPeer(namespace string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &v1.LabelSelector{MatchLabels: map[string]string{framework.NamespaceNameLabelKey: namespace}},
+	}
+}
+
+// GetOperatorNamespace gets the namespace of the operator pod, empty if unknown
+func GetOperatorNamespace() string {
+	return util.GetOSEnv(operatorNamespaceEnvVar, "")
+}
+
+// getOperatorNetworkPolicyPeer gets the NetworkPolicyPeer selecting the operator pod, in any namespace when its namespace is unknown
+func getOperatorNetworkPolicyPeer() networkingv1.NetworkPolicyPeer {
+	peer := networkingv1.NetworkPolicyPeer{
+		PodSelector:       &v1.LabelSelector{MatchLabels: map[string]string{operatorPodLabelKey: operatorPodLabelValue}},
+		NamespaceSelector: &v1.LabelSelector{},
+	}
+	if namespace := GetOperatorNamespace(); len(namespace) > 0 {
+		peer.NamespaceSelector.MatchLabels = map[string]string{framework.NamespaceNameLabelKey: namespace}
+	}
+	return peer
+}
+
+// getMonitoringNetworkPolicyPeer gets the NetworkPolicyPeer selecting the Prometheus pods scraping the metrics of the services
+func (n *networkPolicyHandler) getMonitoringNetworkPolicyPeer() networkingv1.NetworkPolicyPeer {
+	if n.Client.IsOpenshift() {
+		return networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &v1.LabelSelector{MatchLabels: map[string]string{openShiftIngressPolicyGroupLabelKey: openShiftMonitoringPolicyGroup}},
+		}
+	}
+	return n.GetNamespaceNetworkPolicyPeer(util.GetOSEnv(monitoringNamespaceEnvVar, defaultMonitoringNamespace))
+}
+
+func (n *networkPolicyHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(networkingv1.NetworkPolicy{})).
+			WithCustomComparator(framework.CreateNetworkPolicyComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetInfraNetworkPolicyPeer(t *testing.T) {
+	ns := t.Name()
+	context := operator.Context{Client: test.NewFakeClientBuilder().Build(), Log: test.TestLogger}
+	networkPolicyHandler := NewNetworkPolicyHandler(context)
+
+	peer := networkPolicyHandler.GetInfraNetworkPolicyPeer(ns, &v1beta1.InfraResource{Kind: InfinispanKind, Name: "kogito-infinispan", Namespace: ns})
+	assert.Equal(t, map[string]string{"clusterName": "kogito-infinispan"}, peer.PodSelector.MatchLabels)
+	assert.Nil(t, peer.NamespaceSelector)
+
+	peer = networkPolicyHandler.GetInfraNetworkPolicyPeer(ns, &v1beta1.InfraResource{Kind: "Unknown", Name: "my-infra", Namespace: "infra"})
+	assert.Empty(t, peer.PodSelector.MatchLabels)
+	assert.Equal(t, "infra", peer.NamespaceSelector.MatchLabels[framework.NamespaceNameLabelKey])
+
+	peer = networkPolicyHandler.GetInfraNetworkPolicyPeer(ns, &v1beta1.InfraResource{Kind: KnativeEventingBrokerKind, Name: "default"})
+	assert.Nil(t, peer.PodSelector)
+	assert.Equal(t, knativeEventingNamespace, peer.NamespaceSelector.MatchLabels[framework.NamespaceNameLabelKey])
+}
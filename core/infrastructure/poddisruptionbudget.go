@@ -0,0 +1,94 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// defaultMaxUnavailable is the number of pods that can be evicted at once when no budget is configured for the service
+var defaultMaxUnavailable = intstr.FromInt(1)
+
+// PodDisruptionBudgetHandler ...
+type PodDisruptionBudgetHandler interface {
+	FetchPodDisruptionBudget(key types.NamespacedName) (*policyv1beta1.PodDisruptionBudget, error)
+	CreatePodDisruptionBudget(instance api.KogitoService) *policyv1beta1.PodDisruptionBudget
+	GetComparator() compare.MapComparator
+}
+
+type podDisruptionBudgetHandler struct {
+	operator.Context
+}
+
+// NewPodDisruptionBudgetHandler ...
+func NewPodDisruptionBudgetHandler(context operator.Context) PodDisruptionBudgetHandler {
+	return &podDisruptionBudgetHandler{
+		context,
+	}
+}
+
+func (p *podDisruptionBudgetHandler) FetchPodDisruptionBudget(key types.NamespacedName) (*policyv1beta1.PodDisruptionBudget, error) {
+	pdb := &policyv1beta1.PodDisruptionBudget{}
+	exists, err := kubernetes.ResourceC(p.Client).FetchWithKey(key, pdb)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return pdb, nil
+}
+
+// CreatePodDisruptionBudget creates a new PodDisruptionBudget selecting the pods of the given instance
+func (p *podDisruptionBudgetHandler) CreatePodDisruptionBudget(instance api.KogitoService) *policyv1beta1.PodDisruptionBudget {
+	spec := policyv1beta1.PodDisruptionBudgetSpec{
+		Selector: &v1.LabelSelector{MatchLabels: map[string]string{framework.LabelAppKey: instance.GetName()}},
+	}
+	if budget := instance.GetSpec().GetPodDisruptionBudget(); budget != nil && budget.GetMinAvailable() != nil {
+		spec.MinAvailable = budget.GetMinAvailable()
+	} else if budget != nil && budget.GetMaxUnavailable() != nil {
+		spec.MaxUnavailable = budget.GetMaxUnavailable()
+	} else {
+		maxUnavailable := defaultMaxUnavailable
+		spec.MaxUnavailable = &maxUnavailable
+	}
+	return &policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: spec,
+	}
+}
+
+func (p *podDisruptionBudgetHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(policyv1beta1.PodDisruptionBudget{})).
+			WithCustomComparator(framework.CreatePodDisruptionBudgetComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"fmt"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	postgresql "github.com/kiegroup/kogito-operator/core/infrastructure/postgresql/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// PostgreSQLKind refers to PostgreSQL Kind
+	PostgreSQLKind = "PostgresCluster"
+
+	// DefaultPostgreSQLPort is the default port PostgreSQL listens to
+	DefaultPostgreSQLPort = int32(5432)
+
+	// postgreSQLUserSecretName is the name of the secret generated by the PostgreSQL Operator for each user
+	postgreSQLUserSecretName = "%s-pguser-%s"
+
+	// PostgreSQLUserSecretHostKey is the host key in the secret generated for a PostgreSQL user
+	PostgreSQLUserSecretHostKey = "host"
+	// PostgreSQLUserSecretPortKey is the port key in the secret generated for a PostgreSQL user
+	PostgreSQLUserSecretPortKey = "port"
+	// PostgreSQLUserSecretDatabaseKey is the database key in the secret generated for a PostgreSQL user
+	PostgreSQLUserSecretDatabaseKey = "dbname"
+	// PostgreSQLUserSecretUsernameKey is the username key in the secret generated for a PostgreSQL user
+	PostgreSQLUserSecretUsernameKey = "user"
+	// PostgreSQLUserSecretPasswordKey is the password key in the secret generated for a PostgreSQL user
+	PostgreSQLUserSecretPasswordKey = "password"
+)
+
+var (
+	// PostgreSQLAPIVersion refers to PostgreSQL APIVersion
+	PostgreSQLAPIVersion = postgresql.GroupVersion.String()
+
+	postgreSQLServerGroup = postgresql.GroupVersion.Group
+)
+
+// PostgreSQLHandler ...
+type PostgreSQLHandler interface {
+	IsPostgreSQLAvailable() bool
+	FetchPostgreSQLInstance(key types.NamespacedName) (*postgresql.PostgresCluster, error)
+	IsPostgreSQLInstanceReady(instance *postgresql.PostgresCluster) bool
+	GetUserSecretName(instance *postgresql.PostgresCluster, username string) string
+}
+
+type postgreSQLHandler struct {
+	operator.Context
+}
+
+// NewPostgreSQLHandler ...
+func NewPostgreSQLHandler(context operator.Context) PostgreSQLHandler {
+	return &postgreSQLHandler{
+		context,
+	}
+}
+
+func (p *postgreSQLHandler) IsPostgreSQLAvailable() bool {
+	return p.Client.HasServerGroup(postgreSQLServerGroup)
+}
+
+func (p *postgreSQLHandler) FetchPostgreSQLInstance(key types.NamespacedName) (*postgresql.PostgresCluster, error) {
+	p.Log.Debug("fetching deployed kogito PostgreSQL instance")
+	postgreSQLInstance := &postgresql.PostgresCluster{}
+	if exists, err := kubernetes.ResourceC(p.Client).FetchWithKey(key, postgreSQLInstance); err != nil {
+		p.Log.Error(err, "Error occurs while fetching kogito PostgreSQL instance")
+		return nil, err
+	} else if !exists {
+		p.Log.Debug("Kogito PostgreSQL instance is not exists")
+		return nil, nil
+	} else {
+		p.Log.Debug("Kogito PostgreSQL instance found", "instance", postgreSQLInstance.Name)
+		return postgreSQLInstance, nil
+	}
+}
+
+// IsPostgreSQLInstanceReady verifies if every instance set of the given cluster has all of its pods ready
+func (p *postgreSQLHandler) IsPostgreSQLInstanceReady(instance *postgresql.PostgresCluster) bool {
+	if len(instance.Status.InstanceSets) == 0 {
+		return false
+	}
+	for _, instanceSet := range instance.Status.InstanceSets {
+		if instanceSet.ReadyReplicas == 0 || instanceSet.ReadyReplicas < instanceSet.Replicas {
+			return false
+		}
+	}
+	return true
+}
+
+// GetUserSecretName gets the name of the secret holding the connection details for the given user.
+// The PostgreSQL Operator creates a user named after the cluster when none is defined.
+func (p *postgreSQLHandler) GetUserSecretName(instance *postgresql.PostgresCluster, username string) string {
+	if len(username) == 0 {
+		username = instance.Name
+	}
+	return fmt.Sprintf(postgreSQLUserSecretName, instance.Name, username)
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PostgresClusterSpec defines the desired state of PostgresCluster.
+// Only the fields required by the Kogito Operator are mapped.
+type PostgresClusterSpec struct {
+	// The major version of PostgreSQL installed in the PostgreSQL image
+	PostgresVersion int `json:"postgresVersion"`
+
+	// The port on which PostgreSQL should listen.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// Specifies one or more sets of PostgreSQL pods that replicate data for this cluster.
+	InstanceSets []PostgresInstanceSetSpec `json:"instances"`
+
+	// Users to create inside PostgreSQL and the databases they should access.
+	// +optional
+	Users []PostgresUserSpec `json:"users,omitempty"`
+}
+
+// PostgresInstanceSetSpec ...
+type PostgresInstanceSetSpec struct {
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// PostgresUserSpec ...
+type PostgresUserSpec struct {
+	// The name of this PostgreSQL user. The credentials are stored in a Secret named
+	// "<cluster name>-pguser-<user name>".
+	Name string `json:"name"`
+
+	// Databases to which this user can connect and create objects.
+	// +optional
+	Databases []string `json:"databases,omitempty"`
+}
+
+// PostgresClusterStatus defines the observed state of PostgresCluster
+type PostgresClusterStatus struct {
+	// Current state of PostgreSQL instances.
+	// +optional
+	InstanceSets []PostgresInstanceSetStatus `json:"instances,omitempty"`
+}
+
+// PostgresInstanceSetStatus ...
+type PostgresInstanceSetStatus struct {
+	Name string `json:"name"`
+
+	// Total number of non-terminated pods.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Total number of ready pods.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PostgresCluster is the Schema for the postgresclusters API
+type PostgresCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgresClusterSpec   `json:"spec,omitempty"`
+	Status PostgresClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgresClusterList contains a list of PostgresCluster
+type PostgresClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgresCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PostgresCluster{}, &PostgresClusterList{})
+}
@@ -15,11 +15,16 @@
 package infrastructure
 
 import (
+	"context"
+
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
 	"github.com/kiegroup/kogito-operator/core/operator"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -29,15 +34,18 @@ const (
 	roleName                  = "kogito-service-viewer"
 	roleBindingName           = "kogito-service-viewer"
 	roleAPIGroup              = "rbac.authorization.k8s.io"
+	watchNamespaceEnvVar      = "WATCH_NAMESPACE"
 )
 
 var serviceViewerRoleVerbs = []string{"list", "get", "watch", "update", "patch"}
 var serviceViewerRoleAPIGroups = []string{""}
 var serviceViewerRoleResources = []string{"services", "configmaps"}
+var watchVerbs = []string{"list", "watch"}
 
 // RBACHandler ...
 type RBACHandler interface {
 	SetupRBAC(namespace string) (err error)
+	IsWatchAllowed(resource schema.GroupVersionResource) (bool, error)
 }
 
 type rbacHandler struct {
@@ -72,6 +80,36 @@ func (r *rbacHandler) SetupRBAC(namespace string) (err error) {
 	return
 }
 
+// IsWatchAllowed checks if the operator is granted to list and watch the given resource in the namespace it watches, all namespaces if it's cluster scoped
+func (r *rbacHandler) IsWatchAllowed(resource schema.GroupVersionResource) (bool, error) {
+	for _, verb := range watchVerbs {
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: GetWatchNamespace(),
+					Verb:      verb,
+					Group:     resource.Group,
+					Version:   resource.Version,
+					Resource:  resource.Resource,
+				},
+			},
+		}
+		if err := r.Client.ControlCli.Create(context.TODO(), review); err != nil {
+			return false, err
+		}
+		if !review.Status.Allowed {
+			r.Log.Debug("Operator not granted to access resource", "resource", resource.String(), "verb", verb, "reason", review.Status.Reason)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// GetWatchNamespace gets the namespace watched by the operator, empty if it watches all namespaces
+func GetWatchNamespace() string {
+	return util.GetOSEnv(watchNamespaceEnvVar, "")
+}
+
 func getServiceViewerServiceAccount(namespace string) client.Object {
 	return &v1.ServiceAccount{
 		ObjectMeta: v12.ObjectMeta{
@@ -17,8 +17,10 @@ package infrastructure
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	api "github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -65,6 +67,28 @@ const (
 	RouteProcessed ConditionReason = "RouteProcessed"
 	// RouteCreationFailureReason - Unable to properly create Route
 	RouteCreationFailureReason ConditionReason = "RouteCreationFailure"
+	// PersistenceInfraConflictReason - More than one persistence backend bound to the service
+	PersistenceInfraConflictReason ConditionReason = "PersistenceInfraConflict"
+	// RolloutCandidateDeployingReason - The new image is being deployed next to the current one
+	RolloutCandidateDeployingReason ConditionReason = "CandidateDeploying"
+	// RolloutCanaryReason - The new image receives a share of the traffic, waiting to be promoted
+	RolloutCanaryReason ConditionReason = "CanaryInProgress"
+	// RolloutAwaitingPromotionReason - The new image is deployed without traffic, waiting to be promoted
+	RolloutAwaitingPromotionReason ConditionReason = "AwaitingPromotion"
+	// RolloutPromotingReason - The new image receives all the traffic while it replaces the current one
+	RolloutPromotingReason ConditionReason = "Promoting"
+	// RolloutCompletedReason - The service runs a single image
+	RolloutCompletedReason ConditionReason = "RolloutCompleted"
+	// KnativeServingNotAvailableReason - The service is deployed as a Knative Service, but Knative Serving is not installed in the cluster
+	KnativeServingNotAvailableReason ConditionReason = "KnativeServingNotAvailable"
+	// CertificateProviderNotAvailableReason - The provider of the serving certificate of the service is not available in the cluster
+	CertificateProviderNotAvailableReason ConditionReason = "CertificateProviderNotAvailable"
+	// CertificateNotReadyReason - The serving certificate of the service is not yet issued
+	CertificateNotReadyReason ConditionReason = "CertificateNotReady"
+	// ResourceConflictReason - A resource required by the service already exists, not created by the operator
+	ResourceConflictReason ConditionReason = "ResourceConflict"
+	// KafkaUserNotReadyReason - The credentials of the KafkaUser of the service are not yet generated
+	KafkaUserNotReadyReason ConditionReason = "KafkaUserNotReady"
 )
 
 const (
@@ -196,6 +220,51 @@ func ErrorForRouteCreation(err error) ReconciliationError {
 	}
 }
 
+// ErrorForPersistenceInfraConflict ...
+func ErrorForPersistenceInfraConflict(serviceName string, infraNames []string) ReconciliationError {
+	return ReconciliationError{
+		reason: PersistenceInfraConflictReason,
+		innerError: fmt.Errorf("KogitoService '%s' can use only one persistence backend, but KogitoInfra resources %s provide different ones; bind only one of them",
+			serviceName, strings.Join(infraNames, ", ")),
+	}
+}
+
+// ErrorForKnativeServingNotAvailable ...
+func ErrorForKnativeServingNotAvailable(serviceName string) ReconciliationError {
+	return ReconciliationError{
+		reason:                 KnativeServingNotAvailableReason,
+		reconciliationInterval: ReconciliationAfterOneMinute,
+		innerError:             fmt.Errorf("KogitoService '%s' is deployed as a Knative Service, but Knative Serving is not installed in the cluster", serviceName),
+	}
+}
+
+// ErrorForCertificateProviderNotAvailable ...
+func ErrorForCertificateProviderNotAvailable(serviceName string, provider api.CertificateProviderType) ReconciliationError {
+	return ReconciliationError{
+		reason:                 CertificateProviderNotAvailableReason,
+		reconciliationInterval: ReconciliationAfterOneMinute,
+		innerError:             fmt.Errorf("KogitoService '%s' requests its serving certificate to %s, but it's not available in the cluster", serviceName, provider),
+	}
+}
+
+// ErrorForCertificateNotReady ...
+func ErrorForCertificateNotReady(serviceName string) ReconciliationError {
+	return ReconciliationError{
+		reason:                 CertificateNotReadyReason,
+		reconciliationInterval: ReconciliationAfterTen,
+		innerError:             fmt.Errorf("Serving certificate of KogitoService '%s' is not yet issued ", serviceName),
+	}
+}
+
+// ErrorForKafkaUserNotReady ...
+func ErrorForKafkaUserNotReady(userName string) ReconciliationError {
+	return ReconciliationError{
+		reason:                 KafkaUserNotReadyReason,
+		reconciliationInterval: ReconciliationAfterTen,
+		innerError:             fmt.Errorf("Credentials of KafkaUser '%s' are not yet generated ", userName),
+	}
+}
+
 // ReconciliationErrorHandler ...
 type ReconciliationErrorHandler interface {
 	IsReconciliationError(err error) bool
@@ -102,7 +102,7 @@ func (r *routeHandler) CreateRoute(instance api.KogitoService) *routev1.Route {
 		},
 		Spec: routev1.RouteSpec{
 			Port: &routev1.RoutePort{
-				TargetPort: intstr.FromString(framework.DefaultPortName),
+				TargetPort: intstr.FromString(getServicePortName(instance)),
 			},
 			To: routev1.RouteTargetReference{
 				Kind: openshift.KindService.Name,
@@ -110,6 +110,13 @@ func (r *routeHandler) CreateRoute(instance api.KogitoService) *routev1.Route {
 			},
 		},
 	}
+	// the router terminates the TLS connection of the clients and opens a new one to the service
+	if IsTLSEnabled(instance) {
+		route.Spec.TLS = &routev1.TLSConfig{
+			Termination:                   routev1.TLSTerminationReencrypt,
+			InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+		}
+	}
 	route.ResourceVersion = ""
 	return route
 }
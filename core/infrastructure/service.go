@@ -15,6 +15,7 @@
 package infrastructure
 
 import (
+	"fmt"
 	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
@@ -65,7 +66,7 @@ func (s *serviceHandler) FetchService(key types.NamespacedName) (*corev1.Service
 }
 
 func (s *serviceHandler) CreateService(instance api.KogitoService) *corev1.Service {
-	ports := createServicePorts()
+	ports := createServicePorts(instance)
 	labels := instance.GetSpec().GetServiceLabels()
 	if labels == nil {
 		labels = make(map[string]string)
@@ -84,11 +85,33 @@ func (s *serviceHandler) CreateService(instance api.KogitoService) *corev1.Servi
 			Type:     corev1.ServiceTypeClusterIP,
 		},
 	}
+	if IsTLSEnabled(instance) && instance.GetSpec().GetTLS().GetProvider() == api.OpenShiftCertificateProvider {
+		svc.Annotations = map[string]string{ServingCertSecretAnnotation: GetTLSSecretName(instance)}
+	}
 	return &svc
 }
 
+// GetKogitoServiceInternalURL gets the URL of the Service exposing the given Kogito service, reachable from every namespace of the cluster
+func GetKogitoServiceInternalURL(instance api.KogitoService) string {
+	// the Service exposes the default HTTP(S) port, the host name must match the serving certificate
+	if IsTLSEnabled(instance) {
+		return fmt.Sprintf("%s://%s", httpsScheme, GetKogitoServiceHostName(instance))
+	}
+	return fmt.Sprintf("%s://%s.%s", httpScheme, instance.GetName(), instance.GetNamespace())
+}
+
 // createServicePorts converts ports defined in the given container to ServicePorts
-func createServicePorts() []corev1.ServicePort {
+func createServicePorts(instance api.KogitoService) []corev1.ServicePort {
+	if IsTLSEnabled(instance) {
+		return []corev1.ServicePort{
+			{
+				Name:       framework.DefaultTLSPortName,
+				Protocol:   corev1.ProtocolTCP,
+				Port:       defaultHTTPSPort,
+				TargetPort: intstr.FromInt(framework.DefaultExposedTLSPort),
+			},
+		}
+	}
 	svcPorts := []corev1.ServicePort{
 		{
 			Name:       framework.DefaultPortName,
@@ -136,6 +159,7 @@ func (s *serviceHandler) createServiceComparator() func(deployed client.Object,
 		pairs = append(pairs, [2]interface{}{svcDeployed.Spec.Ports, svcRequested.Spec.Ports})
 		pairs = append(pairs, [2]interface{}{svcDeployed.Spec.Selector, svcRequested.Spec.Selector})
 		pairs = append(pairs, [2]interface{}{svcDeployed.Spec.Type, svcRequested.Spec.Type})
+		pairs = append(pairs, [2]interface{}{svcDeployed.Annotations[ServingCertSecretAnnotation], svcRequested.Annotations[ServingCertSecretAnnotation]})
 		equal := compare.EqualPairs(pairs)
 
 		if !equal {
@@ -0,0 +1,97 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ServiceSpec defines the desired state of a Knative Service, managing the Configuration and the Route of its Revisions.
+type ServiceSpec struct {
+	// Template holds the latest specification for the Revision to be stamped out.
+	Template RevisionTemplateSpec `json:"template"`
+	// Traffic specifies how to distribute traffic over a collection of Revisions.
+	Traffic []TrafficTarget `json:"traffic,omitempty"`
+}
+
+// RevisionTemplateSpec describes the data a Revision should have when created from a template.
+type RevisionTemplateSpec struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              RevisionSpec `json:"spec,omitempty"`
+}
+
+// RevisionSpec holds the desired state of the Revision.
+type RevisionSpec struct {
+	corev1.PodSpec `json:",inline"`
+	// ContainerConcurrency specifies the maximum allowed in-flight (concurrent) requests per container of the Revision.
+	ContainerConcurrency *int64 `json:"containerConcurrency,omitempty"`
+	// TimeoutSeconds is the maximum duration in seconds that the request routing layer will wait for a request delivered to a container to begin replying.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// TrafficTarget holds a single entry of the routing table for a Route.
+type TrafficTarget struct {
+	// Tag is optionally used to expose a dedicated url for referencing this target exclusively.
+	Tag string `json:"tag,omitempty"`
+	// RevisionName of a specific revision to which to send this portion of traffic.
+	RevisionName string `json:"revisionName,omitempty"`
+	// LatestRevision may be optionally provided to indicate that the latest ready Revision should be used for this traffic target.
+	LatestRevision *bool `json:"latestRevision,omitempty"`
+	// Percent indicates that percentage based routing should be used and the value indicates the percent of traffic that is be routed to this Revision.
+	Percent *int64 `json:"percent,omitempty"`
+}
+
+// ServiceStatus represents the observed state of a Knative Service.
+type ServiceStatus struct {
+	duckv1.Status `json:",inline"`
+	// URL holds the url that will distribute traffic over the provided traffic targets.
+	URL *apis.URL `json:"url,omitempty"`
+	// LatestReadyRevisionName holds the name of the latest Revision stamped out from this Service's template that has had its "Ready" condition become "True".
+	LatestReadyRevisionName string `json:"latestReadyRevisionName,omitempty"`
+	// LatestCreatedRevisionName is the last revision that was created from this Service's template.
+	LatestCreatedRevisionName string `json:"latestCreatedRevisionName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Service is the Schema for the services API
+type Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpec   `json:"spec,omitempty"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+// IsReady returns true when the latest Revision of the Service is ready to serve the traffic
+func (s *Service) IsReady() bool {
+	return s.Generation == s.Status.ObservedGeneration && s.Status.GetCondition(apis.ConditionReady).IsTrue()
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceList contains a list of Service
+type ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Service `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Service{}, &ServiceList{})
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PipelineRunSpecStatus defines the pipelinerun spec status the user can provide
+type PipelineRunSpecStatus string
+
+const (
+	// PipelineRunSpecStatusCancelled indicates that the user wants to cancel the run
+	PipelineRunSpecStatusCancelled PipelineRunSpecStatus = "PipelineRunCancelled"
+
+	// ConditionSucceeded specifies that the resource has finished.
+	ConditionSucceeded = "Succeeded"
+
+	// PipelineRunReasonRunning is the reason set when the PipelineRun is running
+	PipelineRunReasonRunning = "Running"
+	// PipelineRunReasonCancelled is the reason set when the PipelineRun cancelled by the user
+	PipelineRunReasonCancelled = "Cancelled"
+	// PipelineRunReasonCancelledDeprecated is the reason set by older Tekton versions when the PipelineRun is cancelled
+	PipelineRunReasonCancelledDeprecated = "PipelineRunCancelled"
+)
+
+// PipelineRunSpec defines the desired state of PipelineRun.
+// Only the fields required by the Kogito Operator are mapped.
+type PipelineRunSpec struct {
+	// +optional
+	PipelineSpec *PipelineSpec `json:"pipelineSpec,omitempty"`
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// Used for cancelling a pipelinerun (and maybe more later on)
+	// +optional
+	Status PipelineRunSpecStatus `json:"status,omitempty"`
+}
+
+// PipelineSpec defines the desired state of Pipeline.
+type PipelineSpec struct {
+	// Tasks declares the graph of Tasks that execute when this Pipeline is run.
+	Tasks []PipelineTask `json:"tasks,omitempty"`
+}
+
+// PipelineTask defines a task in a Pipeline
+type PipelineTask struct {
+	// Name is the name of this task within the context of a Pipeline.
+	Name string `json:"name,omitempty"`
+	// TaskSpec is a specification of a task embedded in the Pipeline
+	// +optional
+	TaskSpec *EmbeddedTask `json:"taskSpec,omitempty"`
+}
+
+// EmbeddedTask is used to define a Task inline within a Pipeline's PipelineTasks.
+type EmbeddedTask struct {
+	TaskSpec `json:",inline,omitempty"`
+}
+
+// TaskSpec defines the desired state of Task.
+type TaskSpec struct {
+	// Steps are the steps of the build; each step is run sequentially with the source mounted into /workspace.
+	Steps []Step `json:"steps,omitempty"`
+	// Volumes is a collection of volumes that are available to mount into the steps of the build.
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+}
+
+// Step embeds the Container type, which allows it to include fields not provided by Container.
+type Step struct {
+	corev1.Container `json:",inline"`
+
+	// Script is the contents of an executable file to execute.
+	// +optional
+	Script string `json:"script,omitempty"`
+}
+
+// PipelineRunStatus defines the observed state of PipelineRun
+type PipelineRunStatus struct {
+	// Conditions the latest available observations of the resource's current state.
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+	// StartTime is the time the PipelineRun is actually started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the PipelineRun completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// Condition defines a readiness condition for a Knative resource.
+type Condition struct {
+	// Type of condition.
+	Type string `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// LastTransitionTime is the last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// GetCondition returns the condition with the given type, nil if it's not set
+func (s *PipelineRunStatus) GetCondition(conditionType string) *Condition {
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == conditionType {
+			return &s.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PipelineRun represents a single execution of a Pipeline.
+type PipelineRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PipelineRunSpec   `json:"spec,omitempty"`
+	Status PipelineRunStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PipelineRunList contains a list of PipelineRun
+type PipelineRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PipelineRun `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PipelineRun{}, &PipelineRunList{})
+}
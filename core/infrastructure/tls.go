@@ -0,0 +1,231 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	certmanagerv1 "github.com/kiegroup/kogito-operator/core/infrastructure/certmanager/v1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ServingCertSecretAnnotation requests the OpenShift service CA to store the serving certificate of the annotated Service in the given Secret
+	ServingCertSecretAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
+	// InjectCABundleAnnotation requests the OpenShift service CA to inject its certificate in the annotated ConfigMap
+	InjectCABundleAnnotation = "service.beta.openshift.io/inject-cabundle"
+	// ServiceCABundleKey is the key of the certificate injected by the OpenShift service CA in the annotated ConfigMap
+	ServiceCABundleKey = "service-ca.crt"
+	// TLSCACertKey is the key of the CA certificate stored by cert-manager next to the serving certificate
+	TLSCACertKey = "ca.crt"
+
+	tlsSecretSuffix           = "-tls"
+	serviceCAConfigMapSuffix  = "-service-ca"
+	defaultHTTPSPort          = 443
+	certManagerIssuerAPIGroup = "cert-manager.io"
+)
+
+// IsTLSEnabled checks if the given Kogito service serves HTTPS with a serving certificate managed by the operator
+func IsTLSEnabled(instance api.KogitoService) bool {
+	return instance.GetSpec().GetTLS() != nil
+}
+
+// GetTLSSecretName gets the name of the Secret holding the serving certificate of the given Kogito service
+func GetTLSSecretName(instance api.KogitoService) string {
+	return instance.GetName() + tlsSecretSuffix
+}
+
+// GetServiceCAConfigMapName gets the name of the ConfigMap the OpenShift service CA injects its certificate into for the given Kogito service
+func GetServiceCAConfigMapName(instance api.KogitoService) string {
+	return instance.GetName() + serviceCAConfigMapSuffix
+}
+
+// GetKogitoServiceHostName gets the host name of the Service exposing the given Kogito service, matching its serving certificate
+func GetKogitoServiceHostName(instance api.KogitoService) string {
+	return fmt.Sprintf("%s.%s.svc", instance.GetName(), instance.GetNamespace())
+}
+
+// getServicePortName gets the name of the port of the Service exposing the given Kogito service
+func getServicePortName(instance api.KogitoService) string {
+	if IsTLSEnabled(instance) {
+		return framework.DefaultTLSPortName
+	}
+	return framework.DefaultPortName
+}
+
+// TLSHandler ...
+type TLSHandler interface {
+	IsCertManagerAvailable() bool
+	FetchCertificate(key types.NamespacedName) (*certmanagerv1.Certificate, error)
+	CreateCertificate(instance api.KogitoService) *certmanagerv1.Certificate
+	CreateServiceCAConfigMap(instance api.KogitoService) *corev1.ConfigMap
+	FetchCACertificate(instance api.KogitoService) ([]byte, error)
+	NewHTTPClient(instance api.KogitoService) (*http.Client, error)
+	GetComparator() compare.MapComparator
+}
+
+type tlsHandler struct {
+	operator.Context
+}
+
+// NewTLSHandler ...
+func NewTLSHandler(context operator.Context) TLSHandler {
+	return &tlsHandler{
+		context,
+	}
+}
+
+// IsCertManagerAvailable checks if cert-manager CRDs are available in the cluster
+func (t *tlsHandler) IsCertManagerAvailable() bool {
+	return t.Client.HasServerGroup(certmanagerv1.GroupVersion.Group)
+}
+
+func (t *tlsHandler) FetchCertificate(key types.NamespacedName) (*certmanagerv1.Certificate, error) {
+	certificate := &certmanagerv1.Certificate{}
+	if exists, err := kubernetes.ResourceC(t.Client).FetchWithKey(key, certificate); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return certificate, nil
+}
+
+// CreateCertificate creates the cert-manager Certificate of the given Kogito service, valid for all the host names of its Service
+func (t *tlsHandler) CreateCertificate(instance api.KogitoService) *certmanagerv1.Certificate {
+	tlsSpec := instance.GetSpec().GetTLS()
+	return &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: certmanagerv1.CertificateSpec{
+			CommonName: GetKogitoServiceHostName(instance),
+			DNSNames: []string{
+				instance.GetName(),
+				fmt.Sprintf("%s.%s", instance.GetName(), instance.GetNamespace()),
+				GetKogitoServiceHostName(instance),
+				GetKogitoServiceHostName(instance) + ".cluster.local",
+			},
+			SecretName: GetTLSSecretName(instance),
+			IssuerRef: certmanagerv1.ObjectReference{
+				Name:  tlsSpec.GetIssuerName(),
+				Kind:  tlsSpec.GetIssuerKind(),
+				Group: certManagerIssuerAPIGroup,
+			},
+		},
+	}
+}
+
+// CreateServiceCAConfigMap creates the ConfigMap the OpenShift service CA injects its certificate into
+func (t *tlsHandler) CreateServiceCAConfigMap(instance api.KogitoService) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        GetServiceCAConfigMapName(instance),
+			Namespace:   instance.GetNamespace(),
+			Labels:      map[string]string{framework.LabelAppKey: instance.GetName()},
+			Annotations: map[string]string{InjectCABundleAnnotation: "true"},
+		},
+	}
+}
+
+// FetchCACertificate fetches the PEM encoded certificate of the CA signing the serving certificate of the given Kogito service.
+// Returns nil while the CA certificate is not yet available.
+func (t *tlsHandler) FetchCACertificate(instance api.KogitoService) ([]byte, error) {
+	if instance.GetSpec().GetTLS().GetProvider() == api.OpenShiftCertificateProvider {
+		configMap, err := NewConfigMapHandler(t.Context).FetchConfigMap(types.NamespacedName{Name: GetServiceCAConfigMapName(instance), Namespace: instance.GetNamespace()})
+		if err != nil || configMap == nil || len(configMap.Data[ServiceCABundleKey]) == 0 {
+			return nil, err
+		}
+		return []byte(configMap.Data[ServiceCABundleKey]), nil
+	}
+	secret, err := NewSecretHandler(t.Context).FetchSecret(types.NamespacedName{Name: GetTLSSecretName(instance), Namespace: instance.GetNamespace()})
+	if err != nil || secret == nil {
+		return nil, err
+	}
+	// issuers signing with a public CA don't store it, the certificate chain is trusted instead
+	if caCert := secret.Data[TLSCACertKey]; len(caCert) > 0 {
+		return caCert, nil
+	}
+	return secret.Data[corev1.TLSCertKey], nil
+}
+
+// NewHTTPClient creates an HTTP client to call the given Kogito service, trusting its serving certificate when it serves HTTPS
+func (t *tlsHandler) NewHTTPClient(instance api.KogitoService) (*http.Client, error) {
+	if !IsTLSEnabled(instance) {
+		return http.DefaultClient, nil
+	}
+	caCert, err := t.FetchCACertificate(instance)
+	if err != nil {
+		return nil, err
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("No CA certificate found for the serving certificate of %s ", instance.GetName())
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{RootCAs: certPool, MinVersion: tls.VersionTLS12},
+		},
+	}, nil
+}
+
+func (t *tlsHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(certmanagerv1.Certificate{})).
+			WithCustomComparator(framework.CreateCertificateComparator()).
+			Build())
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(corev1.ConfigMap{})).
+			WithCustomComparator(createServiceCAConfigMapComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
+// createServiceCAConfigMapComparator creates a new comparator for the service CA ConfigMap only checking Label and Annotation,
+// its data being injected by the OpenShift service CA
+func createServiceCAConfigMapComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		cmDeployed := deployed.(*corev1.ConfigMap)
+		cmRequested := requested.(*corev1.ConfigMap)
+		for key, value := range cmRequested.GetLabels() {
+			if cmDeployed.GetLabels()[key] != value {
+				return false
+			}
+		}
+		for key, value := range cmRequested.GetAnnotations() {
+			if cmDeployed.GetAnnotations()[key] != value {
+				return false
+			}
+		}
+		return true
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// istioMeshGateway is the reserved name of the gateway of the sidecars in the mesh
+	istioMeshGateway = "mesh"
+)
+
+// VirtualServiceHandler ...
+type VirtualServiceHandler interface {
+	IsVirtualServiceAvailable() bool
+	FetchVirtualService(key types.NamespacedName) (*istiov1beta1.VirtualService, error)
+	CreateVirtualService(instance api.KogitoService, destinations []istiov1beta1.HTTPRouteDestination) *istiov1beta1.VirtualService
+	GetComparator() compare.MapComparator
+}
+
+type virtualServiceHandler struct {
+	operator.Context
+}
+
+// NewVirtualServiceHandler ...
+func NewVirtualServiceHandler(context operator.Context) VirtualServiceHandler {
+	return &virtualServiceHandler{
+		context,
+	}
+}
+
+// IsVirtualServiceAvailable checks if the Istio networking CRDs are available in the cluster
+func (v *virtualServiceHandler) IsVirtualServiceAvailable() bool {
+	return v.Client.HasServerGroup(istiov1beta1.GroupVersion.Group)
+}
+
+func (v *virtualServiceHandler) FetchVirtualService(key types.NamespacedName) (*istiov1beta1.VirtualService, error) {
+	virtualService := &istiov1beta1.VirtualService{}
+	exists, err := kubernetes.ResourceC(v.Client).FetchWithKey(key, virtualService)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return virtualService, nil
+}
+
+// CreateVirtualService creates the VirtualService splitting the traffic addressed to the Service of the given instance between the given destinations.
+// When the instance is exposed through an Istio Gateway, the VirtualService also routes the traffic of its hosts.
+func (v *virtualServiceHandler) CreateVirtualService(instance api.KogitoService, destinations []istiov1beta1.HTTPRouteDestination) *istiov1beta1.VirtualService {
+	virtualService := &istiov1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: istiov1beta1.VirtualServiceSpec{
+			Hosts: []string{instance.GetName()},
+			HTTP: []istiov1beta1.HTTPRoute{
+				{
+					Name:  instance.GetName(),
+					Route: destinations,
+				},
+			},
+		},
+	}
+	if istio := instance.GetSpec().GetIstio(); istio != nil && istio.GetGateway() != nil {
+		virtualService.Spec.Hosts = append(virtualService.Spec.Hosts, istio.GetGateway().GetHosts()...)
+		virtualService.Spec.Gateways = []string{istioMeshGateway, instance.GetName()}
+	}
+	return virtualService
+}
+
+func (v *virtualServiceHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(istiov1beta1.VirtualService{})).
+			WithCustomComparator(framework.CreateVirtualServiceComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
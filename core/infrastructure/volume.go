@@ -0,0 +1,122 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// VolumeHandler handles the storage volumes mounted in the container of a Kogito service
+type VolumeHandler interface {
+	FetchPersistentVolumeClaim(key types.NamespacedName) (*corev1.PersistentVolumeClaim, error)
+	CreatePersistentVolumeClaim(instance api.KogitoService, volume api.VolumeInterface) *corev1.PersistentVolumeClaim
+	MountAsVolume(deployment *appsv1.Deployment, instance api.KogitoService, volume api.VolumeInterface)
+	GetComparator() compare.MapComparator
+}
+
+type volumeHandler struct {
+	operator.Context
+}
+
+// NewVolumeHandler ...
+func NewVolumeHandler(context operator.Context) VolumeHandler {
+	return &volumeHandler{
+		Context: context,
+	}
+}
+
+func (v *volumeHandler) FetchPersistentVolumeClaim(key types.NamespacedName) (*corev1.PersistentVolumeClaim, error) {
+	v.Log.Debug("fetching persistent volume claim.")
+	claim := &corev1.PersistentVolumeClaim{}
+	if exists, err := kubernetes.ResourceC(v.Client).FetchWithKey(key, claim); err != nil {
+		return nil, err
+	} else if !exists {
+		v.Log.Debug("PersistentVolumeClaim not found.")
+		return nil, nil
+	} else {
+		v.Log.Debug("Successfully fetch deployed PersistentVolumeClaim")
+		return claim, nil
+	}
+}
+
+// CreatePersistentVolumeClaim creates the PersistentVolumeClaim requested by the claim template of the given volume
+func (v *volumeHandler) CreatePersistentVolumeClaim(instance api.KogitoService, volume api.VolumeInterface) *corev1.PersistentVolumeClaim {
+	claimTemplate := volume.GetClaimTemplate()
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GetPersistentVolumeClaimName(instance, volume),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      claimTemplate.GetAccessModes(),
+			StorageClassName: claimTemplate.GetStorageClassName(),
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: claimTemplate.GetSize()},
+			},
+		},
+	}
+}
+
+// MountAsVolume mounts the given volume in the container of the service, backed by its PersistentVolumeClaim or by an emptyDir
+func (v *volumeHandler) MountAsVolume(deployment *appsv1.Deployment, instance api.KogitoService, volume api.VolumeInterface) {
+	volumeSource := corev1.VolumeSource{}
+	if volume.GetEmptyDir() != nil {
+		volumeSource.EmptyDir = volume.GetEmptyDir()
+	} else {
+		volumeSource.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: GetPersistentVolumeClaimName(instance, volume),
+			ReadOnly:  volume.IsReadOnly(),
+		}
+	}
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name:         volume.GetName(),
+		VolumeSource: volumeSource,
+	})
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      volume.GetName(),
+		MountPath: volume.GetMountPath(),
+		ReadOnly:  volume.IsReadOnly(),
+	})
+}
+
+func (v *volumeHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(corev1.PersistentVolumeClaim{})).
+			WithCustomComparator(framework.CreatePersistentVolumeClaimComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
+// GetPersistentVolumeClaimName gets the name of the PersistentVolumeClaim backing the given volume,
+// the claims created by the operator are named after the service and the volume
+func GetPersistentVolumeClaimName(instance api.KogitoService, volume api.VolumeInterface) string {
+	if len(volume.GetClaimName()) > 0 {
+		return volume.GetClaimName()
+	}
+	return instance.GetName() + "-" + volume.GetName()
+}
@@ -52,6 +52,8 @@ type DecoratorHandler interface {
 	decoratorForSourceRuntimeBuilder() decorator
 	decoratorForRuntimeBuilder() decorator
 	decoratorForCustomLabels() decorator
+	builderEnvs(build api.KogitoBuildInterface, resources corev1.ResourceRequirements) []corev1.EnvVar
+	artifactEnvs(build api.KogitoBuildInterface) []corev1.EnvVar
 }
 
 type decoratorHandler struct {
@@ -95,20 +97,7 @@ func (b *decoratorHandler) decoratorForRemoteSourceBuilder() decorator {
 // decoratorForLocalSourceBuilder decorates the original BuildConfig to support Local Source build type
 func (b *decoratorHandler) decoratorForLocalSourceBuilder() decorator {
 	return func(build api.KogitoBuildInterface, bc *buildv1.BuildConfig) {
-		var envs []corev1.EnvVar
-		if len(build.GetSpec().GetArtifact().GetGroupID()) > 0 {
-			b.Log.Debug("Setting final generated", "Artifact group ID", build.GetSpec().GetArtifact().GetGroupID())
-			envs = framework.EnvOverride(envs, corev1.EnvVar{Name: mavenGroupIDEnvVar, Value: build.GetSpec().GetArtifact().GetGroupID()})
-		}
-		if len(build.GetSpec().GetArtifact().GetArtifactID()) > 0 {
-			b.Log.Debug("Setting final", "Generated artifact id", build.GetSpec().GetArtifact().GetArtifactID())
-			envs = framework.EnvOverride(envs, corev1.EnvVar{Name: mavenArtifactIDEnvVar, Value: build.GetSpec().GetArtifact().GetArtifactID()})
-		}
-		if len(build.GetSpec().GetArtifact().GetVersion()) > 0 {
-			b.Log.Debug("Setting final generated", "Artifact version", build.GetSpec().GetArtifact().GetVersion())
-			envs = framework.EnvOverride(envs, corev1.EnvVar{Name: mavenArtifactVersionEnvVar, Value: build.GetSpec().GetArtifact().GetVersion()})
-		}
-		bc.Spec.Strategy.SourceStrategy.Env = append(bc.Spec.Strategy.SourceStrategy.Env, envs...)
+		bc.Spec.Strategy.SourceStrategy.Env = append(bc.Spec.Strategy.SourceStrategy.Env, b.artifactEnvs(build)...)
 
 		bc.Spec.Source.Type = buildv1.BuildSourceBinary
 		// The comparator hits reconciliation if this are not set to empty values. TODO: fix on the operator-utils project
@@ -137,23 +126,7 @@ func (b *decoratorHandler) decoratorForSourceBuilder() decorator {
 		bc.Spec.Triggers = []buildv1.BuildTriggerPolicy{
 			{Type: buildv1.ImageChangeBuildTriggerType, ImageChange: &buildv1.ImageChangeTrigger{From: &baseImage}},
 		}
-		// apply the necessary environment variables
-		envs := build.GetSpec().GetEnv()
-		if build.GetSpec().GetRuntime() == api.QuarkusRuntimeType {
-			envs = framework.EnvOverride(envs, corev1.EnvVar{Name: nativeBuildEnvVarKey, Value: strconv.FormatBool(build.GetSpec().IsNative())})
-		}
-		limitCPU, limitMemory := getBuilderLimitsAsIntString(bc)
-		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: builderLimitCPUEnvVarKey, Value: limitCPU})
-		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: builderLimitMemoryEnvVarKey, Value: limitMemory})
-		if len(build.GetSpec().GetMavenMirrorURL()) > 0 {
-			b.Log.Info("Setting maven mirror", "Maven Mirror Url", build.GetSpec().GetMavenMirrorURL())
-			envs = framework.EnvOverride(envs, corev1.EnvVar{Name: mavenMirrorURLEnvVar, Value: build.GetSpec().GetMavenMirrorURL()})
-		}
-		if build.GetSpec().IsEnableMavenDownloadOutput() {
-			b.Log.Debug("Enable logging for transfer progress of downloading/uploading maven dependencies")
-			envs = framework.EnvOverride(envs,
-				corev1.EnvVar{Name: mavenDownloadOutputEnvVar, Value: strconv.FormatBool(build.GetSpec().IsEnableMavenDownloadOutput())})
-		}
+		envs := b.builderEnvs(build, bc.Spec.Resources)
 		incremental := !build.GetSpec().IsDisableIncremental()
 		bc.Spec.Strategy = buildv1.BuildStrategy{
 			Type: buildv1.SourceBuildStrategyType,
@@ -245,3 +218,42 @@ func (b *decoratorHandler) decoratorForCustomLabels() decorator {
 		util.AppendToStringMap(b.Labels, bc.Labels)
 	}
 }
+
+// builderEnvs gets the environment variables required by the Kogito builder image to build the service from source
+func (b *decoratorHandler) builderEnvs(build api.KogitoBuildInterface, resources corev1.ResourceRequirements) []corev1.EnvVar {
+	envs := build.GetSpec().GetEnv()
+	if build.GetSpec().GetRuntime() == api.QuarkusRuntimeType {
+		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: nativeBuildEnvVarKey, Value: strconv.FormatBool(build.GetSpec().IsNative())})
+	}
+	limitCPU, limitMemory := getBuilderLimitsAsIntString(resources)
+	envs = framework.EnvOverride(envs, corev1.EnvVar{Name: builderLimitCPUEnvVarKey, Value: limitCPU})
+	envs = framework.EnvOverride(envs, corev1.EnvVar{Name: builderLimitMemoryEnvVarKey, Value: limitMemory})
+	if len(build.GetSpec().GetMavenMirrorURL()) > 0 {
+		b.Log.Info("Setting maven mirror", "Maven Mirror Url", build.GetSpec().GetMavenMirrorURL())
+		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: mavenMirrorURLEnvVar, Value: build.GetSpec().GetMavenMirrorURL()})
+	}
+	if build.GetSpec().IsEnableMavenDownloadOutput() {
+		b.Log.Debug("Enable logging for transfer progress of downloading/uploading maven dependencies")
+		envs = framework.EnvOverride(envs,
+			corev1.EnvVar{Name: mavenDownloadOutputEnvVar, Value: strconv.FormatBool(build.GetSpec().IsEnableMavenDownloadOutput())})
+	}
+	return envs
+}
+
+// artifactEnvs gets the environment variables overriding the Maven artifact generated by Local Source builds
+func (b *decoratorHandler) artifactEnvs(build api.KogitoBuildInterface) []corev1.EnvVar {
+	var envs []corev1.EnvVar
+	if len(build.GetSpec().GetArtifact().GetGroupID()) > 0 {
+		b.Log.Debug("Setting final generated", "Artifact group ID", build.GetSpec().GetArtifact().GetGroupID())
+		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: mavenGroupIDEnvVar, Value: build.GetSpec().GetArtifact().GetGroupID()})
+	}
+	if len(build.GetSpec().GetArtifact().GetArtifactID()) > 0 {
+		b.Log.Debug("Setting final", "Generated artifact id", build.GetSpec().GetArtifact().GetArtifactID())
+		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: mavenArtifactIDEnvVar, Value: build.GetSpec().GetArtifact().GetArtifactID()})
+	}
+	if len(build.GetSpec().GetArtifact().GetVersion()) > 0 {
+		b.Log.Debug("Setting final generated", "Artifact version", build.GetSpec().GetArtifact().GetVersion())
+		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: mavenArtifactVersionEnvVar, Value: build.GetSpec().GetArtifact().GetVersion()})
+	}
+	return envs
+}
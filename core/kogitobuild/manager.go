@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
 	"github.com/kiegroup/kogito-operator/apis"
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
@@ -44,7 +45,7 @@ type deltaProcessor struct {
 
 // NewDeltaProcessor creates a new DeltaProcessor instance for the given KogitoBuild
 func NewDeltaProcessor(context BuildContext, build api.KogitoBuildInterface) (DeltaProcessor, error) {
-	setDefaults(build)
+	setDefaults(context, build)
 	if err := sanityCheck(build); err != nil {
 		return nil, err
 	}
@@ -55,10 +56,21 @@ func NewDeltaProcessor(context BuildContext, build api.KogitoBuildInterface) (De
 }
 
 // setDefaults sets the default values for the given KogitoBuild
-func setDefaults(build api.KogitoBuildInterface) {
+func setDefaults(context BuildContext, build api.KogitoBuildInterface) {
 	if len(build.GetSpec().GetRuntime()) == 0 {
 		build.GetSpec().SetRuntime(api.QuarkusRuntimeType)
 	}
+	if len(build.GetSpec().GetBackend()) == 0 {
+		build.GetSpec().SetBackend(GetDefaultBackend(context.Client))
+	}
+}
+
+// GetDefaultBackend gets the backend of the KogitoBuilds without one: OpenShift Builds on OpenShift, Tekton on the other clusters
+func GetDefaultBackend(cli *kogitocli.Client) api.KogitoBuildBackendType {
+	if cli.IsOpenshift() {
+		return api.OpenShiftBuildBackend
+	}
+	return api.TektonBuildBackend
 }
 
 // sanityCheck verifies the spec attributes for the given KogitoBuild instance
@@ -70,6 +82,14 @@ func sanityCheck(build api.KogitoBuildInterface) error {
 		len(build.GetSpec().GetGitSource().GetURI()) == 0 {
 		return fmt.Errorf("%s: %s %s", errorPrefix, "Git URL is required when build type is", api.RemoteSourceBuildType)
 	}
+	if build.GetSpec().GetBackend() == api.TektonBuildBackend {
+		if build.GetSpec().GetRegistry() == nil || len(build.GetSpec().GetRegistry().GetURL()) == 0 {
+			return fmt.Errorf("%s: %s %s", errorPrefix, "Registry URL is required when build backend is", api.TektonBuildBackend)
+		}
+		if build.GetSpec().GetType() != api.RemoteSourceBuildType && len(build.GetSpec().GetSourceVolumeClaim()) == 0 {
+			return fmt.Errorf("%s: %s %s", errorPrefix, "Source volume claim is required when build backend is", api.TektonBuildBackend)
+		}
+	}
 	return nil
 }
 
@@ -82,6 +102,8 @@ type buildManager interface {
 	GetRequestedResources() (map[reflect.Type][]client.Object, error)
 	GetDeployedResources() (map[reflect.Type][]client.Object, error)
 	GetComparator() compare.MapComparator
+	// OnResourceChange triggers the backend hooks after the given delta has been applied to the cluster
+	OnResourceChange(resourceType reflect.Type, delta compare.ResourceDelta) error
 }
 
 func (d *deltaProcessor) ProcessDelta() (resultErr error) {
@@ -118,10 +140,8 @@ func (d *deltaProcessor) ProcessDelta() (resultErr error) {
 			return
 		}
 
-		if len(delta.Updated) > 0 {
-			if resultErr = d.onResourceChange(d.build, resourceType, delta.Updated); resultErr != nil {
-				return
-			}
+		if resultErr = m.OnResourceChange(resourceType, delta); resultErr != nil {
+			return
 		}
 	}
 	return
@@ -132,6 +152,10 @@ func (d *deltaProcessor) getBuildManager() buildManager {
 		BuildContext: d.BuildContext,
 		build:        d.build,
 	}
+	if api.TektonBuildBackend == d.build.GetSpec().GetBackend() {
+		manager.Log = manager.Log.WithValues("build_backend", "tekton")
+		return &tektonManager{manager}
+	}
 	if api.LocalSourceBuildType == d.build.GetSpec().GetType() ||
 		api.RemoteSourceBuildType == d.build.GetSpec().GetType() {
 		manager.Log = manager.Log.WithValues("build_type", "source")
@@ -172,26 +196,29 @@ func (m *manager) GetComparator() compare.MapComparator {
 	return compare.MapComparator{Comparator: resourceComparator}
 }
 
-// onResourceChange triggers hooks when a resource is changed
-func (d *deltaProcessor) onResourceChange(instance api.KogitoBuildInterface, resourceType reflect.Type, resources []client.Object) error {
+// OnResourceChange triggers hooks when a resource is changed
+func (m *manager) OnResourceChange(resourceType reflect.Type, delta compare.ResourceDelta) error {
+	if len(delta.Updated) == 0 {
+		return nil
+	}
 	// add other resources if need
 	switch resourceType {
 	case reflect.TypeOf(buildv1.BuildConfig{}):
-		return d.onBuildConfigChange(instance, resources)
+		return m.onBuildConfigChange(m.build, delta.Updated)
 	}
 	return nil
 }
 
 // onBuildConfigChange triggers when a build config changes
-func (d *deltaProcessor) onBuildConfigChange(instance api.KogitoBuildInterface, buildConfigs []client.Object) error {
+func (m *manager) onBuildConfigChange(instance api.KogitoBuildInterface, buildConfigs []client.Object) error {
 	// triggers only on source builds
 	if instance.GetSpec().GetType() == api.RemoteSourceBuildType ||
 		instance.GetSpec().GetType() == api.LocalSourceBuildType {
 		for _, bc := range buildConfigs {
 			// building from source
 			if bc.GetName() == GetBuildBuilderName(instance) {
-				d.Log.Info("Changes detected for build config, starting again", "Build Config", bc.GetName())
-				triggerHandler := NewTriggerHandler(d.Context)
+				m.Log.Info("Changes detected for build config, starting again", "Build Config", bc.GetName())
+				triggerHandler := NewTriggerHandler(m.Context)
 				if err := triggerHandler.StartNewBuild(bc.(*buildv1.BuildConfig)); err != nil {
 					return err
 				}
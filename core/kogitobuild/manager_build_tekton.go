@@ -0,0 +1,127 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitobuild
+
+import (
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	tektonv1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/tekton/v1beta1"
+	manager2 "github.com/kiegroup/kogito-operator/core/manager"
+	buildv1 "github.com/openshift/api/build/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"reflect"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// tektonManager builds the Kogito service with Tekton PipelineRuns, used on clusters without OpenShift Builds.
+// Every generation of the KogitoBuild has its own PipelineRun, previous ones are kept as the build history.
+type tektonManager struct {
+	manager
+}
+
+func (m *tektonManager) GetRequestedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	pipelineRun := NewPipelineRunHandler(m.BuildContext).newPipelineRun(m.build)
+	if err := framework.SetOwner(m.build, m.Scheme, pipelineRun); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(tektonv1beta1.PipelineRun{})] = []client.Object{pipelineRun}
+	return resources, nil
+}
+
+func (m *tektonManager) GetDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	pipelineRun := &tektonv1beta1.PipelineRun{}
+	pipelineRun.Name = GetPipelineRunName(m.build)
+	pipelineRun.Namespace = m.build.GetNamespace()
+	exists, err := kubernetes.ResourceC(m.Client).Fetch(pipelineRun)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		resources[reflect.TypeOf(tektonv1beta1.PipelineRun{})] = []client.Object{pipelineRun}
+	}
+	return resources, nil
+}
+
+func (m *tektonManager) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(tektonv1beta1.PipelineRun{})).
+			WithCustomComparator(framework.CreatePipelineRunComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
+// OnResourceChange cancels the unfinished PipelineRuns of previous generations once a new one is created,
+// the same way OpenShift runs the builds of a BuildConfig serially
+func (m *tektonManager) OnResourceChange(resourceType reflect.Type, delta compare.ResourceDelta) error {
+	if resourceType != reflect.TypeOf(tektonv1beta1.PipelineRun{}) || len(delta.Added) == 0 {
+		return nil
+	}
+	pipelineRunHandler := NewPipelineRunHandler(m.BuildContext)
+	pipelineRuns, err := pipelineRunHandler.FetchPipelineRuns(m.build)
+	if err != nil {
+		return err
+	}
+	for i := range pipelineRuns.Items {
+		if pipelineRuns.Items[i].Name == GetPipelineRunName(m.build) {
+			continue
+		}
+		if err := pipelineRunHandler.CancelPipelineRun(&pipelineRuns.Items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeployBuiltImage sets the image pushed by the latest successful PipelineRun of the given KogitoBuild in its target KogitoRuntime,
+// which rolls it out the same way the ImageStream triggers do for the OpenShift builds
+func DeployBuiltImage(context BuildContext, build api.KogitoBuildInterface, runtimeHandler manager2.KogitoRuntimeHandler) error {
+	pipelineRuns, err := NewPipelineRunHandler(context).FetchPipelineRuns(build)
+	if err != nil {
+		return err
+	}
+	var latestRun *tektonv1beta1.PipelineRun
+	for i := range pipelineRuns.Items {
+		if getPipelineRunPhase(&pipelineRuns.Items[i]) != buildv1.BuildPhaseComplete {
+			continue
+		}
+		if latestRun == nil || pipelineRuns.Items[i].CreationTimestamp.After(latestRun.CreationTimestamp.Time) {
+			latestRun = &pipelineRuns.Items[i]
+		}
+	}
+	if latestRun == nil {
+		return nil
+	}
+	runtime, err := runtimeHandler.FetchKogitoRuntimeInstance(types.NamespacedName{Name: build.GetSpec().GetTargetKogitoRuntime(), Namespace: build.GetNamespace()})
+	if err != nil {
+		return err
+	}
+	if runtime == nil {
+		context.Log.Debug("Target KogitoRuntime not found, the built image is not deployed", "KogitoRuntime", build.GetSpec().GetTargetKogitoRuntime())
+		return nil
+	}
+	image := GetPipelineRunImage(build, latestRun.Name)
+	if runtime.GetSpec().GetImage() == image {
+		return nil
+	}
+	context.Log.Info("Deploying built image", "KogitoRuntime", runtime.GetName(), "Image", image)
+	runtime.GetSpec().SetImage(image)
+	return kubernetes.ResourceC(context.Client).Update(runtime)
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitobuild
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	tektonv1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/tekton/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/kiegroup/kogito-operator/version/app"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"testing"
+	"time"
+)
+
+func TestProcessDeltaWhenBuildingWithTekton(t *testing.T) {
+	build := newTektonBuild(t, api.RemoteSourceBuildType)
+	build.Spec.GitSource.URI = "https://github.com/kiegroup/kogito-examples"
+	previousRun := &tektonv1beta1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "quarkus-example-1",
+			Namespace: t.Name(),
+			Labels:    map[string]string{framework.LabelAppKey: "quarkus-example", LabelKeyBuildType: string(api.RemoteSourceBuildType)},
+		},
+		Status: tektonv1beta1.PipelineRunStatus{
+			Conditions: []tektonv1beta1.Condition{{Type: tektonv1beta1.ConditionSucceeded, Status: corev1.ConditionUnknown, Reason: "Running"}},
+		},
+	}
+	assert.NoError(t, framework.SetOwner(build, meta.GetRegisteredSchema(), previousRun))
+	cli := test.NewFakeClientBuilder().AddK8sObjects(build, previousRun).Build()
+	context := BuildContext{
+		Context: operator.Context{
+			Client:  cli,
+			Log:     test.TestLogger,
+			Scheme:  meta.GetRegisteredSchema(),
+			Version: app.Version,
+		},
+	}
+	deltaProcessor, err := NewDeltaProcessor(context, build)
+	assert.NoError(t, err)
+	assert.NoError(t, deltaProcessor.ProcessDelta())
+
+	pipelineRun := &tektonv1beta1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example-2", Namespace: t.Name()}}
+	test.AssertFetchMustExist(t, cli, pipelineRun)
+	assert.True(t, metav1.IsControlledBy(pipelineRun, build))
+	assert.Len(t, pipelineRun.Spec.PipelineSpec.Tasks[0].TaskSpec.Steps, 4)
+
+	// the PipelineRun of the previous generation is outdated
+	previousRun = &tektonv1beta1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example-1", Namespace: t.Name()}}
+	test.AssertFetchMustExist(t, cli, previousRun)
+	assert.Equal(t, tektonv1beta1.PipelineRunSpecStatusCancelled, previousRun.Spec.Status)
+}
+
+func TestNewWhenSanityCheckComplainAboutTektonRegistry(t *testing.T) {
+	build := newTektonBuild(t, api.BinaryBuildType)
+	build.Spec.SourceVolumeClaim = "quarkus-example-binaries"
+	build.Spec.Registry = nil
+	deltaProcessor, err := NewDeltaProcessor(newTektonBuildContext(build), build)
+	assert.Error(t, err)
+	assert.Nil(t, deltaProcessor)
+}
+
+func TestNewDeltaProcessorDefaultsToTektonOnKubernetes(t *testing.T) {
+	build := newTektonBuild(t, api.RemoteSourceBuildType)
+	build.Spec.GitSource.URI = "https://github.com/kiegroup/kogito-examples"
+	build.Spec.Backend = ""
+	_, err := NewDeltaProcessor(newTektonBuildContext(build), build)
+	assert.NoError(t, err)
+	assert.Equal(t, api.TektonBuildBackend, build.Spec.Backend)
+}
+
+// fakeRuntimeHandler fetches the KogitoRuntimes of the app.kiegroup.org group
+type fakeRuntimeHandler struct {
+	operator.Context
+}
+
+func (f *fakeRuntimeHandler) FetchKogitoRuntimeInstance(key types.NamespacedName) (api.KogitoRuntimeInterface, error) {
+	instance := &v1beta1.KogitoRuntime{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+	if exists, err := kubernetes.ResourceC(f.Client).Fetch(instance); err != nil || !exists {
+		return nil, err
+	}
+	return instance, nil
+}
+
+func (f *fakeRuntimeHandler) FetchAllKogitoRuntimeInstances(namespace string) (api.KogitoRuntimeListInterface, error) {
+	list := &v1beta1.KogitoRuntimeList{}
+	return list, kubernetes.ResourceC(f.Client).ListWithNamespace(namespace, list)
+}
+
+func TestDeployBuiltImage(t *testing.T) {
+	build := newTektonBuild(t, api.RemoteSourceBuildType)
+	runtime := &v1beta1.KogitoRuntime{ObjectMeta: metav1.ObjectMeta{Name: build.Spec.TargetKogitoRuntime, Namespace: t.Name()}}
+	newPipelineRun := func(name string, created time.Time, status corev1.ConditionStatus) *tektonv1beta1.PipelineRun {
+		pipelineRun := &tektonv1beta1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         t.Name(),
+				CreationTimestamp: metav1.NewTime(created),
+				Labels:            map[string]string{framework.LabelAppKey: "quarkus-example", LabelKeyBuildType: string(api.RemoteSourceBuildType)},
+			},
+			Status: tektonv1beta1.PipelineRunStatus{
+				Conditions: []tektonv1beta1.Condition{{Type: tektonv1beta1.ConditionSucceeded, Status: status}},
+			},
+		}
+		assert.NoError(t, framework.SetOwner(build, meta.GetRegisteredSchema(), pipelineRun))
+		return pipelineRun
+	}
+	now := time.Now()
+	firstRun := newPipelineRun("quarkus-example-1", now.Add(-2*time.Hour), corev1.ConditionTrue)
+	secondRun := newPipelineRun("quarkus-example-2", now.Add(-time.Hour), corev1.ConditionTrue)
+	runningRun := newPipelineRun("quarkus-example-3", now, corev1.ConditionUnknown)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(build, runtime, firstRun, secondRun, runningRun).Build()
+	context := BuildContext{Context: operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}}
+
+	// the image of the latest successful build is rolled out
+	assert.NoError(t, DeployBuiltImage(context, build, &fakeRuntimeHandler{context.Context}))
+	test.AssertFetchMustExist(t, cli, runtime)
+	assert.Equal(t, "quay.io/myorg/quarkus-example:quarkus-example-2", runtime.Spec.Image)
+}
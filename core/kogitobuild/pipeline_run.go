@@ -0,0 +1,351 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitobuild
+
+import (
+	"fmt"
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	tektonv1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/tekton/v1beta1"
+	buildv1 "github.com/openshift/api/build/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	gitImageEnvVar    = "GIT_IMAGE"
+	kanikoImageEnvVar = "KANIKO_IMAGE"
+	shellImageEnvVar  = "SHELL_IMAGE"
+	// defaultGitImage image used to clone the Git repository of Remote Source builds
+	defaultGitImage = "docker.io/alpine/git:v2.32.0"
+	// defaultKanikoImage image used to build and push the final Kogito service image
+	defaultKanikoImage = "gcr.io/kaniko-project/executor:v1.6.0"
+	// defaultShellImage image used to prepare the context of the final Kogito service image
+	defaultShellImage = "docker.io/library/busybox:1.33.1"
+
+	pipelineRunTaskName     = "build"
+	sourceVolumeName        = "source"
+	contextVolumeName       = "context"
+	dockerConfigVolumeName  = "docker-config"
+	sourceMountPath         = "/workspace/source"
+	contextMountPath        = "/workspace/context"
+	dockerConfigMountPath   = "/kaniko/.docker"
+	dockerConfigJSONFileKey = "config.json"
+	dockerConfigEnvVar      = "DOCKER_CONFIG"
+	s2iSourcePath           = "/tmp/src"
+	s2iAssembleScript       = "/usr/local/s2i/assemble"
+
+	gitURIEnvVar       = "GIT_URI"
+	gitReferenceEnvVar = "GIT_REFERENCE"
+	sourceDirEnvVar    = "SOURCE_DIR"
+)
+
+// PipelineRunHandler ...
+type PipelineRunHandler interface {
+	newPipelineRun(build api.KogitoBuildInterface) *tektonv1beta1.PipelineRun
+	FetchPipelineRuns(build api.KogitoBuildInterface) (*tektonv1beta1.PipelineRunList, error)
+	CancelPipelineRun(pipelineRun *tektonv1beta1.PipelineRun) error
+}
+
+type pipelineRunHandler struct {
+	BuildContext
+}
+
+// NewPipelineRunHandler ...
+func NewPipelineRunHandler(context BuildContext) PipelineRunHandler {
+	return &pipelineRunHandler{
+		context,
+	}
+}
+
+// GetPipelineRunName gets the name of the PipelineRun building the current generation of the given KogitoBuild
+func GetPipelineRunName(build api.KogitoBuildInterface) string {
+	return strings.Join([]string{build.GetName(), strconv.FormatInt(build.GetGeneration(), 10)}, "-")
+}
+
+// GetRegistryImageName gets the image name, without the tag, pushed to the registry by the Tekton builds
+func GetRegistryImageName(build api.KogitoBuildInterface) string {
+	return strings.Join([]string{strings.TrimSuffix(build.GetSpec().GetRegistry().GetURL(), "/"), GetApplicationName(build)}, "/")
+}
+
+// GetPipelineRunImage gets the image pushed by the given PipelineRun, tagged with the name of the PipelineRun so that every build has its own image
+func GetPipelineRunImage(build api.KogitoBuildInterface, pipelineRunName string) string {
+	return strings.Join([]string{GetRegistryImageName(build), pipelineRunName}, ":")
+}
+
+// newPipelineRun creates the Tekton PipelineRun that builds the final Kogito service image and pushes it to the configured registry.
+// Remote Source builds clone the Git repository, Local Source and Binary builds read the files from the given PersistentVolumeClaim.
+func (p *pipelineRunHandler) newPipelineRun(build api.KogitoBuildInterface) *tektonv1beta1.PipelineRun {
+	labels := map[string]string{
+		LabelKeyBuildType:     string(build.GetSpec().GetType()),
+		framework.LabelAppKey: GetApplicationName(build),
+	}
+	util.AppendToStringMap(p.Labels, labels)
+
+	var steps []tektonv1beta1.Step
+	switch build.GetSpec().GetType() {
+	case api.RemoteSourceBuildType:
+		steps = append(steps, p.newGitCloneStep(build), p.newSourceBuildStep(build))
+	case api.LocalSourceBuildType:
+		steps = append(steps, p.newSourceBuildStep(build))
+	}
+	steps = append(steps, p.newPrepareContextStep(build), p.newBuildAndPushStep(build))
+
+	return &tektonv1beta1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GetPipelineRunName(build),
+			Namespace: build.GetNamespace(),
+			Labels:    labels,
+		},
+		Spec: tektonv1beta1.PipelineRunSpec{
+			PipelineSpec: &tektonv1beta1.PipelineSpec{
+				Tasks: []tektonv1beta1.PipelineTask{
+					{
+						Name: pipelineRunTaskName,
+						TaskSpec: &tektonv1beta1.EmbeddedTask{
+							TaskSpec: tektonv1beta1.TaskSpec{
+								Steps:   steps,
+								Volumes: p.newVolumes(build),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// newVolumes creates the volumes shared among the steps of the build
+func (p *pipelineRunHandler) newVolumes(build api.KogitoBuildInterface) []corev1.Volume {
+	source := corev1.Volume{Name: sourceVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}
+	if build.GetSpec().GetType() != api.RemoteSourceBuildType {
+		source.VolumeSource = corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: build.GetSpec().GetSourceVolumeClaim(), ReadOnly: true},
+		}
+	}
+	volumes := []corev1.Volume{
+		source,
+		{Name: contextVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	}
+	if len(build.GetSpec().GetRegistry().GetSecret()) > 0 {
+		volumes = append(volumes, corev1.Volume{
+			Name: dockerConfigVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: build.GetSpec().GetRegistry().GetSecret(),
+					Items:      []corev1.KeyToPath{{Key: corev1.DockerConfigJsonKey, Path: dockerConfigJSONFileKey}},
+				},
+			},
+		})
+	}
+	return volumes
+}
+
+// newGitCloneStep creates the step cloning the Git repository of Remote Source builds into the source volume
+func (p *pipelineRunHandler) newGitCloneStep(build api.KogitoBuildInterface) tektonv1beta1.Step {
+	return tektonv1beta1.Step{
+		Container: corev1.Container{
+			Name:         "git-clone",
+			Image:        getImageFromEnv(gitImageEnvVar, defaultGitImage),
+			WorkingDir:   sourceMountPath,
+			VolumeMounts: []corev1.VolumeMount{{Name: sourceVolumeName, MountPath: sourceMountPath}},
+			Env: []corev1.EnvVar{
+				{Name: gitURIEnvVar, Value: build.GetSpec().GetGitSource().GetURI()},
+				{Name: gitReferenceEnvVar, Value: build.GetSpec().GetGitSource().GetReference()},
+			},
+		},
+		Script: `#!/bin/sh
+set -e
+git clone "${GIT_URI}" .
+if [ -n "${GIT_REFERENCE}" ]; then
+  git checkout "${GIT_REFERENCE}"
+fi
+`,
+	}
+}
+
+// newSourceBuildStep creates the step running the assemble script of the Kogito builder image against the sources,
+// the same way the OpenShift source-to-image build does, then copies the resulting binaries to the image context
+func (p *pipelineRunHandler) newSourceBuildStep(build api.KogitoBuildInterface) tektonv1beta1.Step {
+	decoratorHandler := NewDecoratorHandler(p.BuildContext)
+	envs := decoratorHandler.builderEnvs(build, build.GetSpec().GetResources())
+	sourceDir := sourceMountPath
+	if build.GetSpec().GetType() == api.LocalSourceBuildType {
+		envs = append(envs, decoratorHandler.artifactEnvs(build)...)
+	} else if contextDir := strings.Trim(build.GetSpec().GetGitSource().GetContextDir(), "/"); len(contextDir) > 0 {
+		sourceDir = path.Join(sourceMountPath, contextDir)
+	}
+	envs = framework.EnvOverride(envs, corev1.EnvVar{Name: sourceDirEnvVar, Value: sourceDir})
+	return tektonv1beta1.Step{
+		Container: corev1.Container{
+			Name:      "s2i-build",
+			Image:     p.resolveKogitoImage(build, true),
+			Env:       envs,
+			Resources: build.GetSpec().GetResources(),
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: sourceVolumeName, MountPath: sourceMountPath},
+				{Name: contextVolumeName, MountPath: contextMountPath},
+			},
+		},
+		Script: fmt.Sprintf(`#!/bin/bash
+set -e
+mkdir -p %[1]s
+cp -R "${SOURCE_DIR}"/. %[1]s/
+%[2]s
+mkdir -p %[3]s/bin
+cp -R %[4]s/. %[3]s/bin/
+`, s2iSourcePath, s2iAssembleScript, contextMountPath, runnerSourcePath),
+	}
+}
+
+// newPrepareContextStep creates the step writing the Dockerfile that copies the binaries on top of the Kogito runtime image.
+// Binary builds take the binaries as they are from the source volume.
+func (p *pipelineRunHandler) newPrepareContextStep(build api.KogitoBuildInterface) tektonv1beta1.Step {
+	copyBinaries := ""
+	if build.GetSpec().GetType() == api.BinaryBuildType {
+		copyBinaries = fmt.Sprintf("cp -R %s/. %s/bin/\n", sourceMountPath, contextMountPath)
+	}
+	return tektonv1beta1.Step{
+		Container: corev1.Container{
+			Name:  "prepare-context",
+			Image: getImageFromEnv(shellImageEnvVar, defaultShellImage),
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: sourceVolumeName, MountPath: sourceMountPath},
+				{Name: contextVolumeName, MountPath: contextMountPath},
+			},
+		},
+		Script: fmt.Sprintf(`#!/bin/sh
+set -e
+mkdir -p %[1]s/bin
+%[2]scat > %[1]s/Dockerfile <<EOF
+FROM %[3]s
+COPY --chown=1001:0 bin/ %[4]s/
+EOF
+`, contextMountPath, copyBinaries, p.resolveKogitoImage(build, false), runnerSourcePath),
+	}
+}
+
+// newBuildAndPushStep creates the step building the final Kogito service image and pushing it to the configured registry
+func (p *pipelineRunHandler) newBuildAndPushStep(build api.KogitoBuildInterface) tektonv1beta1.Step {
+	args := []string{
+		"--dockerfile=" + path.Join(contextMountPath, "Dockerfile"),
+		"--context=dir://" + contextMountPath,
+		"--destination=" + GetPipelineRunImage(build, GetPipelineRunName(build)),
+	}
+	if build.GetSpec().GetRegistry().IsInsecure() {
+		args = append(args, "--insecure", "--skip-tls-verify")
+	}
+	step := tektonv1beta1.Step{
+		Container: corev1.Container{
+			Name:         "build-and-push",
+			Image:        getImageFromEnv(kanikoImageEnvVar, defaultKanikoImage),
+			Args:         args,
+			VolumeMounts: []corev1.VolumeMount{{Name: contextVolumeName, MountPath: contextMountPath}},
+		},
+	}
+	if len(build.GetSpec().GetRegistry().GetSecret()) > 0 {
+		step.Env = []corev1.EnvVar{{Name: dockerConfigEnvVar, Value: dockerConfigMountPath}}
+		step.VolumeMounts = append(step.VolumeMounts, corev1.VolumeMount{Name: dockerConfigVolumeName, MountPath: dockerConfigMountPath, ReadOnly: true})
+	}
+	return step
+}
+
+// resolveKogitoImage resolves the full name of the Kogito builder or runtime image, e.g. quay.io/kiegroup/kogito-builder:1.0
+func (p *pipelineRunHandler) resolveKogitoImage(build api.KogitoBuildInterface, isBuilder bool) string {
+	imageStreamHandler := NewImageSteamHandler(p.BuildContext)
+	return strings.Join([]string{
+		resolveKogitoImageRegistryNamespace(build, isBuilder),
+		imageStreamHandler.ResolveKogitoImageNameTag(build, isBuilder),
+	}, "/")
+}
+
+// FetchPipelineRuns lists all the PipelineRuns created for the given KogitoBuild
+func (p *pipelineRunHandler) FetchPipelineRuns(build api.KogitoBuildInterface) (*tektonv1beta1.PipelineRunList, error) {
+	pipelineRuns := &tektonv1beta1.PipelineRunList{}
+	if err := kubernetes.ResourceC(p.Client).ListWithNamespaceAndLabel(
+		build.GetNamespace(), pipelineRuns,
+		map[string]string{
+			framework.LabelAppKey: GetApplicationName(build),
+			LabelKeyBuildType:     string(build.GetSpec().GetType())}); err != nil {
+		return nil, err
+	}
+	var owned []tektonv1beta1.PipelineRun
+	for _, pipelineRun := range pipelineRuns.Items {
+		if metav1.IsControlledBy(&pipelineRun, build) {
+			owned = append(owned, pipelineRun)
+		}
+	}
+	pipelineRuns.Items = owned
+	return pipelineRuns, nil
+}
+
+// CancelPipelineRun cancels the given PipelineRun if it's not finished yet
+func (p *pipelineRunHandler) CancelPipelineRun(pipelineRun *tektonv1beta1.PipelineRun) error {
+	switch getPipelineRunPhase(pipelineRun) {
+	case buildv1.BuildPhaseNew, buildv1.BuildPhasePending, buildv1.BuildPhaseRunning:
+		if pipelineRun.Spec.Status == tektonv1beta1.PipelineRunSpecStatusCancelled {
+			return nil
+		}
+		p.Log.Info("Cancelling outdated PipelineRun", "PipelineRun", pipelineRun.Name)
+		pipelineRun.Spec.Status = tektonv1beta1.PipelineRunSpecStatusCancelled
+		return kubernetes.ResourceC(p.Client).Update(pipelineRun)
+	}
+	return nil
+}
+
+// getPipelineRunPhase maps the Succeeded condition of the given PipelineRun to the equivalent OpenShift Build phase
+func getPipelineRunPhase(pipelineRun *tektonv1beta1.PipelineRun) buildv1.BuildPhase {
+	condition := pipelineRun.Status.GetCondition(tektonv1beta1.ConditionSucceeded)
+	if condition == nil {
+		return buildv1.BuildPhaseNew
+	}
+	switch condition.Status {
+	case corev1.ConditionTrue:
+		return buildv1.BuildPhaseComplete
+	case corev1.ConditionFalse:
+		if condition.Reason == tektonv1beta1.PipelineRunReasonCancelled ||
+			condition.Reason == tektonv1beta1.PipelineRunReasonCancelledDeprecated {
+			return buildv1.BuildPhaseCancelled
+		}
+		return buildv1.BuildPhaseFailed
+	}
+	if condition.Reason == tektonv1beta1.PipelineRunReasonRunning {
+		return buildv1.BuildPhaseRunning
+	}
+	return buildv1.BuildPhasePending
+}
+
+// getPipelineRunMessage gets the message of the Succeeded condition of the given PipelineRun
+func getPipelineRunMessage(pipelineRun *tektonv1beta1.PipelineRun) string {
+	if condition := pipelineRun.Status.GetCondition(tektonv1beta1.ConditionSucceeded); condition != nil {
+		return condition.Message
+	}
+	return ""
+}
+
+// getImageFromEnv gets the image defined in the given operator environment variable, the default image otherwise
+func getImageFromEnv(envVar, defaultImage string) string {
+	image := os.Getenv(envVar)
+	if len(image) == 0 {
+		image = defaultImage
+	}
+	return image
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitobuild
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	tektonv1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/tekton/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/kiegroup/kogito-operator/version/app"
+	buildv1 "github.com/openshift/api/build/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+)
+
+func newTektonBuild(t *testing.T, buildType api.KogitoBuildType) *v1beta1.KogitoBuild {
+	return &v1beta1.KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example", Namespace: t.Name(), Generation: 2},
+		Spec: v1beta1.KogitoBuildSpec{
+			Runtime:             api.QuarkusRuntimeType,
+			Type:                buildType,
+			Backend:             api.TektonBuildBackend,
+			TargetKogitoRuntime: "quarkus-example",
+			Registry:            &v1beta1.ImageRegistry{URL: "quay.io/myorg/", Secret: "quay-push", Insecure: true},
+		},
+	}
+}
+
+func newTektonBuildContext(objects ...*v1beta1.KogitoBuild) BuildContext {
+	builder := test.NewFakeClientBuilder()
+	for _, object := range objects {
+		builder.AddK8sObjects(object)
+	}
+	return BuildContext{
+		Context: operator.Context{
+			Client:  builder.Build(),
+			Log:     test.TestLogger,
+			Scheme:  meta.GetRegisteredSchema(),
+			Version: app.Version,
+			Labels:  map[string]string{"team": "kogito"},
+		},
+	}
+}
+
+func getStepNames(pipelineRun *tektonv1beta1.PipelineRun) []string {
+	var names []string
+	for _, step := range pipelineRun.Spec.PipelineSpec.Tasks[0].TaskSpec.Steps {
+		names = append(names, step.Name)
+	}
+	return names
+}
+
+func Test_newPipelineRun_RemoteSource(t *testing.T) {
+	build := newTektonBuild(t, api.RemoteSourceBuildType)
+	build.Spec.GitSource = v1beta1.GitSource{URI: "https://github.com/kiegroup/kogito-examples", Reference: "stable", ContextDir: "process-quarkus-example/"}
+	build.Spec.MavenMirrorURL = "https://maven.example.com"
+
+	pipelineRun := NewPipelineRunHandler(newTektonBuildContext()).newPipelineRun(build)
+
+	assert.Equal(t, "quarkus-example-2", pipelineRun.Name)
+	assert.Equal(t, "quarkus-example", pipelineRun.Labels[framework.LabelAppKey])
+	assert.Equal(t, string(api.RemoteSourceBuildType), pipelineRun.Labels[LabelKeyBuildType])
+	assert.Equal(t, "kogito", pipelineRun.Labels["team"])
+	assert.Equal(t, []string{"git-clone", "s2i-build", "prepare-context", "build-and-push"}, getStepNames(pipelineRun))
+
+	taskSpec := pipelineRun.Spec.PipelineSpec.Tasks[0].TaskSpec
+	assert.NotNil(t, taskSpec.Volumes[0].EmptyDir)
+	assert.Equal(t, "quay-push", taskSpec.Volumes[2].Secret.SecretName)
+
+	gitClone := taskSpec.Steps[0]
+	assert.Contains(t, gitClone.Env, corev1.EnvVar{Name: gitURIEnvVar, Value: "https://github.com/kiegroup/kogito-examples"})
+	assert.Contains(t, gitClone.Env, corev1.EnvVar{Name: gitReferenceEnvVar, Value: "stable"})
+
+	s2iBuild := taskSpec.Steps[1]
+	assert.Contains(t, s2iBuild.Image, GetDefaultBuilderImage())
+	assert.Contains(t, s2iBuild.Env, corev1.EnvVar{Name: sourceDirEnvVar, Value: "/workspace/source/process-quarkus-example"})
+	assert.Contains(t, s2iBuild.Env, corev1.EnvVar{Name: mavenMirrorURLEnvVar, Value: "https://maven.example.com"})
+	assert.Contains(t, s2iBuild.Script, s2iAssembleScript)
+
+	assert.Contains(t, taskSpec.Steps[2].Script, "FROM ")
+	assert.Contains(t, taskSpec.Steps[2].Script, GetDefaultRuntimeJVMImage())
+
+	buildAndPush := taskSpec.Steps[3]
+	assert.Contains(t, buildAndPush.Args, "--destination=quay.io/myorg/quarkus-example:quarkus-example-2")
+	assert.Contains(t, buildAndPush.Args, "--insecure")
+	assert.Contains(t, buildAndPush.Env, corev1.EnvVar{Name: dockerConfigEnvVar, Value: dockerConfigMountPath})
+}
+
+func Test_newPipelineRun_LocalSource(t *testing.T) {
+	build := newTektonBuild(t, api.LocalSourceBuildType)
+	build.Spec.SourceVolumeClaim = "quarkus-example-sources"
+	build.Spec.Artifact = v1beta1.Artifact{GroupID: "com.example"}
+	build.Spec.Registry = &v1beta1.ImageRegistry{URL: "quay.io/myorg"}
+
+	pipelineRun := NewPipelineRunHandler(newTektonBuildContext()).newPipelineRun(build)
+
+	assert.Equal(t, []string{"s2i-build", "prepare-context", "build-and-push"}, getStepNames(pipelineRun))
+	taskSpec := pipelineRun.Spec.PipelineSpec.Tasks[0].TaskSpec
+	assert.Len(t, taskSpec.Volumes, 2)
+	assert.Equal(t, "quarkus-example-sources", taskSpec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Contains(t, taskSpec.Steps[0].Env, corev1.EnvVar{Name: mavenGroupIDEnvVar, Value: "com.example"})
+	assert.Contains(t, taskSpec.Steps[0].Env, corev1.EnvVar{Name: sourceDirEnvVar, Value: sourceMountPath})
+	assert.NotContains(t, taskSpec.Steps[2].Args, "--insecure")
+	assert.Empty(t, taskSpec.Steps[2].Env)
+}
+
+func Test_newPipelineRun_Binary(t *testing.T) {
+	build := newTektonBuild(t, api.BinaryBuildType)
+	build.Spec.SourceVolumeClaim = "quarkus-example-binaries"
+	build.Spec.Native = true
+
+	pipelineRun := NewPipelineRunHandler(newTektonBuildContext()).newPipelineRun(build)
+
+	assert.Equal(t, []string{"prepare-context", "build-and-push"}, getStepNames(pipelineRun))
+	taskSpec := pipelineRun.Spec.PipelineSpec.Tasks[0].TaskSpec
+	assert.Equal(t, "quarkus-example-binaries", taskSpec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Contains(t, taskSpec.Steps[0].Script, "cp -R /workspace/source/. /workspace/context/bin/")
+	assert.Contains(t, taskSpec.Steps[0].Script, GetDefaultRuntimeNativeImage())
+}
+
+func Test_getPipelineRunPhase(t *testing.T) {
+	newPipelineRun := func(status corev1.ConditionStatus, reason string) *tektonv1beta1.PipelineRun {
+		return &tektonv1beta1.PipelineRun{
+			Status: tektonv1beta1.PipelineRunStatus{
+				Conditions: []tektonv1beta1.Condition{{Type: tektonv1beta1.ConditionSucceeded, Status: status, Reason: reason}},
+			},
+		}
+	}
+	assert.Equal(t, buildv1.BuildPhaseNew, getPipelineRunPhase(&tektonv1beta1.PipelineRun{}))
+	assert.Equal(t, buildv1.BuildPhasePending, getPipelineRunPhase(newPipelineRun(corev1.ConditionUnknown, "Started")))
+	assert.Equal(t, buildv1.BuildPhaseRunning, getPipelineRunPhase(newPipelineRun(corev1.ConditionUnknown, "Running")))
+	assert.Equal(t, buildv1.BuildPhaseComplete, getPipelineRunPhase(newPipelineRun(corev1.ConditionTrue, "Succeeded")))
+	assert.Equal(t, buildv1.BuildPhaseFailed, getPipelineRunPhase(newPipelineRun(corev1.ConditionFalse, "Failed")))
+	assert.Equal(t, buildv1.BuildPhaseCancelled, getPipelineRunPhase(newPipelineRun(corev1.ConditionFalse, "Cancelled")))
+	assert.Equal(t, buildv1.BuildPhaseCancelled, getPipelineRunPhase(newPipelineRun(corev1.ConditionFalse, "PipelineRunCancelled")))
+}
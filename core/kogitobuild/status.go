@@ -19,6 +19,7 @@ import (
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/client/openshift"
 	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/metrics"
 	buildv1 "github.com/openshift/api/build/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -55,6 +56,7 @@ func NewStatusHandler(context BuildContext) StatusHandler {
 }
 
 func (s *statusHandler) HandleStatusChange(instance api.KogitoBuildInterface, err error) {
+	defer s.recordMetrics(instance, err)
 	if instance.GetStatus().GetConditions() == nil {
 		instance.GetStatus().SetConditions(&[]metav1.Condition{})
 	}
@@ -70,6 +72,16 @@ func (s *statusHandler) HandleStatusChange(instance api.KogitoBuildInterface, er
 	}
 }
 
+// recordMetrics counts the reconciliation of the given instance and reports its builds per phase
+func (s *statusHandler) recordMetrics(instance api.KogitoBuildInterface, err error) {
+	result, reason := metrics.ReconcileSuccess, ""
+	if err != nil {
+		result, reason = metrics.ReconcileFailure, string(api.OperatorFailureReason)
+	}
+	metrics.RecordReconcile(metrics.GetKind(instance, s.Scheme), result, reason)
+	metrics.SetKogitoBuilds(instance.GetNamespace(), instance.GetName(), instance.GetStatus().GetBuilds())
+}
+
 // newSuccessfulCondition ...
 func (s *statusHandler) newSuccessfulCondition(status metav1.ConditionStatus, reason api.KogitoBuildConditionReason) metav1.Condition {
 	return metav1.Condition{
@@ -117,6 +129,9 @@ func (s *statusHandler) setFailed(conditions *[]metav1.Condition, reason api.Kog
 }
 
 func (s *statusHandler) handleConditionTransition(instance api.KogitoBuildInterface) error {
+	if instance.GetSpec().GetBackend() == api.TektonBuildBackend {
+		return s.handlePipelineRunConditionTransition(instance)
+	}
 	err := s.updateBuildsStatus(instance)
 	if err != nil {
 		return err
@@ -136,7 +151,53 @@ func (s *statusHandler) handleConditionTransition(instance api.KogitoBuildInterf
 		})
 		latestBuild := builds.Items[0]
 		instance.GetStatus().SetLatestBuild(latestBuild.Name)
-		s.addCondition(latestBuild, instance.GetStatus().GetConditions())
+		s.addCondition(latestBuild.Status.Phase, latestBuild.Status.Message, instance.GetStatus().GetConditions())
+		return nil
+	}
+	s.setRunningConditions(instance.GetStatus().GetConditions(), api.BuildNotStartedReason)
+	return nil
+}
+
+// handlePipelineRunConditionTransition updates the status of a KogitoBuild running on the Tekton backend,
+// mapping the PipelineRuns to the same phases of the OpenShift builds
+func (s *statusHandler) handlePipelineRunConditionTransition(instance api.KogitoBuildInterface) error {
+	pipelineRuns, err := NewPipelineRunHandler(s.BuildContext).FetchPipelineRuns(instance)
+	if err != nil {
+		return err
+	}
+	builds := instance.GetStatus().GetBuilds()
+	var newRuns, pendingRuns, runningRuns, completeRuns, failedRuns, cancelledRuns []string
+	for i := range pipelineRuns.Items {
+		name := pipelineRuns.Items[i].Name
+		switch getPipelineRunPhase(&pipelineRuns.Items[i]) {
+		case buildv1.BuildPhaseNew:
+			newRuns = append(newRuns, name)
+		case buildv1.BuildPhasePending:
+			pendingRuns = append(pendingRuns, name)
+		case buildv1.BuildPhaseRunning:
+			runningRuns = append(runningRuns, name)
+		case buildv1.BuildPhaseComplete:
+			completeRuns = append(completeRuns, name)
+		case buildv1.BuildPhaseFailed:
+			failedRuns = append(failedRuns, name)
+		case buildv1.BuildPhaseCancelled:
+			cancelledRuns = append(cancelledRuns, name)
+		}
+	}
+	builds.SetNew(newRuns)
+	builds.SetPending(pendingRuns)
+	builds.SetRunning(runningRuns)
+	builds.SetComplete(completeRuns)
+	builds.SetFailed(failedRuns)
+	builds.SetError(nil)
+	builds.SetCancelled(cancelledRuns)
+	if len(pipelineRuns.Items) > 0 {
+		sort.SliceStable(pipelineRuns.Items, func(i, j int) bool {
+			return pipelineRuns.Items[i].CreationTimestamp.After(pipelineRuns.Items[j].CreationTimestamp.Time)
+		})
+		latestRun := &pipelineRuns.Items[0]
+		instance.GetStatus().SetLatestBuild(latestRun.Name)
+		s.addCondition(getPipelineRunPhase(latestRun), getPipelineRunMessage(latestRun), instance.GetStatus().GetConditions())
 		return nil
 	}
 	s.setRunningConditions(instance.GetStatus().GetConditions(), api.BuildNotStartedReason)
@@ -157,11 +218,11 @@ func (s *statusHandler) updateBuildsStatus(instance api.KogitoBuildInterface) (e
 	return nil
 }
 
-func (s *statusHandler) addCondition(build buildv1.Build, conditions *[]metav1.Condition) {
-	conditionReason := buildConditionReason[build.Status.Phase]
-	switch build.Status.Phase {
+func (s *statusHandler) addCondition(phase buildv1.BuildPhase, message string, conditions *[]metav1.Condition) {
+	conditionReason := buildConditionReason[phase]
+	switch phase {
 	case buildv1.BuildPhaseFailed, buildv1.BuildPhaseCancelled:
-		s.setFailedConditions(conditions, conditionReason, build.Status.Message)
+		s.setFailedConditions(conditions, conditionReason, message)
 	case buildv1.BuildPhaseNew, buildv1.BuildPhasePending, buildv1.BuildPhaseRunning:
 		s.setRunningConditions(conditions, conditionReason)
 	case buildv1.BuildPhaseComplete:
@@ -18,12 +18,16 @@ import (
 	"errors"
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/framework/util"
+	tektonv1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/tekton/v1beta1"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/test"
 	"github.com/kiegroup/kogito-operator/meta"
 	buildv1 "github.com/openshift/api/build/v1"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	meta2 "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"reflect"
@@ -176,3 +180,44 @@ func TestStatusChangeWhenBuildsAreRunning(t *testing.T) {
 	assert.Len(t, instance.Status.Builds.New, 1)
 	assert.Len(t, instance.Status.Builds.Pending, 1)
 }
+
+func TestStatusChangeWhenPipelineRunsAreRunning(t *testing.T) {
+	instance := newTektonBuild(t, api.RemoteSourceBuildType)
+	labels := map[string]string{framework.LabelAppKey: "quarkus-example", LabelKeyBuildType: string(api.RemoteSourceBuildType)}
+	newPipelineRun := func(name string, created time.Time, status corev1.ConditionStatus, reason, message string) *tektonv1beta1.PipelineRun {
+		pipelineRun := &tektonv1beta1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: t.Name(), Labels: labels, CreationTimestamp: metav1.NewTime(created)},
+			Status: tektonv1beta1.PipelineRunStatus{
+				Conditions: []tektonv1beta1.Condition{{Type: tektonv1beta1.ConditionSucceeded, Status: status, Reason: reason, Message: message}},
+			},
+		}
+		assert.NoError(t, framework.SetOwner(instance, meta.GetRegisteredSchema(), pipelineRun))
+		return pipelineRun
+	}
+	completeRun := newPipelineRun("quarkus-example-1", time.Now().Add(time.Hour*1), corev1.ConditionTrue, "Succeeded", "Tasks Completed: 1")
+	failedRun := newPipelineRun("quarkus-example-2", time.Now().Add(time.Hour*2), corev1.ConditionFalse, "Failed", "Tasks Completed: 1 (Failed: 1)")
+	// owned by another KogitoBuild targeting the same KogitoRuntime
+	otherRun := newPipelineRun("other-example-1", time.Now().Add(time.Hour*3), corev1.ConditionUnknown, "Running", "")
+	otherRun.OwnerReferences = nil
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, completeRun, failedRun, otherRun).Build()
+	context := BuildContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+	}
+	NewStatusHandler(context).HandleStatusChange(instance, nil)
+
+	instance = &v1beta1.KogitoBuild{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: t.Name()}}
+	test.AssertFetchMustExist(t, cli, instance)
+	assert.Equal(t, "quarkus-example-2", instance.Status.LatestBuild)
+	assert.Equal(t, []string{"quarkus-example-1"}, instance.Status.Builds.Complete)
+	assert.Equal(t, []string{"quarkus-example-2"}, instance.Status.Builds.Failed)
+	assert.Empty(t, instance.Status.Builds.Running)
+	failedCondition := meta2.FindStatusCondition(*instance.Status.Conditions, string(api.KogitoBuildFailure))
+	assert.NotNil(t, failedCondition)
+	assert.Equal(t, string(api.BuildPhaseFailedReason), failedCondition.Reason)
+	assert.Equal(t, "Tasks Completed: 1 (Failed: 1)", failedCondition.Message)
+}
@@ -16,7 +16,7 @@ package kogitobuild
 
 import (
 	"github.com/kiegroup/kogito-operator/apis"
-	buildv1 "github.com/openshift/api/build/v1"
+	corev1 "k8s.io/api/core/v1"
 	"strconv"
 	"strings"
 )
@@ -39,20 +39,20 @@ func GetBuildBuilderName(build api.KogitoBuildInterface) string {
 	return strings.Join([]string{build.GetName(), builderSuffix}, "")
 }
 
-// getBuilderLimitsAsIntString gets the string representation for the resource limits defined in the given bc
-func getBuilderLimitsAsIntString(bc *buildv1.BuildConfig) (limitCPU, limitMemory string) {
+// getBuilderLimitsAsIntString gets the string representation for the given resource limits
+func getBuilderLimitsAsIntString(resources corev1.ResourceRequirements) (limitCPU, limitMemory string) {
 	limitCPU = ""
 	limitMemory = ""
-	if bc.Spec.Resources.Limits == nil {
+	if resources.Limits == nil {
 		return "", ""
 	}
-	limitMemoryInt, possible := bc.Spec.Resources.Limits.Memory().AsInt64()
+	limitMemoryInt, possible := resources.Limits.Memory().AsInt64()
 	if !possible {
-		limitMemoryInt = bc.Spec.Resources.Limits.Memory().ToDec().AsDec().UnscaledBig().Int64()
+		limitMemoryInt = resources.Limits.Memory().ToDec().AsDec().UnscaledBig().Int64()
 	}
 	if limitMemoryInt > 0 {
 		limitMemory = strconv.FormatInt(limitMemoryInt, 10)
 	}
-	limitCPU = bc.Spec.Resources.Limits.Cpu().String()
+	limitCPU = resources.Limits.Cpu().String()
 	return limitCPU, limitMemory
 }
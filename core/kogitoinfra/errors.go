@@ -17,6 +17,7 @@ package kogitoinfra
 import (
 	"fmt"
 	"github.com/kiegroup/kogito-operator/apis"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // reconciliationError type for KogitoInfra reconciliation cycle cases.
@@ -49,13 +50,25 @@ func errorForResourceAPINotFound(apiVersion string) reconciliationError {
 	}
 }
 
+func errorForResourceAccessDenied(resource schema.GroupVersionResource, namespace string) reconciliationError {
+	scope := "all namespaces"
+	if len(namespace) > 0 {
+		scope = "namespace " + namespace
+	}
+	return reconciliationError{
+		Reason:     api.ResourceAccessDenied,
+		innerError: fmt.Errorf("The operator is not granted to list and watch %s in %s. Please grant it the permissions required by the infra provider ", resource.GroupResource(), scope),
+	}
+}
+
 func errorForUnsupportedAPI(context infraContext) reconciliationError {
 	return reconciliationError{
 		Reason: api.UnsupportedAPIKind,
-		innerError: fmt.Errorf("API %s is not supported for kind %s. Supported APIs are: %v",
+		innerError: fmt.Errorf("API %s is not supported for kind %s. Supported APIs are: %v, other APIs require an infra provider ConfigMap labelled with %s=true",
 			context.instance.GetSpec().GetResource().GetAPIVersion(),
 			context.instance.GetSpec().GetResource().GetKind(),
-			getSupportedResources(context)),
+			GetSupportedResources(),
+			InfraProviderLabelKey),
 	}
 }
 
@@ -80,15 +93,6 @@ func errorForResourceNotReadyError(err error) reconciliationError {
 	}
 }
 
-func getSupportedResources(context infraContext) []string {
-	res := getSupportedInfraResources(context)
-	keys := make([]string, 0, len(res))
-	for k := range res {
-		keys = append(keys, k)
-	}
-	return keys
-}
-
 func reasonForError(err error) api.KogitoInfraConditionReason {
 	if err == nil {
 		return ""
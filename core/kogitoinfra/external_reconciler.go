@@ -0,0 +1,324 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const (
+	externalCredentialsUsernameKey = "username"
+	externalCredentialsPasswordKey = "password"
+
+	externalCertMountPath = operator.KogitoHomeDir + "/certs/%s"
+
+	postgreSQLJdbcURLPrefix       = "jdbc:"
+	infraPropertiesSaslMechanism  = "sasl-mechanism"
+	kafkaScramLoginModule         = "org.apache.kafka.common.security.scram.ScramLoginModule"
+	kafkaPlainLoginModule         = "org.apache.kafka.common.security.plain.PlainLoginModule"
+	kafkaSaslPlainMechanism       = "PLAIN"
+	kafkaSaslScramSha256Mechanism = "SCRAM-SHA-256"
+	kafkaSaslJaasConfigFormat     = `%s required username="%s" password="%s";`
+)
+
+// externalEndpoint holds the connection details of an infrastructure not managed by an operator in the cluster
+type externalEndpoint struct {
+	URI      string
+	Username string
+	Password string
+	// TrustStorePath is the location of the truststore generated from the CA of the endpoint, empty if the endpoint isn't secured with TLS
+	TrustStorePath string
+}
+
+func (e *externalEndpoint) hasCredentials() bool {
+	return len(e.Username) > 0
+}
+
+// externalPropertiesProvider gets the application properties and the sensitive properties used by the given runtime to connect to an external endpoint
+type externalPropertiesProvider func(instance api.KogitoInfraInterface, endpoint *externalEndpoint, runtime api.RuntimeType) (appProps map[string]string, secretProps map[string]string, err error)
+
+func getSupportedExternalInfra() map[api.ExternalInfraKind]externalPropertiesProvider {
+	return map[api.ExternalInfraKind]externalPropertiesProvider{
+		api.InfinispanExternalInfra: getExternalInfinispanProps,
+		api.KafkaExternalInfra:      getExternalKafkaProps,
+		api.KeycloakExternalInfra:   getExternalKeycloakProps,
+		api.MongoDBExternalInfra:    getExternalMongoDBProps,
+		api.PostgreSQLExternalInfra: getExternalPostgreSQLProps,
+	}
+}
+
+// IsExternalInfraSupported checks if the given kind of external infrastructure is handled by KogitoInfra
+func IsExternalInfraSupported(kind api.ExternalInfraKind) bool {
+	_, ok := getSupportedExternalInfra()[kind]
+	return ok
+}
+
+// GetSupportedExternalInfraKinds gets the sorted list of external infrastructure kinds supported by KogitoInfra
+func GetSupportedExternalInfraKinds() []string {
+	var kinds []string
+	for kind := range getSupportedExternalInfra() {
+		kinds = append(kinds, string(kind))
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// IsExternalInfraSecret checks if the given Secret holds the credentials or the CA certificate of the endpoint described by the given KogitoInfra.
+// Their content is copied in the Secrets mounted in the services, which must be updated along with them.
+func IsExternalInfraSecret(instance api.KogitoInfraInterface, secret client.Object) bool {
+	if instance.GetSpec().IsExternalEmpty() || instance.GetNamespace() != secret.GetNamespace() {
+		return false
+	}
+	external := instance.GetSpec().GetExternal()
+	return external.GetCredentialsSecret() == secret.GetName() || external.GetTrustStoreSecret() == secret.GetName()
+}
+
+// externalInfraReconciler configures the services to connect to an endpoint described by the KogitoInfra, without any third party resource
+type externalInfraReconciler struct {
+	infraContext
+	secretHandler infrastructure.SecretHandler
+}
+
+func initExternalInfraReconciler(context infraContext) Reconciler {
+	context.Log = context.Log.WithValues("resource", "External")
+	return &externalInfraReconciler{
+		infraContext:  context,
+		secretHandler: infrastructure.NewSecretHandler(context.Context),
+	}
+}
+
+// Reconcile reconcile Kogito infra object
+func (e *externalInfraReconciler) Reconcile() error {
+	external := e.instance.GetSpec().GetExternal()
+	propertiesProvider, ok := getSupportedExternalInfra()[external.GetKind()]
+	if !ok {
+		return errorForResourceConfigError(e.instance, fmt.Sprintf("External infrastructure kind %s not supported", external.GetKind()))
+	}
+	if len(external.GetURI()) == 0 {
+		return errorForMissingResourceConfig(e.instance, "external URI")
+	}
+
+	endpoint := &externalEndpoint{URI: external.GetURI()}
+	if err := e.fetchCredentials(endpoint); err != nil {
+		return err
+	}
+	if len(external.GetTrustStoreSecret()) > 0 {
+		if err := e.reconcileTrustStore(endpoint); err != nil {
+			return err
+		}
+	}
+
+	kind := strings.ToLower(string(external.GetKind()))
+	for _, runtime := range []api.RuntimeType{api.QuarkusRuntimeType, api.SpringBootRuntimeType} {
+		appProps, secretProps, err := propertiesProvider(e.instance, endpoint, runtime)
+		if err != nil {
+			return errorForResourceConfigError(e.instance, err.Error())
+		}
+		if err := newExternalConfigReconciler(e.infraContext, kind, runtime, appProps).Reconcile(); err != nil {
+			return err
+		}
+		if len(secretProps) > 0 {
+			if err := newExternalCredentialReconciler(e.infraContext, kind, runtime, secretProps).Reconcile(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fetchCredentials reads the username and the password from the credentials Secret referenced by the KogitoInfra, if any
+func (e *externalInfraReconciler) fetchCredentials(endpoint *externalEndpoint) error {
+	secretName := e.instance.GetSpec().GetExternal().GetCredentialsSecret()
+	if len(secretName) == 0 {
+		return nil
+	}
+	secret, err := e.secretHandler.FetchSecret(types.NamespacedName{Name: secretName, Namespace: e.instance.GetNamespace()})
+	if err != nil {
+		return err
+	} else if secret == nil {
+		return errorForResourceNotFound("Secret", secretName, e.instance.GetNamespace())
+	}
+	endpoint.Username = string(secret.Data[externalCredentialsUsernameKey])
+	endpoint.Password = string(secret.Data[externalCredentialsPasswordKey])
+	if !endpoint.hasCredentials() {
+		return errorForResourceConfigError(e.instance, fmt.Sprintf("Secret %s doesn't have the key %s", secretName, externalCredentialsUsernameKey))
+	}
+	return nil
+}
+
+// reconcileTrustStore generates a truststore from the CA certificate referenced by the KogitoInfra and mounts it in the services
+func (e *externalInfraReconciler) reconcileTrustStore(endpoint *externalEndpoint) error {
+	secretName := e.instance.GetSpec().GetExternal().GetTrustStoreSecret()
+	caSecret, err := e.secretHandler.FetchSecret(types.NamespacedName{Name: secretName, Namespace: e.instance.GetNamespace()})
+	if err != nil {
+		return err
+	} else if caSecret == nil {
+		return errorForResourceNotFound("Secret", secretName, e.instance.GetNamespace())
+	}
+	caCert := caSecret.Data[trustStoreCACertKey]
+	if len(caCert) == 0 {
+		return errorForResourceConfigError(e.instance, fmt.Sprintf("Secret %s doesn't have the key %s", secretName, trustStoreCACertKey))
+	}
+	trustStoreSecretName := getExternalResourceName("kogito-%s-truststore", e.instance)
+	if err := reconcileTrustStoreSecret(e.infraContext, trustStoreSecretName, caCert); err != nil {
+		return err
+	}
+	mountPath := getExternalResourceName(externalCertMountPath, e.instance)
+	e.instance.GetStatus().AddSecretVolumeReference(trustStoreSecretName, mountPath, &framework.ModeForCertificates, nil)
+	endpoint.TrustStorePath = mountPath + "/" + trustStoreKey
+	return nil
+}
+
+// getExternalResourceName formats the given name with the kind of external infrastructure described by the KogitoInfra
+func getExternalResourceName(format string, instance api.KogitoInfraInterface) string {
+	return fmt.Sprintf(format, strings.ToLower(string(instance.GetSpec().GetExternal().GetKind())))
+}
+
+func getExternalKafkaProps(instance api.KogitoInfraInterface, endpoint *externalEndpoint, runtime api.RuntimeType) (map[string]string, map[string]string, error) {
+	appProps := map[string]string{enableEventsEnvKey: "true"}
+	secretProps := map[string]string{}
+	if runtime == api.QuarkusRuntimeType {
+		appProps[QuarkusKafkaBootstrapAppProp] = endpoint.URI
+	} else {
+		appProps[springKafkaBootstrapAppProp] = endpoint.URI
+	}
+	tlsEnabled := len(endpoint.TrustStorePath) > 0
+	switch {
+	case endpoint.hasCredentials() && tlsEnabled:
+		appProps[propertiesKafka[runtime][appPropKafkaSecurityProtocol]] = "SASL_SSL"
+	case endpoint.hasCredentials():
+		appProps[propertiesKafka[runtime][appPropKafkaSecurityProtocol]] = "SASL_PLAINTEXT"
+	case tlsEnabled:
+		appProps[propertiesKafka[runtime][appPropKafkaSecurityProtocol]] = "SSL"
+	}
+	if endpoint.hasCredentials() {
+		mechanism := instance.GetSpec().GetInfraProperties()[infraPropertiesSaslMechanism]
+		if len(mechanism) == 0 {
+			mechanism = kafkaSaslScramSha512Mechanism
+		}
+		loginModule := kafkaScramLoginModule
+		switch mechanism {
+		case kafkaSaslPlainMechanism:
+			loginModule = kafkaPlainLoginModule
+		case kafkaSaslScramSha256Mechanism, kafkaSaslScramSha512Mechanism:
+		default:
+			return nil, nil, fmt.Errorf("SASL mechanism %s not supported, use one of %s, %s or %s", mechanism, kafkaSaslPlainMechanism, kafkaSaslScramSha256Mechanism, kafkaSaslScramSha512Mechanism)
+		}
+		appProps[propertiesKafka[runtime][appPropKafkaSaslMechanism]] = mechanism
+		secretProps[propertiesKafka[runtime][appPropKafkaSaslJaasConfig]] = fmt.Sprintf(kafkaSaslJaasConfigFormat, loginModule, endpoint.Username, endpoint.Password)
+	}
+	if tlsEnabled {
+		secretProps[propertiesKafka[runtime][appPropKafkaTrustStore]] = getKafkaStoreLocation(runtime, endpoint.TrustStorePath)
+		secretProps[propertiesKafka[runtime][appPropKafkaTrustStoreType]] = pkcs12CertType
+		secretProps[propertiesKafka[runtime][appPropKafkaTrustStorePassword]] = pkcs12.DefaultPassword
+	}
+	return appProps, secretProps, nil
+}
+
+func getExternalInfinispanProps(_ api.KogitoInfraInterface, endpoint *externalEndpoint, runtime api.RuntimeType) (map[string]string, map[string]string, error) {
+	appProps := map[string]string{
+		infinispanEnablePersistenceEnvKey:                          "true",
+		propertiesInfinispan[runtime][appPropInfinispanServerList]: endpoint.URI,
+		propertiesInfinispan[runtime][appPropInfinispanUseAuth]:    fmt.Sprint(endpoint.hasCredentials()),
+	}
+	secretProps := map[string]string{}
+	if endpoint.hasCredentials() {
+		secretProps[propertiesInfinispan[runtime][envVarInfinispanUser]] = endpoint.Username
+		secretProps[propertiesInfinispan[runtime][envVarInfinispanPassword]] = endpoint.Password
+	}
+	if len(endpoint.TrustStorePath) > 0 {
+		secretProps[propertiesInfinispan[runtime][appPropInfinispanTrustStore]] = endpoint.TrustStorePath
+		secretProps[propertiesInfinispan[runtime][appPropInfinispanTrustStoreType]] = pkcs12CertType
+		secretProps[propertiesInfinispan[runtime][appPropInfinispanTrustStorePassword]] = pkcs12.DefaultPassword
+	}
+	return appProps, secretProps, nil
+}
+
+func getExternalMongoDBProps(instance api.KogitoInfraInterface, endpoint *externalEndpoint, runtime api.RuntimeType) (map[string]string, map[string]string, error) {
+	mongoDBURL, err := url.ParseRequestURI(endpoint.URI)
+	if err != nil {
+		return nil, nil, err
+	}
+	appProps := map[string]string{mongoDBEnablePersistenceEnvKey: "true"}
+	if runtime == api.QuarkusRuntimeType {
+		appProps[propertiesMongoDB[runtime][appPropMongoDBURI]] = endpoint.URI
+	} else {
+		appProps[propertiesMongoDB[runtime][appPropMongoDBHost]] = mongoDBURL.Hostname()
+		appProps[propertiesMongoDB[runtime][appPropMongoDBPort]] = mongoDBURL.Port()
+	}
+	secretProps := map[string]string{}
+	database := instance.GetSpec().GetInfraProperties()[infraPropertiesDatabaseKey]
+	if len(database) == 0 {
+		database = strings.TrimPrefix(mongoDBURL.Path, "/")
+	}
+	if len(database) > 0 {
+		secretProps[propertiesMongoDB[runtime][envVarMongoDBDatabase]] = database
+	}
+	if endpoint.hasCredentials() {
+		secretProps[propertiesMongoDB[runtime][envVarMongoDBUser]] = endpoint.Username
+		secretProps[propertiesMongoDB[runtime][envVarMongoDBPassword]] = endpoint.Password
+		if authDatabase := instance.GetSpec().GetInfraProperties()[infraPropertiesAuthDatabaseKey]; len(authDatabase) > 0 {
+			secretProps[propertiesMongoDB[runtime][envVarMongoDBAuthDatabase]] = authDatabase
+		}
+	}
+	return appProps, secretProps, nil
+}
+
+func getExternalPostgreSQLProps(_ api.KogitoInfraInterface, endpoint *externalEndpoint, runtime api.RuntimeType) (map[string]string, map[string]string, error) {
+	if !strings.HasPrefix(endpoint.URI, postgreSQLJdbcURLPrefix+postgreSQLDBKind+"://") {
+		return nil, nil, fmt.Errorf("PostgreSQL URI %s isn't a JDBC URL, expected format is %s", endpoint.URI, postgreSQLJdbcURLFormat)
+	}
+	appProps := map[string]string{
+		postgreSQLEnablePersistenceEnvKey:                               "true",
+		propertiesPostgreSQL[runtime][appPropPostgreSQLPersistenceType]: postgreSQLDBKind,
+		propertiesPostgreSQL[runtime][appPropPostgreSQLJdbcURL]:         endpoint.URI,
+	}
+	if runtime == api.QuarkusRuntimeType {
+		appProps[propertiesPostgreSQL[runtime][appPropPostgreSQLDBKind]] = postgreSQLDBKind
+		appProps[propertiesPostgreSQL[runtime][appPropPostgreSQLReactiveURL]] = strings.TrimPrefix(endpoint.URI, postgreSQLJdbcURLPrefix)
+	}
+	secretProps := map[string]string{}
+	if endpoint.hasCredentials() {
+		secretProps[propertiesPostgreSQL[runtime][envVarPostgreSQLUser]] = endpoint.Username
+		secretProps[propertiesPostgreSQL[runtime][envVarPostgreSQLPassword]] = endpoint.Password
+	}
+	return appProps, secretProps, nil
+}
+
+func getExternalKeycloakProps(_ api.KogitoInfraInterface, endpoint *externalEndpoint, runtime api.RuntimeType) (map[string]string, map[string]string, error) {
+	appProps := map[string]string{
+		propertiesKeycloak[runtime][appPropKeycloakAuthServerURL]: endpoint.URI,
+	}
+	if runtime == api.SpringBootRuntimeType {
+		appProps[propertiesKeycloak[runtime][appPropKeycloakClientIssuerURI]] = endpoint.URI
+	}
+	secretProps := map[string]string{}
+	if endpoint.hasCredentials() {
+		secretProps[propertiesKeycloak[runtime][envVarKeycloakClientID]] = endpoint.Username
+		secretProps[propertiesKeycloak[runtime][envVarKeycloakClientSecret]] = endpoint.Password
+	}
+	return appProps, secretProps, nil
+}
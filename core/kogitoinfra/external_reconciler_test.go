@@ -0,0 +1,164 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newExternalInfraContext(cli *client.Client, instance api.KogitoInfraInterface) infraContext {
+	return infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: instance,
+	}
+}
+
+func TestExternalInfraReconciler_KafkaWithCredentialsAndTrustStore(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoExternal(ns, api.KafkaExternalInfra, "broker-1.example.com:9093,broker-2.example.com:9093")
+	instance.GetSpec().GetExternal().SetCredentialsSecret("kafka-credentials")
+	instance.GetSpec().GetExternal().SetTrustStoreSecret("kafka-ca")
+	credentials := &v1.Secret{
+		ObjectMeta: v12.ObjectMeta{Name: "kafka-credentials", Namespace: ns},
+		Data:       map[string][]byte{"username": []byte("kogito"), "password": []byte("secret")},
+	}
+	caSecret, err := test.CreateFakeKafkaClusterCACertSecret(ns)
+	assert.NoError(t, err)
+	caSecret.Name = "kafka-ca"
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, credentials, caSecret).Build()
+
+	err = initExternalInfraReconciler(newExternalInfraContext(cli, instance)).Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"kogito-kafka-quarkus-config", "kogito-kafka-springboot-config"}, instance.GetStatus().GetConfigMapEnvFromReferences())
+	assert.Equal(t, []string{"kogito-kafka-quarkus-credential", "kogito-kafka-springboot-credential"}, instance.GetStatus().GetSecretEnvFromReferences())
+	assert.Equal(t, 1, len(instance.GetStatus().GetSecretVolumeReferences()))
+	assert.Equal(t, "/home/kogito/certs/kafka", instance.GetStatus().GetSecretVolumeReferences()[0].GetMountPath())
+
+	configMap := &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-kafka-quarkus-config", Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "broker-1.example.com:9093,broker-2.example.com:9093", configMap.Data[QuarkusKafkaBootstrapAppProp])
+	assert.Equal(t, "SASL_SSL", configMap.Data["kafka.security.protocol"])
+	assert.Equal(t, "SCRAM-SHA-512", configMap.Data["kafka.sasl.mechanism"])
+
+	secret := &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kogito-kafka-springboot-credential", Namespace: ns}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(secret)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, `org.apache.kafka.common.security.scram.ScramLoginModule required username="kogito" password="secret";`, string(secret.Data["spring.kafka.properties.sasl.jaas.config"]))
+	assert.Equal(t, "file:/home/kogito/certs/kafka/truststore.p12", string(secret.Data["spring.kafka.ssl.trust-store-location"]))
+
+	trustStore := &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kogito-kafka-truststore", Namespace: ns}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(trustStore)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NotEmpty(t, trustStore.Data[trustStoreKey])
+}
+
+func TestExternalInfraReconciler_PostgreSQL(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoExternal(ns, api.PostgreSQLExternalInfra, "jdbc:postgresql://db.example.com:5432/kogito?sslmode=require")
+	instance.GetSpec().GetExternal().SetCredentialsSecret("db-credentials")
+	credentials := &v1.Secret{
+		ObjectMeta: v12.ObjectMeta{Name: "db-credentials", Namespace: ns},
+		Data:       map[string][]byte{"username": []byte("kogito"), "password": []byte("secret")},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, credentials).Build()
+
+	err := initExternalInfraReconciler(newExternalInfraContext(cli, instance)).Reconcile()
+	assert.NoError(t, err)
+	assert.Empty(t, instance.GetStatus().GetSecretVolumeReferences())
+
+	configMap := &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-postgresql-quarkus-config", Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "jdbc:postgresql://db.example.com:5432/kogito?sslmode=require", configMap.Data["quarkus.datasource.jdbc.url"])
+	assert.Equal(t, "postgresql://db.example.com:5432/kogito?sslmode=require", configMap.Data["quarkus.datasource.reactive.url"])
+
+	secret := &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kogito-postgresql-quarkus-credential", Namespace: ns}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(secret)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "kogito", string(secret.Data["QUARKUS_DATASOURCE_USERNAME"]))
+}
+
+func TestExternalInfraReconciler_InvalidPostgreSQLURI(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoExternal(ns, api.PostgreSQLExternalInfra, "db.example.com:5432")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+
+	err := initExternalInfraReconciler(newExternalInfraContext(cli, instance)).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, api.ResourceConfigError, reasonForError(err))
+}
+
+func TestExternalInfraReconciler_MissingCredentialsSecret(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoExternal(ns, api.InfinispanExternalInfra, "infinispan.example.com:11222")
+	instance.GetSpec().GetExternal().SetCredentialsSecret("infinispan-credentials")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+
+	err := initExternalInfraReconciler(newExternalInfraContext(cli, instance)).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, api.ResourceNotFound, reasonForError(err))
+}
+
+func TestGetInfraReconciler_External(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoExternal(ns, api.MongoDBExternalInfra, "mongodb://mongo.example.com:27017/kogito")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	handler := NewReconcilerHandler(operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()})
+
+	reconciler, err := handler.GetInfraReconciler(instance)
+	assert.NoError(t, err)
+	assert.IsType(t, &externalInfraReconciler{}, reconciler)
+	assert.NoError(t, reconciler.Reconcile())
+
+	configMap := &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-mongodb-springboot-config", Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "mongo.example.com", configMap.Data["spring.data.mongodb.host"])
+	assert.Equal(t, "27017", configMap.Data["spring.data.mongodb.port"])
+}
+
+func TestIsExternalInfraSecret(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoExternal(ns, api.KafkaExternalInfra, "kafka.example.com:9093")
+	instance.GetSpec().GetExternal().SetCredentialsSecret("kafka-credentials")
+	instance.GetSpec().GetExternal().SetTrustStoreSecret("kafka-ca")
+
+	assert.True(t, IsExternalInfraSecret(instance, &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kafka-credentials", Namespace: ns}}))
+	assert.True(t, IsExternalInfraSecret(instance, &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kafka-ca", Namespace: ns}}))
+	assert.False(t, IsExternalInfraSecret(instance, &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kafka-ca", Namespace: "other"}}))
+	assert.False(t, IsExternalInfraSecret(instance, &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "other", Namespace: ns}}))
+	assert.False(t, IsExternalInfraSecret(test.CreateFakeKogitoKafka(ns), &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kafka-ca", Namespace: ns}}))
+}
@@ -0,0 +1,121 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	externalConfigMapName = "kogito-%s-%s-config"
+)
+
+// externalConfigReconciler creates the ConfigMap of application properties rendered for an infrastructure without a built-in reconciler
+type externalConfigReconciler struct {
+	infraContext
+	kind             string
+	runtime          api.RuntimeType
+	appProps         map[string]string
+	configMapHandler infrastructure.ConfigMapHandler
+}
+
+func newExternalConfigReconciler(ctx infraContext, kind string, runtime api.RuntimeType, appProps map[string]string) Reconciler {
+	return &externalConfigReconciler{
+		infraContext:     ctx,
+		kind:             kind,
+		runtime:          runtime,
+		appProps:         appProps,
+		configMapHandler: infrastructure.NewConfigMapHandler(ctx.Context),
+	}
+}
+
+func (e *externalConfigReconciler) Reconcile() (err error) {
+
+	// Create Required resource
+	requestedResources, err := e.createRequiredResources()
+	if err != nil {
+		return
+	}
+
+	// Get Deployed resource
+	deployedResources, err := e.getDeployedResources()
+	if err != nil {
+		return
+	}
+
+	// Process Delta
+	if err = e.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	e.instance.GetStatus().AddConfigMapEnvFromReferences(e.getExternalConfigMapName())
+	return nil
+}
+
+func (e *externalConfigReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	configMap := e.createExternalConfigMap()
+	if err := framework.SetOwner(e.instance, e.Scheme, configMap); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(v12.ConfigMap{})] = []client.Object{configMap}
+	return resources, nil
+}
+
+func (e *externalConfigReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	deployedConfigMap, err := e.configMapHandler.FetchConfigMap(types.NamespacedName{Name: e.getExternalConfigMapName(), Namespace: e.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if deployedConfigMap != nil {
+		resources[reflect.TypeOf(v12.ConfigMap{})] = []client.Object{deployedConfigMap}
+	}
+	return resources, nil
+}
+
+func (e *externalConfigReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	comparator := e.configMapHandler.GetComparator()
+	deltaProcessor := infrastructure.NewDeltaProcessor(e.Context)
+	_, err = deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return err
+}
+
+func (e *externalConfigReconciler) createExternalConfigMap() *v12.ConfigMap {
+	return &v12.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.getExternalConfigMapName(),
+			Namespace: e.instance.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: e.instance.GetName(),
+			},
+		},
+		Data: e.appProps,
+	}
+}
+
+// getExternalConfigMapName uses the same name as the ConfigMap generated for the third party resource of the same kind
+func (e *externalConfigReconciler) getExternalConfigMapName() string {
+	return fmt.Sprintf(externalConfigMapName, e.kind, e.runtime)
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	externalCredentialSecretName = "kogito-%s-%s-credential"
+)
+
+// externalCredentialReconciler creates the Secret of sensitive properties rendered for an infrastructure without a built-in reconciler
+type externalCredentialReconciler struct {
+	infraContext
+	kind          string
+	runtime       api.RuntimeType
+	secretProps   map[string]string
+	secretHandler infrastructure.SecretHandler
+}
+
+func newExternalCredentialReconciler(ctx infraContext, kind string, runtime api.RuntimeType, secretProps map[string]string) Reconciler {
+	return &externalCredentialReconciler{
+		infraContext:  ctx,
+		kind:          kind,
+		runtime:       runtime,
+		secretProps:   secretProps,
+		secretHandler: infrastructure.NewSecretHandler(ctx.Context),
+	}
+}
+
+func (e *externalCredentialReconciler) Reconcile() (err error) {
+
+	// Create Required resource
+	requestedResources, err := e.createRequiredResources()
+	if err != nil {
+		return
+	}
+
+	// Get Deployed resource
+	deployedResources, err := e.getDeployedResources()
+	if err != nil {
+		return
+	}
+
+	// Process Delta
+	if err = e.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	e.instance.GetStatus().AddSecretEnvFromReferences(e.getCredentialSecretName())
+	return nil
+}
+
+func (e *externalCredentialReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	secret := e.createExternalCredentialSecret()
+	if err := framework.SetOwner(e.instance, e.Scheme, secret); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(v12.Secret{})] = []client.Object{secret}
+	return resources, nil
+}
+
+func (e *externalCredentialReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	deployedSecret, err := e.secretHandler.FetchSecret(types.NamespacedName{Name: e.getCredentialSecretName(), Namespace: e.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if deployedSecret != nil {
+		resources[reflect.TypeOf(v12.Secret{})] = []client.Object{deployedSecret}
+	}
+	return resources, nil
+}
+
+func (e *externalCredentialReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	comparator := e.secretHandler.GetComparator()
+	deltaProcessor := infrastructure.NewDeltaProcessor(e.Context)
+	_, err = deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return err
+}
+
+func (e *externalCredentialReconciler) createExternalCredentialSecret() *v12.Secret {
+	data := make(map[string][]byte, len(e.secretProps))
+	for key, value := range e.secretProps {
+		data[key] = []byte(value)
+	}
+	return &v12.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.getCredentialSecretName(),
+			Namespace: e.instance.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: e.instance.GetName(),
+			},
+		},
+		Type: v12.SecretTypeOpaque,
+		Data: data,
+	}
+}
+
+// getCredentialSecretName uses the same name as the Secret generated for the third party resource of the same kind
+func (e *externalCredentialReconciler) getCredentialSecretName() string {
+	return fmt.Sprintf(externalCredentialSecretName, e.kind, e.runtime)
+}
@@ -17,8 +17,11 @@ package kogitoinfra
 import (
 	"fmt"
 	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sort"
 	"strings"
@@ -27,6 +30,63 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+const (
+	// appPropKafkaSecurityProtocol application property for setting the protocol used to communicate with brokers
+	appPropKafkaSecurityProtocol int = iota
+	// appPropKafkaSaslMechanism application property for setting the SASL mechanism used to authenticate to brokers
+	appPropKafkaSaslMechanism
+	appPropKafkaTrustStore
+	appPropKafkaTrustStoreType
+	appPropKafkaTrustStorePassword
+	appPropKafkaKeyStore
+	appPropKafkaKeyStoreType
+	appPropKafkaKeyStorePassword
+	// appPropKafkaSaslJaasConfig application property for setting the credentials of the SASL mechanism
+	appPropKafkaSaslJaasConfig
+
+	kafkaSaslScramSha512Mechanism = "SCRAM-SHA-512"
+
+	infraPropertiesListenerKey  = "listener"
+	infraPropertiesKafkaUserKey = "kafka-user"
+
+	kafkaCertMountPath  = operator.KogitoHomeDir + "/certs/kafka"
+	kafkaTrustStorePath = kafkaCertMountPath + "/" + trustStoreKey
+	// KafkaUserCertMountPath is the path where the Secret holding the keystore of a TLS KafkaUser is mounted
+	KafkaUserCertMountPath = operator.KogitoHomeDir + "/certs/kafka-user"
+	kafkaKeyStorePath      = KafkaUserCertMountPath + "/" + infrastructure.KafkaUserKeyStoreKey
+)
+
+var (
+	// Kafka security variables for the KogitoInfra deployed infrastructure.
+	// For Quarkus: https://quarkus.io/guides/kafka#kafka-configuration
+	// For Spring: https://docs.spring.io/spring-boot/docs/current/reference/html/application-properties.html#application-properties.integration.spring.kafka.security.protocol
+
+	propertiesKafka = map[api.RuntimeType]map[int]string{
+		api.QuarkusRuntimeType: {
+			appPropKafkaSecurityProtocol:   "kafka.security.protocol",
+			appPropKafkaSaslMechanism:      "kafka.sasl.mechanism",
+			appPropKafkaTrustStore:         "kafka.ssl.truststore.location",
+			appPropKafkaTrustStoreType:     "kafka.ssl.truststore.type",
+			appPropKafkaTrustStorePassword: "kafka.ssl.truststore.password",
+			appPropKafkaKeyStore:           "kafka.ssl.keystore.location",
+			appPropKafkaKeyStoreType:       "kafka.ssl.keystore.type",
+			appPropKafkaKeyStorePassword:   "kafka.ssl.keystore.password",
+			appPropKafkaSaslJaasConfig:     "kafka.sasl.jaas.config",
+		},
+		api.SpringBootRuntimeType: {
+			appPropKafkaSecurityProtocol:   "spring.kafka.security.protocol",
+			appPropKafkaSaslMechanism:      "spring.kafka.properties.sasl.mechanism",
+			appPropKafkaTrustStore:         "spring.kafka.ssl.trust-store-location",
+			appPropKafkaTrustStoreType:     "spring.kafka.ssl.trust-store-type",
+			appPropKafkaTrustStorePassword: "spring.kafka.ssl.trust-store-password",
+			appPropKafkaKeyStore:           "spring.kafka.ssl.key-store-location",
+			appPropKafkaKeyStoreType:       "spring.kafka.ssl.key-store-type",
+			appPropKafkaKeyStorePassword:   "spring.kafka.ssl.key-store-password",
+			appPropKafkaSaslJaasConfig:     "spring.kafka.properties.sasl.jaas.config",
+		},
+	}
+)
+
 // AppendKafkaWatchedObjects ...
 func AppendKafkaWatchedObjects(b *builder.Builder) *builder.Builder {
 	return b
@@ -75,6 +135,20 @@ func (k *kafkaInfraReconciler) Reconcile() (resultErr error) {
 		return errorForResourceNotReadyError(fmt.Errorf("kafka instance %s not ready yet. Waiting for Condition status Ready", kafkaInstance.Name))
 	}
 
+	listener := infrastructure.ResolveKafkaListener(kafkaInstance, GetKafkaListenerName(k.instance))
+	if listener == nil && GetKafkaListenerName(k.instance) != infrastructure.KafkaDefaultListener {
+		return errorForResourceConfigError(k.instance, fmt.Sprintf("Listener %s not found in Kafka instance %s", GetKafkaListenerName(k.instance), kafkaInstance.Name))
+	}
+	if listener != nil && len(listener.Authentication.AuthenticationType) > 0 && kafkaInstance.Namespace != k.instance.GetNamespace() {
+		return errorForResourceConfigError(k.instance, fmt.Sprintf("Listener %s requires authentication, the credentials of the Kafka users can only be mounted when Kafka instance %s runs in namespace %s", listener.Name, kafkaInstance.Name, k.instance.GetNamespace()))
+	}
+	if listener != nil && listener.TLS {
+		kafkaTrustStoreReconciler := newKafkaTrustStoreReconciler(k.infraContext, kafkaInstance)
+		if resultErr = kafkaTrustStoreReconciler.Reconcile(); resultErr != nil {
+			return resultErr
+		}
+	}
+
 	if resultErr = k.updateKafkaRuntimePropsInStatus(kafkaInstance, api.QuarkusRuntimeType); resultErr != nil {
 		return resultErr
 	}
@@ -127,3 +201,63 @@ func (k *kafkaInfraReconciler) updateKafkaRuntimePropsInStatus(kafkaInstance *v1
 	}
 	return nil
 }
+
+// GetKafkaListenerName gets the name of the Kafka listener used by the services bound to the given KogitoInfra
+func GetKafkaListenerName(instance api.KogitoInfraInterface) string {
+	if listener := instance.GetSpec().GetInfraProperties()[infraPropertiesListenerKey]; len(listener) > 0 {
+		return listener
+	}
+	return infrastructure.KafkaDefaultListener
+}
+
+// GetKafkaUserName gets the name of the existing KafkaUser referenced by the given KogitoInfra, empty if the operator creates a user per service
+func GetKafkaUserName(instance api.KogitoInfraInterface) string {
+	return instance.GetSpec().GetInfraProperties()[infraPropertiesKafkaUserKey]
+}
+
+// GetKafkaSecurityProtocol gets the protocol used to communicate with the given listener
+func GetKafkaSecurityProtocol(listener *v1beta2.GenericKafkaListener) string {
+	if listener == nil {
+		return "PLAINTEXT"
+	}
+	saslEnabled := listener.Authentication.AuthenticationType == v1beta2.KafkaScramSha512Authentication
+	switch {
+	case listener.TLS && saslEnabled:
+		return "SASL_SSL"
+	case saslEnabled:
+		return "SASL_PLAINTEXT"
+	case listener.TLS:
+		return "SSL"
+	}
+	return "PLAINTEXT"
+}
+
+// GetKafkaUserCredentialEnvs gets the environment variables holding the credentials of the given KafkaUser for the given runtime.
+// The credentials are read from the Secret generated by Strimzi for the user, named after it.
+// With TLS authentication, this Secret must be mounted in KafkaUserCertMountPath.
+func GetKafkaUserCredentialEnvs(runtime api.RuntimeType, authentication v1beta2.KafkaAuthenticationType, userName string) []corev1.EnvVar {
+	if _, ok := propertiesKafka[runtime]; !ok {
+		runtime = api.QuarkusRuntimeType
+	}
+	switch authentication {
+	case v1beta2.KafkaScramSha512Authentication:
+		return []corev1.EnvVar{
+			framework.CreateSecretEnvVar(propertiesKafka[runtime][appPropKafkaSaslJaasConfig], userName, infrastructure.KafkaUserSaslJaasConfigKey),
+		}
+	case v1beta2.KafkaTLSAuthentication:
+		return []corev1.EnvVar{
+			framework.CreateEnvVar(propertiesKafka[runtime][appPropKafkaKeyStore], getKafkaStoreLocation(runtime, kafkaKeyStorePath)),
+			framework.CreateEnvVar(propertiesKafka[runtime][appPropKafkaKeyStoreType], pkcs12CertType),
+			framework.CreateSecretEnvVar(propertiesKafka[runtime][appPropKafkaKeyStorePassword], userName, infrastructure.KafkaUserKeyStorePasswordKey),
+		}
+	}
+	return nil
+}
+
+// getKafkaStoreLocation gets the location of the given store file, Spring Boot expects a resource URL
+func getKafkaStoreLocation(runtime api.RuntimeType, path string) string {
+	if runtime == api.SpringBootRuntimeType {
+		return "file:" + path
+	}
+	return path
+}
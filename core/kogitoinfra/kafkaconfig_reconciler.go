@@ -113,7 +113,8 @@ func (k *kafkaConfigReconciler) processDelta(requestedResources map[reflect.Type
 
 func (k *kafkaConfigReconciler) getKafkaAppProps() (map[string]string, error) {
 	appProps := map[string]string{}
-	kafkaURI, err := k.kafkaHandler.ResolveKafkaServerURI(k.kafkaInstance)
+	listenerName := GetKafkaListenerName(k.instance)
+	kafkaURI, err := k.kafkaHandler.ResolveKafkaServerURI(k.kafkaInstance, listenerName)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +125,13 @@ func (k *kafkaConfigReconciler) getKafkaAppProps() (map[string]string, error) {
 		} else if k.runtime == api.SpringBootRuntimeType {
 			appProps[springKafkaBootstrapAppProp] = kafkaURI
 		}
+		// secured listeners, the credentials are provided to each service by its own KafkaUser
+		if listener := infrastructure.ResolveKafkaListener(k.kafkaInstance, listenerName); listener != nil && (listener.TLS || len(listener.Authentication.AuthenticationType) > 0) {
+			appProps[propertiesKafka[k.runtime][appPropKafkaSecurityProtocol]] = GetKafkaSecurityProtocol(listener)
+			if listener.Authentication.AuthenticationType == v1beta2.KafkaScramSha512Authentication {
+				appProps[propertiesKafka[k.runtime][appPropKafkaSaslMechanism]] = kafkaSaslScramSha512Mechanism
+			}
+		}
 	} else {
 		appProps[enableEventsEnvKey] = "false"
 	}
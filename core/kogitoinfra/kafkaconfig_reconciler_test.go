@@ -17,6 +17,7 @@ package kogitoinfra
 import (
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/test"
 	"github.com/kiegroup/kogito-operator/meta"
@@ -58,3 +59,41 @@ func TestKafkaConfigReconciler(t *testing.T) {
 	assert.Equal(t, "true", kafkaConfigMap.Data[enableEventsEnvKey])
 	assert.True(t, len(kafkaConfigMap.Data["kafka.bootstrap.servers"]) > 0)
 }
+
+func TestKafkaConfigReconciler_ScramListener(t *testing.T) {
+	ns := t.Name()
+	kogitoKafkaInstance := test.CreateFakeKogitoKafka(ns)
+	kogitoKafkaInstance.GetSpec().AddInfraProperties(map[string]string{infraPropertiesListenerKey: "tls"})
+	kafkaInstance := test.CreateFakeKafka(ns)
+	kafkaInstance.Spec.Kafka.Listeners[1].Authentication.AuthenticationType = v1beta2.KafkaScramSha512Authentication
+	kafkaInstance.Status.Listeners = append(kafkaInstance.Status.Listeners, v1beta2.ListenerStatus{
+		Name:      "tls",
+		Type:      "tls",
+		Addresses: []v1beta2.ListenerAddress{{Host: "kafka-host", Port: int32(9093)}},
+	})
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafkaInstance).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKafkaInstance,
+	}
+
+	kafkaConfigReconciler := newKafkaConfigReconciler(infraContext, kafkaInstance, api.SpringBootRuntimeType)
+	err := kafkaConfigReconciler.Reconcile()
+	assert.NoError(t, err)
+	kafkaConfigMap := &v12.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      GetKafkaConfigMapName(api.SpringBootRuntimeType),
+			Namespace: ns,
+		},
+	}
+	exist, err := kubernetes.ResourceC(cli).Fetch(kafkaConfigMap)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, "kafka-host:9093", kafkaConfigMap.Data[springKafkaBootstrapAppProp])
+	assert.Equal(t, "SASL_SSL", kafkaConfigMap.Data["spring.kafka.security.protocol"])
+	assert.Equal(t, "SCRAM-SHA-512", kafkaConfigMap.Data["spring.kafka.properties.sasl.mechanism"])
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	kafkaTrustStoreSecretName = "kogito-kafka-truststore"
+)
+
+// kafkaTrustStoreReconciler mounts a truststore holding the cluster CA of a Kafka instance with TLS listeners.
+// The truststore is generated again when Strimzi renews the cluster CA.
+type kafkaTrustStoreReconciler struct {
+	infraContext
+	kafkaInstance *v1beta2.Kafka
+	secretHandler infrastructure.SecretHandler
+}
+
+func newKafkaTrustStoreReconciler(context infraContext, kafkaInstance *v1beta2.Kafka) Reconciler {
+	return &kafkaTrustStoreReconciler{
+		infraContext:  context,
+		kafkaInstance: kafkaInstance,
+		secretHandler: infrastructure.NewSecretHandler(context.Context),
+	}
+}
+
+func (k *kafkaTrustStoreReconciler) Reconcile() error {
+	if err := k.reconcileTrustStoreSecret(); err != nil {
+		return err
+	}
+	k.instance.GetStatus().AddSecretVolumeReference(kafkaTrustStoreSecretName, kafkaCertMountPath, &framework.ModeForCertificates, nil)
+
+	if err := newKafkaTrustStoreSecretReconciler(k.infraContext, api.QuarkusRuntimeType).Reconcile(); err != nil {
+		return err
+	}
+	if err := newKafkaTrustStoreSecretReconciler(k.infraContext, api.SpringBootRuntimeType).Reconcile(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (k *kafkaTrustStoreReconciler) reconcileTrustStoreSecret() error {
+	caSecret, err := k.secretHandler.MustFetchSecret(types.NamespacedName{Name: infrastructure.GetKafkaClusterCACertSecretName(k.kafkaInstance.Name), Namespace: k.kafkaInstance.Namespace})
+	if err != nil {
+		return err
+	}
+	return reconcileTrustStoreSecret(k.infraContext, kafkaTrustStoreSecretName, caSecret.Data[infrastructure.KafkaClusterCACertKey])
+}
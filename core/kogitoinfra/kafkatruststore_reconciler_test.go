@@ -0,0 +1,93 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKafkaTrustStoreReconciler(t *testing.T) {
+	ns := t.Name()
+	kogitoKafkaInstance := test.CreateFakeKogitoKafka(ns)
+	kafkaInstance := test.CreateFakeKafka(ns)
+	caSecret, err := test.CreateFakeKafkaClusterCACertSecret(ns)
+	assert.NoError(t, err)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafkaInstance, caSecret).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKafkaInstance,
+	}
+	kafkaTrustStoreReconciler := newKafkaTrustStoreReconciler(infraContext, kafkaInstance)
+	err = kafkaTrustStoreReconciler.Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(kogitoKafkaInstance.GetStatus().GetSecretVolumeReferences()))
+	assert.Equal(t, kafkaCertMountPath, kogitoKafkaInstance.GetStatus().GetSecretVolumeReferences()[0].GetMountPath())
+	assert.Equal(t, 2, len(kogitoKafkaInstance.GetStatus().GetSecretEnvFromReferences()))
+
+	trustStoreSecret := &v1.Secret{
+		ObjectMeta: v12.ObjectMeta{
+			Name:      kafkaTrustStoreSecretName,
+			Namespace: ns,
+		},
+	}
+	exist, err := kubernetes.ResourceC(cli).Fetch(trustStoreSecret)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, trustStoreSecret.Data[trustStoreKey])
+	assert.Equal(t, caSecret.Data[infrastructure.KafkaClusterCACertKey], trustStoreSecret.Data[infrastructure.KafkaClusterCACertKey])
+
+	propsSecret := &v1.Secret{
+		ObjectMeta: v12.ObjectMeta{
+			Name:      "kogito-kafka-truststore-quarkus-secret",
+			Namespace: ns,
+		},
+	}
+	exist, err = kubernetes.ResourceC(cli).Fetch(propsSecret)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, kafkaTrustStorePath, string(propsSecret.Data["kafka.ssl.truststore.location"]))
+}
+
+func TestKafkaTrustStoreReconciler_MissingClusterCA(t *testing.T) {
+	ns := t.Name()
+	kogitoKafkaInstance := test.CreateFakeKogitoKafka(ns)
+	kafkaInstance := test.CreateFakeKafka(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafkaInstance).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKafkaInstance,
+	}
+	kafkaTrustStoreReconciler := newKafkaTrustStoreReconciler(infraContext, kafkaInstance)
+	err := kafkaTrustStoreReconciler.Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, 0, len(kogitoKafkaInstance.GetStatus().GetSecretVolumeReferences()))
+}
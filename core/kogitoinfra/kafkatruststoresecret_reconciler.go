@@ -0,0 +1,124 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const (
+	kafkaTrustStorePropsSecretName = "kogito-kafka-truststore-%s-secret"
+)
+
+type kafkaTrustStoreSecretReconciler struct {
+	infraContext
+	runtime       api.RuntimeType
+	secretHandler infrastructure.SecretHandler
+}
+
+func newKafkaTrustStoreSecretReconciler(context infraContext, runtime api.RuntimeType) Reconciler {
+	return &kafkaTrustStoreSecretReconciler{
+		infraContext:  context,
+		runtime:       runtime,
+		secretHandler: infrastructure.NewSecretHandler(context.Context),
+	}
+}
+
+func (k *kafkaTrustStoreSecretReconciler) Reconcile() (err error) {
+
+	// Create Required resource
+	requestedResources, err := k.createRequiredResources()
+	if err != nil {
+		return
+	}
+
+	// Get Deployed resource
+	deployedResources, err := k.getDeployedResources()
+	if err != nil {
+		return
+	}
+
+	// Process Delta
+	if err = k.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	k.instance.GetStatus().AddSecretEnvFromReferences(k.getKafkaTrustStoreSecretName())
+	return nil
+}
+
+func (k *kafkaTrustStoreSecretReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	secret := k.createKafkaTrustStoreSecret(k.getKafkaTrustStoreSecretProps())
+	if err := framework.SetOwner(k.instance, k.Scheme, secret); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(v12.Secret{})] = []client.Object{secret}
+	return resources, nil
+}
+
+func (k *kafkaTrustStoreSecretReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	deployedSecret, err := k.secretHandler.FetchSecret(types.NamespacedName{Name: k.getKafkaTrustStoreSecretName(), Namespace: k.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if deployedSecret != nil {
+		resources[reflect.TypeOf(v12.Secret{})] = []client.Object{deployedSecret}
+	}
+	return resources, nil
+}
+
+func (k *kafkaTrustStoreSecretReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	comparator := k.secretHandler.GetComparator()
+	deltaProcessor := infrastructure.NewDeltaProcessor(k.Context)
+	_, err = deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return err
+}
+
+func (k *kafkaTrustStoreSecretReconciler) getKafkaTrustStoreSecretProps() map[string][]byte {
+	appProps := map[string][]byte{}
+	appProps[propertiesKafka[k.runtime][appPropKafkaTrustStoreType]] = []byte(pkcs12CertType)
+	appProps[propertiesKafka[k.runtime][appPropKafkaTrustStore]] = []byte(getKafkaStoreLocation(k.runtime, kafkaTrustStorePath))
+	appProps[propertiesKafka[k.runtime][appPropKafkaTrustStorePassword]] = []byte(pkcs12.DefaultPassword)
+	return appProps
+}
+
+func (k *kafkaTrustStoreSecretReconciler) createKafkaTrustStoreSecret(appProps map[string][]byte) *v12.Secret {
+	return &v12.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k.getKafkaTrustStoreSecretName(),
+			Namespace: k.instance.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: k.instance.GetName(),
+			},
+		},
+		Data: appProps,
+	}
+}
+
+func (k *kafkaTrustStoreSecretReconciler) getKafkaTrustStoreSecretName() string {
+	return fmt.Sprintf(kafkaTrustStorePropsSecretName, k.runtime)
+}
@@ -15,13 +15,53 @@
 package kogitoinfra
 
 import (
-	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"fmt"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 )
 
+const (
+	appPropKeycloakAuthServerURL   = iota
+	appPropKeycloakClientIssuerURI // for Spring boot
+
+	envVarKeycloakClientID
+	envVarKeycloakClientSecret
+
+	keycloakRealmPath = "%s/auth/realms/%s"
+
+	infraPropertiesRealmKey = "realm"
+	defaultKeycloakRealm    = "kogito"
+)
+
+var (
+	// Keycloak variables for the KogitoInfra deployed infrastructure.
+	// For Quarkus: https://quarkus.io/guides/security-openid-connect#configuration-reference
+	// For Spring: https://docs.spring.io/spring-security/reference/servlet/oauth2/index.html
+
+	propertiesKeycloak = map[api.RuntimeType]map[int]string{
+		api.QuarkusRuntimeType: {
+			appPropKeycloakAuthServerURL: "quarkus.oidc.auth-server-url",
+
+			envVarKeycloakClientID:     "QUARKUS_OIDC_CLIENT_ID",
+			envVarKeycloakClientSecret: "QUARKUS_OIDC_CREDENTIALS_SECRET",
+		},
+		api.SpringBootRuntimeType: {
+			appPropKeycloakAuthServerURL:   "spring.security.oauth2.resourceserver.jwt.issuer-uri",
+			appPropKeycloakClientIssuerURI: "spring.security.oauth2.client.provider.keycloak.issuer-uri",
+
+			envVarKeycloakClientID:     "SPRING_SECURITY_OAUTH2_CLIENT_REGISTRATION_KEYCLOAK_CLIENT_ID",
+			envVarKeycloakClientSecret: "SPRING_SECURITY_OAUTH2_CLIENT_REGISTRATION_KEYCLOAK_CLIENT_SECRET",
+		},
+	}
+)
+
 // keycloakInfraReconciler implementation of KogitoInfraResource
 type keycloakInfraReconciler struct {
 	infraContext
@@ -54,7 +94,7 @@ func (k *keycloakInfraReconciler) Reconcile() (resultErr error) {
 			namespace = k.instance.GetNamespace()
 			k.Log.Debug("Namespace is not provided for custom resource, taking instance", "Namespace", namespace)
 		}
-		if keycloakInstance, resultErr = k.loadDeployedKeycloakInstance(k.instance.GetSpec().GetResource().GetName(), namespace); resultErr != nil {
+		if keycloakInstance, resultErr = keycloakHandler.FetchKeycloakInstance(types.NamespacedName{Name: k.instance.GetSpec().GetResource().GetName(), Namespace: namespace}); resultErr != nil {
 			return resultErr
 		} else if keycloakInstance == nil {
 			return errorForResourceNotFound("Keycloak", k.instance.GetSpec().GetResource().GetName(), namespace)
@@ -62,20 +102,56 @@ func (k *keycloakInfraReconciler) Reconcile() (resultErr error) {
 	} else {
 		return errorForResourceConfigError(k.instance, "No Keycloak resource name given")
 	}
+
+	if !keycloakInstance.Status.Ready || len(keycloakInstance.Status.InternalURL) == 0 {
+		return errorForResourceNotReadyError(fmt.Errorf("keycloak instance %s not ready. Waiting for Status.Ready", keycloakInstance.Name))
+	}
+	k.Log.Info("Keycloak instance is ready")
+
+	keycloakRealmReconciler := newKeycloakRealmReconciler(k.infraContext, keycloakInstance)
+	if resultErr = keycloakRealmReconciler.Reconcile(); resultErr != nil {
+		return resultErr
+	}
+	if resultErr = k.updateKeycloakRuntimePropsInStatus(keycloakInstance, api.QuarkusRuntimeType); resultErr != nil {
+		return resultErr
+	}
+	if resultErr = k.updateKeycloakRuntimePropsInStatus(keycloakInstance, api.SpringBootRuntimeType); resultErr != nil {
+		return resultErr
+	}
 	return nil
 }
 
-func (k *keycloakInfraReconciler) loadDeployedKeycloakInstance(name string, namespace string) (*keycloakv1alpha1.Keycloak, error) {
-	k.Log.Debug("fetching deployed kogito Keycloak instance")
-	keycloakInstance := &keycloakv1alpha1.Keycloak{}
-	if exits, err := kubernetes.ResourceC(k.Client).FetchWithKey(types.NamespacedName{Name: name, Namespace: namespace}, keycloakInstance); err != nil {
-		k.Log.Error(err, "Error occurs while fetching kogito Keycloak instance")
-		return nil, err
-	} else if !exits {
-		k.Log.Debug("Kogito Keycloak instance is not exists")
-		return nil, nil
-	} else {
-		k.Log.Debug("Kogito Keycloak instance found")
-		return keycloakInstance, nil
+func (k *keycloakInfraReconciler) updateKeycloakRuntimePropsInStatus(keycloakInstance *keycloakv1alpha1.Keycloak, runtime api.RuntimeType) error {
+	k.Log.Debug("going to Update Keycloak runtime properties in kogito infra instance status", "runtime", runtime)
+	keycloakConfigReconciler := newKeycloakConfigReconciler(k.infraContext, keycloakInstance, runtime)
+	return keycloakConfigReconciler.Reconcile()
+}
+
+// getKeycloakRealm gets the name of the realm holding the clients of the services bound to the given KogitoInfra
+func getKeycloakRealm(instance api.KogitoInfraInterface) string {
+	if realm := instance.GetSpec().GetInfraProperties()[infraPropertiesRealmKey]; len(realm) > 0 {
+		return realm
+	}
+	return defaultKeycloakRealm
+}
+
+// GetKeycloakRealmSelector gets the selector of the KeycloakRealm provisioned for the given KogitoInfra
+func GetKeycloakRealmSelector(instance api.KogitoInfraInterface) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			framework.LabelAppKey: instance.GetName(),
+		},
+	}
+}
+
+// GetKeycloakClientEnvs gets the environment variables holding the credentials of the given Keycloak client for the given runtime.
+// The client secret is read from the Secret generated by the Keycloak Operator.
+func GetKeycloakClientEnvs(runtime api.RuntimeType, clientID string) []corev1.EnvVar {
+	if _, ok := propertiesKeycloak[runtime]; !ok {
+		runtime = api.QuarkusRuntimeType
+	}
+	return []corev1.EnvVar{
+		framework.CreateEnvVar(propertiesKeycloak[runtime][envVarKeycloakClientID], clientID),
+		framework.CreateSecretEnvVar(propertiesKeycloak[runtime][envVarKeycloakClientSecret], infrastructure.GetKeycloakClientSecretName(clientID), infrastructure.KeycloakClientSecretKey),
 	}
 }
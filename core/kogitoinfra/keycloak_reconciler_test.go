@@ -0,0 +1,81 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	keycloak "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKeycloakInfraReconciler(t *testing.T) {
+	ns := t.Name()
+	kogitoKeycloakInstance := test.CreateFakeKogitoKeycloak(ns)
+	keycloakInstance := test.CreateFakeKeycloak(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKeycloakInstance, keycloakInstance).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKeycloakInstance,
+	}
+	keycloakInfraReconciler := initkeycloakInfraReconciler(infraContext)
+	err := keycloakInfraReconciler.Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(kogitoKeycloakInstance.GetStatus().GetConfigMapEnvFromReferences()))
+
+	realm := &keycloak.KeycloakRealm{ObjectMeta: metav1.ObjectMeta{Name: kogitoKeycloakInstance.GetName(), Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(realm)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "kogito-realm", realm.Spec.Realm.Realm)
+	assert.Equal(t, keycloakInstance.Labels, realm.Spec.InstanceSelector.MatchLabels)
+	assert.Equal(t, GetKeycloakRealmSelector(kogitoKeycloakInstance).MatchLabels, realm.Labels)
+
+	configMap := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kogito-keycloak-quarkus-config", Namespace: ns}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "https://keycloak."+ns+".svc:8443/auth/realms/kogito-realm", configMap.Data["quarkus.oidc.auth-server-url"])
+}
+
+func TestKeycloakInfraReconciler_KeycloakInstanceNotReady(t *testing.T) {
+	ns := t.Name()
+	kogitoKeycloakInstance := test.CreateFakeKogitoKeycloak(ns)
+	keycloakInstance := test.CreateFakeKeycloak(ns)
+	keycloakInstance.Status.Ready = false
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKeycloakInstance, keycloakInstance).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKeycloakInstance,
+	}
+	keycloakInfraReconciler := initkeycloakInfraReconciler(infraContext)
+	err := keycloakInfraReconciler.Reconcile()
+	assert.Error(t, err)
+	assert.Empty(t, kogitoKeycloakInstance.GetStatus().GetConfigMapEnvFromReferences())
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	keycloakConfigMapName = "kogito-keycloak-%s-config"
+)
+
+type keycloakConfigReconciler struct {
+	infraContext
+	keycloakInstance *keycloakv1alpha1.Keycloak
+	runtime          api.RuntimeType
+	configMapHandler infrastructure.ConfigMapHandler
+}
+
+func newKeycloakConfigReconciler(ctx infraContext, keycloakInstance *keycloakv1alpha1.Keycloak, runtime api.RuntimeType) Reconciler {
+	return &keycloakConfigReconciler{
+		infraContext:     ctx,
+		keycloakInstance: keycloakInstance,
+		runtime:          runtime,
+		configMapHandler: infrastructure.NewConfigMapHandler(ctx.Context),
+	}
+}
+
+func (k *keycloakConfigReconciler) Reconcile() (err error) {
+	// Create Required resource
+	requestedResources, err := k.createRequiredResources()
+	if err != nil {
+		return
+	}
+
+	// Get Deployed resource
+	deployedResources, err := k.getDeployedResources()
+	if err != nil {
+		return
+	}
+
+	// Process Delta
+	if err = k.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	k.instance.GetStatus().AddConfigMapEnvFromReferences(k.getKeycloakConfigMapName())
+	return nil
+}
+
+func (k *keycloakConfigReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	configMap := k.createKeycloakConfigMap(k.getKeycloakAppProps())
+	if err := framework.SetOwner(k.instance, k.Scheme, configMap); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(v12.ConfigMap{})] = []client.Object{configMap}
+	return resources, nil
+}
+
+func (k *keycloakConfigReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	deployedConfigMap, err := k.configMapHandler.FetchConfigMap(types.NamespacedName{Name: k.getKeycloakConfigMapName(), Namespace: k.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if deployedConfigMap != nil {
+		resources[reflect.TypeOf(v12.ConfigMap{})] = []client.Object{deployedConfigMap}
+	}
+	return resources, nil
+}
+
+func (k *keycloakConfigReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	comparator := k.configMapHandler.GetComparator()
+	deltaProcessor := infrastructure.NewDeltaProcessor(k.Context)
+	_, err = deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return err
+}
+
+func (k *keycloakConfigReconciler) getKeycloakAppProps() map[string]string {
+	appProps := map[string]string{}
+	realmURL := fmt.Sprintf(keycloakRealmPath, k.keycloakInstance.Status.InternalURL, getKeycloakRealm(k.instance))
+	appProps[propertiesKeycloak[k.runtime][appPropKeycloakAuthServerURL]] = realmURL
+	if k.runtime == api.SpringBootRuntimeType {
+		appProps[propertiesKeycloak[k.runtime][appPropKeycloakClientIssuerURI]] = realmURL
+	}
+	return appProps
+}
+
+func (k *keycloakConfigReconciler) createKeycloakConfigMap(appProps map[string]string) *v12.ConfigMap {
+	return &v12.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k.getKeycloakConfigMapName(),
+			Namespace: k.instance.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: k.instance.GetName(),
+			},
+		},
+		Data: appProps,
+	}
+}
+
+func (k *keycloakConfigReconciler) getKeycloakConfigMapName() string {
+	return fmt.Sprintf(keycloakConfigMapName, k.runtime)
+}
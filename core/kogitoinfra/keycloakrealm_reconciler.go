@@ -0,0 +1,114 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// keycloakRealmReconciler provisions the KeycloakRealm holding the clients of the services bound to the KogitoInfra.
+// The realm is created in the KogitoInfra namespace, so the Keycloak Operator must watch this namespace.
+type keycloakRealmReconciler struct {
+	infraContext
+	keycloakInstance *keycloakv1alpha1.Keycloak
+	keycloakHandler  infrastructure.KeycloakHandler
+}
+
+func newKeycloakRealmReconciler(ctx infraContext, keycloakInstance *keycloakv1alpha1.Keycloak) Reconciler {
+	return &keycloakRealmReconciler{
+		infraContext:     ctx,
+		keycloakInstance: keycloakInstance,
+		keycloakHandler:  infrastructure.NewKeycloakHandler(ctx.Context),
+	}
+}
+
+func (k *keycloakRealmReconciler) Reconcile() (err error) {
+	// Create Required resource
+	requestedResources, err := k.createRequiredResources()
+	if err != nil {
+		return
+	}
+
+	// Get Deployed resource
+	deployedResources, err := k.getDeployedResources()
+	if err != nil {
+		return
+	}
+
+	// Process Delta
+	return k.processDelta(requestedResources, deployedResources)
+}
+
+func (k *keycloakRealmReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if len(k.keycloakInstance.Labels) == 0 {
+		return nil, errorForResourceConfigError(k.instance, fmt.Sprintf("Keycloak instance %s has no labels to be selected by the KeycloakRealm", k.keycloakInstance.Name))
+	}
+	realm := k.createKeycloakRealm()
+	if err := framework.SetOwner(k.instance, k.Scheme, realm); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(keycloakv1alpha1.KeycloakRealm{})] = []client.Object{realm}
+	return resources, nil
+}
+
+func (k *keycloakRealmReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	deployedRealm, err := k.keycloakHandler.FetchKeycloakRealm(types.NamespacedName{Name: k.instance.GetName(), Namespace: k.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if deployedRealm != nil {
+		resources[reflect.TypeOf(keycloakv1alpha1.KeycloakRealm{})] = []client.Object{deployedRealm}
+	}
+	return resources, nil
+}
+
+func (k *keycloakRealmReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	comparator := k.keycloakHandler.GetKeycloakRealmComparator()
+	deltaProcessor := infrastructure.NewDeltaProcessor(k.Context)
+	_, err = deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return err
+}
+
+func (k *keycloakRealmReconciler) createKeycloakRealm() *keycloakv1alpha1.KeycloakRealm {
+	realmName := getKeycloakRealm(k.instance)
+	return &keycloakv1alpha1.KeycloakRealm{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k.instance.GetName(),
+			Namespace: k.instance.GetNamespace(),
+			Labels:    GetKeycloakRealmSelector(k.instance).MatchLabels,
+		},
+		Spec: keycloakv1alpha1.KeycloakRealmSpec{
+			InstanceSelector: &metav1.LabelSelector{
+				MatchLabels: k.keycloakInstance.Labels,
+			},
+			Realm: &keycloakv1alpha1.KeycloakAPIRealm{
+				ID:          realmName,
+				Realm:       realmName,
+				Enabled:     true,
+				DisplayName: realmName,
+			},
+		},
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	postgresql "github.com/kiegroup/kogito-operator/core/infrastructure/postgresql/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+const (
+	appPropPostgreSQLDBKind = iota
+	appPropPostgreSQLJdbcURL
+	appPropPostgreSQLReactiveURL // for Quarkus
+	appPropPostgreSQLPersistenceType
+
+	envVarPostgreSQLUser
+	envVarPostgreSQLPassword
+
+	postgreSQLEnablePersistenceEnvKey = "ENABLE_PERSISTENCE"
+	postgreSQLDBKind                  = "postgresql"
+	postgreSQLJdbcURLFormat           = "jdbc:postgresql://%s:%s/%s"
+	postgreSQLReactiveURLFormat       = "postgresql://%s:%s/%s"
+)
+
+var (
+	// PostgreSQL variables for the KogitoInfra deployed infrastructure.
+	//For Quarkus: https://quarkus.io/guides/datasource#configuration-reference
+	//For Spring: https://docs.spring.io/spring-boot/docs/current/reference/html/application-properties.html#application-properties.data
+
+	propertiesPostgreSQL = map[api.RuntimeType]map[int]string{
+		api.QuarkusRuntimeType: {
+			appPropPostgreSQLDBKind:          "quarkus.datasource.db-kind",
+			appPropPostgreSQLJdbcURL:         "quarkus.datasource.jdbc.url",
+			appPropPostgreSQLReactiveURL:     "quarkus.datasource.reactive.url",
+			appPropPostgreSQLPersistenceType: "kogito.persistence.type",
+
+			envVarPostgreSQLUser:     "QUARKUS_DATASOURCE_USERNAME",
+			envVarPostgreSQLPassword: "QUARKUS_DATASOURCE_PASSWORD",
+		},
+		api.SpringBootRuntimeType: {
+			appPropPostgreSQLJdbcURL:         "spring.datasource.url",
+			appPropPostgreSQLPersistenceType: "kogito.persistence.type",
+
+			envVarPostgreSQLUser:     "SPRING_DATASOURCE_USERNAME",
+			envVarPostgreSQLPassword: "SPRING_DATASOURCE_PASSWORD",
+		},
+	}
+)
+
+type postgreSQLInfraReconciler struct {
+	infraContext
+}
+
+func initPostgreSQLInfraReconciler(context infraContext) Reconciler {
+	context.Log = context.Log.WithValues("resource", "postgreSQL")
+	return &postgreSQLInfraReconciler{
+		infraContext: context,
+	}
+}
+
+// AppendPostgreSQLWatchedObjects ...
+func AppendPostgreSQLWatchedObjects(b *builder.Builder) *builder.Builder {
+	return b.Owns(&corev1.Secret{})
+}
+
+func (i *postgreSQLInfraReconciler) Reconcile() (resultErr error) {
+	var postgreSQLInstance *postgresql.PostgresCluster
+	postgreSQLHandler := infrastructure.NewPostgreSQLHandler(i.Context)
+	if !postgreSQLHandler.IsPostgreSQLAvailable() {
+		return errorForResourceAPINotFound(i.instance.GetSpec().GetResource().GetAPIVersion())
+	}
+
+	// Step 1: check whether user has provided custom PostgreSQL instance reference
+	postgreSQLNamespace := i.instance.GetSpec().GetResource().GetNamespace()
+	postgreSQLName := i.instance.GetSpec().GetResource().GetName()
+	if len(postgreSQLNamespace) == 0 {
+		postgreSQLNamespace = i.instance.GetNamespace()
+		i.Log.Debug("Namespace is not provided for infrastructure PostgreSQL resource", "instance", i.instance.GetName(), "namespace", postgreSQLNamespace)
+	}
+	if len(postgreSQLName) == 0 {
+		return errorForResourceConfigError(i.instance, "No resource name given")
+	}
+
+	if postgreSQLInstance, resultErr = postgreSQLHandler.FetchPostgreSQLInstance(types.NamespacedName{Name: postgreSQLName, Namespace: postgreSQLNamespace}); resultErr != nil {
+		return resultErr
+	} else if postgreSQLInstance == nil {
+		return errorForResourceNotFound("PostgreSQL", postgreSQLName, postgreSQLNamespace)
+	}
+
+	if !postgreSQLHandler.IsPostgreSQLInstanceReady(postgreSQLInstance) {
+		return errorForResourceNotReadyError(fmt.Errorf("postgreSQL instance %s not ready. Waiting for all instances to be ready", postgreSQLInstance.Name))
+	}
+	i.Log.Info("PostgreSQL instance is running")
+
+	// Step 2: fetch the connection details generated by the PostgreSQL Operator for the given user
+	userSecretName := postgreSQLHandler.GetUserSecretName(postgreSQLInstance, i.instance.GetSpec().GetInfraProperties()[infraPropertiesUserKey])
+	userSecret, resultErr := infrastructure.NewSecretHandler(i.Context).FetchSecret(types.NamespacedName{Name: userSecretName, Namespace: postgreSQLNamespace})
+	if resultErr != nil {
+		return resultErr
+	} else if userSecret == nil {
+		return errorForResourceNotFound("Secret", userSecretName, postgreSQLNamespace)
+	}
+	connection := i.getPostgreSQLConnection(userSecret)
+
+	if resultErr = i.updatePostgreSQLRuntimePropsInStatus(connection, api.QuarkusRuntimeType); resultErr != nil {
+		return resultErr
+	}
+	if resultErr = i.updatePostgreSQLRuntimePropsInStatus(connection, api.SpringBootRuntimeType); resultErr != nil {
+		return resultErr
+	}
+	return resultErr
+}
+
+// postgreSQLConnection holds the details required by Kogito services to connect to a PostgreSQL database
+type postgreSQLConnection struct {
+	Host     string
+	Port     string
+	Database string
+	Username string
+	Password string
+}
+
+func (i *postgreSQLInfraReconciler) getPostgreSQLConnection(userSecret *corev1.Secret) *postgreSQLConnection {
+	connection := &postgreSQLConnection{
+		Host:     string(userSecret.Data[infrastructure.PostgreSQLUserSecretHostKey]),
+		Port:     string(userSecret.Data[infrastructure.PostgreSQLUserSecretPortKey]),
+		Database: string(userSecret.Data[infrastructure.PostgreSQLUserSecretDatabaseKey]),
+		Username: string(userSecret.Data[infrastructure.PostgreSQLUserSecretUsernameKey]),
+		Password: string(userSecret.Data[infrastructure.PostgreSQLUserSecretPasswordKey]),
+	}
+	if len(connection.Port) == 0 {
+		connection.Port = fmt.Sprint(infrastructure.DefaultPostgreSQLPort)
+	}
+	if database := i.instance.GetSpec().GetInfraProperties()[infraPropertiesDatabaseKey]; len(database) > 0 {
+		connection.Database = database
+	}
+	return connection
+}
+
+func (i *postgreSQLInfraReconciler) updatePostgreSQLRuntimePropsInStatus(connection *postgreSQLConnection, runtime api.RuntimeType) error {
+	i.Log.Debug("going to Update PostgreSQL runtime properties in kogito infra instance status", "runtime", runtime)
+	postgreSQLConfigReconciler := newPostgreSQLConfigReconciler(i.infraContext, connection, runtime)
+	if err := postgreSQLConfigReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	postgreSQLCredentialReconciler := newPostgreSQLCredentialReconciler(i.infraContext, connection, runtime)
+	if err := postgreSQLCredentialReconciler.Reconcile(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPostgreSQLInfraReconciler(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgreSQL(ns)
+	postgreSQLInstance := test.CreateFakePostgreSQL(ns)
+	userSecret := test.CreateFakePostgreSQLUserSecret(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoPostgreSQLInstance, postgreSQLInstance, userSecret).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoPostgreSQLInstance,
+	}
+	postgreSQLInfraReconciler := initPostgreSQLInfraReconciler(infraContext)
+	err := postgreSQLInfraReconciler.Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(kogitoPostgreSQLInstance.GetStatus().GetConfigMapEnvFromReferences()))
+	assert.Equal(t, 2, len(kogitoPostgreSQLInstance.GetStatus().GetSecretEnvFromReferences()))
+
+	configMap := &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-postgresql-" + kogitoPostgreSQLInstance.GetName() + "-quarkus-config", Namespace: ns}}
+	exist, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, "true", configMap.Data["ENABLE_PERSISTENCE"])
+	assert.Equal(t, "postgresql", configMap.Data["quarkus.datasource.db-kind"])
+	assert.Equal(t, "postgresql://kogito-postgresql-primary."+ns+".svc:5432/kogito", configMap.Data["quarkus.datasource.reactive.url"])
+	assert.Equal(t, "jdbc:postgresql://kogito-postgresql-primary."+ns+".svc:5432/kogito", configMap.Data["quarkus.datasource.jdbc.url"])
+
+	configMap = &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-postgresql-" + kogitoPostgreSQLInstance.GetName() + "-springboot-config", Namespace: ns}}
+	exist, err = kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, "jdbc:postgresql://kogito-postgresql-primary."+ns+".svc:5432/kogito", configMap.Data["spring.datasource.url"])
+
+	secret := &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kogito-postgresql-" + kogitoPostgreSQLInstance.GetName() + "-quarkus-credential", Namespace: ns}}
+	exist, err = kubernetes.ResourceC(cli).Fetch(secret)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, "kogito", secret.StringData["QUARKUS_DATASOURCE_USERNAME"])
+	assert.Equal(t, "passwordToFind", secret.StringData["QUARKUS_DATASOURCE_PASSWORD"])
+}
+
+func TestPostgreSQLInfraReconciler_PostgreSQLInstanceNotReady(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgreSQL(ns)
+	postgreSQLInstance := test.CreateFakePostgreSQL(ns)
+	postgreSQLInstance.Status.InstanceSets[0].ReadyReplicas = 0
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoPostgreSQLInstance, postgreSQLInstance).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoPostgreSQLInstance,
+	}
+	postgreSQLInfraReconciler := initPostgreSQLInfraReconciler(infraContext)
+	err := postgreSQLInfraReconciler.Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, 0, len(kogitoPostgreSQLInstance.GetStatus().GetConfigMapEnvFromReferences()))
+}
+
+func TestPostgreSQLInfraReconciler_MissingUserSecret(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgreSQL(ns)
+	postgreSQLInstance := test.CreateFakePostgreSQL(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoPostgreSQLInstance, postgreSQLInstance).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoPostgreSQLInstance,
+	}
+	postgreSQLInfraReconciler := initPostgreSQLInfraReconciler(infraContext)
+	err := postgreSQLInfraReconciler.Reconcile()
+	assert.Errorf(t, err, "Secret resource(kogito-postgresql-pguser-kogito) not found in namespace %s", ns)
+}
+
+func TestPostgreSQLInfraReconciler_WithExternalPostgreSQL(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgreSQL(ns)
+	externalInstance := test.CreateFakeKogitoExternal(ns, api.PostgreSQLExternalInfra, "jdbc:postgresql://db.example.com:5432/kogito")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoPostgreSQLInstance, test.CreateFakePostgreSQL(ns), test.CreateFakePostgreSQLUserSecret(ns), externalInstance).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoPostgreSQLInstance,
+	}
+	assert.NoError(t, initPostgreSQLInfraReconciler(infraContext).Reconcile())
+	assert.NoError(t, initExternalInfraReconciler(newExternalInfraContext(cli, externalInstance)).Reconcile())
+
+	// both infras of the namespace keep their own properties
+	assert.NotEqual(t, kogitoPostgreSQLInstance.GetStatus().GetConfigMapEnvFromReferences(), externalInstance.GetStatus().GetConfigMapEnvFromReferences())
+	configMap := &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-postgresql-" + kogitoPostgreSQLInstance.GetName() + "-quarkus-config", Namespace: ns}}
+	exist, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, "jdbc:postgresql://kogito-postgresql-primary."+ns+".svc:5432/kogito", configMap.Data["quarkus.datasource.jdbc.url"])
+}
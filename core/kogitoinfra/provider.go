@@ -0,0 +1,246 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// InfraProviderLabelKey label key used by ConfigMaps that define an infra provider
+	InfraProviderLabelKey = "kogito-infra-provider"
+	// InfraProviderDefinitionKey key of the ConfigMap data holding the infra provider definition
+	InfraProviderDefinitionKey = "provider.yaml"
+)
+
+// resolvedInfraProviders holds the ConfigMap of the infra provider each KogitoInfra resolved to,
+// so that a definition turning invalid only concerns the KogitoInfras it used to configure
+var resolvedInfraProviders sync.Map
+
+// infraProvider declares how a KogitoInfra referencing a resource not handled by a built-in reconciler is configured.
+// Providers are read from the ConfigMaps labelled with kogito-infra-provider=true in the namespace of the KogitoInfra,
+// then in the namespace of the operator to share them with every namespace, e.g.:
+//
+//	apiVersion: redis.redis.opstreelabs.in/v1beta1
+//	kind: Redis
+//	readiness:
+//	  - jsonPath: "{.status.phase}"
+//	    value: Ready
+//	runtimeProperties:
+//	  quarkus:
+//	    appProps:
+//	      quarkus.redis.hosts: "redis://{{ .Resource.metadata.name }}.{{ .Namespace }}:6379"
+//	    secretProps:
+//	      QUARKUS_REDIS_PASSWORD: '{{ secret "redis-secret" "password" }}'
+//
+// Secrets are only read from the namespace of the KogitoInfra.
+// The operator must be granted the permissions to get, list and watch the declared resource, the KogitoInfra fails with ResourceAccessDenied otherwise.
+type infraProvider struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Readiness checks that must all pass before the properties are rendered
+	Readiness []providerReadinessCheck `json:"readiness,omitempty"`
+	// RuntimeProperties are the Go templates of the properties for each runtime
+	RuntimeProperties map[api.RuntimeType]providerRuntimeProperties `json:"runtimeProperties,omitempty"`
+	// configMap declaring the provider
+	configMap types.NamespacedName
+}
+
+// providerReadinessCheck passes if the JSONPath evaluated against the resource gives the expected value, or any value if none is expected
+type providerReadinessCheck struct {
+	JSONPath string `json:"jsonPath"`
+	Value    string `json:"value,omitempty"`
+}
+
+// providerRuntimeProperties holds the application properties set in a ConfigMap and the sensitive ones set in a Secret
+type providerRuntimeProperties struct {
+	AppProps    map[string]string `json:"appProps,omitempty"`
+	SecretProps map[string]string `json:"secretProps,omitempty"`
+}
+
+func (p *infraProvider) getResourceClass() string {
+	return getResourceClass(p.Kind, p.APIVersion)
+}
+
+func (p *infraProvider) validate() error {
+	if len(p.APIVersion) == 0 || len(p.Kind) == 0 {
+		return fmt.Errorf("apiVersion and kind are required")
+	}
+	for runtime := range p.RuntimeProperties {
+		if runtime != api.QuarkusRuntimeType && runtime != api.SpringBootRuntimeType {
+			return fmt.Errorf("runtime %s not supported, use %s or %s", runtime, api.QuarkusRuntimeType, api.SpringBootRuntimeType)
+		}
+	}
+	return nil
+}
+
+func parseInfraProvider(definition string) (*infraProvider, error) {
+	provider := &infraProvider{}
+	if err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(definition), len(definition)).Decode(provider); err != nil {
+		return nil, err
+	}
+	if err := provider.validate(); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// fetchInfraProvider gets the infra provider declared for the given resource in the namespace or in the operator namespace, nil if there's none.
+// Invalid definitions are logged and ignored, so that they don't prevent other providers to be used.
+func fetchInfraProvider(context operator.Context, namespace string, resource api.ResourceInterface) (*infraProvider, error) {
+	namespaces := []string{namespace}
+	if operatorNamespace := infrastructure.GetOperatorNamespace(); len(operatorNamespace) > 0 && operatorNamespace != namespace {
+		namespaces = append(namespaces, operatorNamespace)
+	}
+	resourceClass := resourceClassForInstance(resource)
+	for _, ns := range namespaces {
+		configMaps, err := infrastructure.NewConfigMapHandler(context).FetchConfigMapsForLabel(ns, map[string]string{InfraProviderLabelKey: "true"})
+		if err != nil {
+			return nil, err
+		}
+		for _, configMap := range configMaps.Items {
+			provider, err := parseInfraProvider(configMap.Data[InfraProviderDefinitionKey])
+			if err != nil {
+				context.Log.Warn("Ignoring invalid infra provider", "configMap", configMap.Name, "namespace", ns, "error", err.Error())
+				continue
+			}
+			if provider.getResourceClass() == resourceClass {
+				provider.configMap = types.NamespacedName{Name: configMap.Name, Namespace: configMap.Namespace}
+				return provider, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// IsInfraProviderFor checks if the given ConfigMap declares the infra provider of the resource referenced by the KogitoInfra
+func IsInfraProviderFor(instance api.KogitoInfraInterface, configMap *corev1.ConfigMap) bool {
+	if configMap.Labels[InfraProviderLabelKey] != "true" || instance.GetSpec().IsResourceEmpty() {
+		return false
+	}
+	if configMap.Namespace != instance.GetNamespace() && configMap.Namespace != infrastructure.GetOperatorNamespace() {
+		return false
+	}
+	provider, err := parseInfraProvider(configMap.Data[InfraProviderDefinitionKey])
+	if err != nil {
+		// a definition turning invalid concerns the KogitoInfra only if it was its provider
+		resolvedConfigMap, resolved := resolvedInfraProviders.Load(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+		return resolved && resolvedConfigMap == types.NamespacedName{Name: configMap.Name, Namespace: configMap.Namespace}
+	}
+	return provider.getResourceClass() == resourceClassForInstance(instance.GetSpec().GetResource())
+}
+
+// ForgetInfraProvider drops the infra provider the given KogitoInfra resolved to, once it's deleted
+func ForgetInfraProvider(key types.NamespacedName) {
+	resolvedInfraProviders.Delete(key)
+}
+
+// CheckProvidedResourceAccess checks that the kind of the resource referenced by the KogitoInfra is served by the cluster
+// and that the operator is granted to watch it, before the watch is added
+func CheckProvidedResourceAccess(context operator.Context, instance api.KogitoInfraInterface) error {
+	resource := instance.GetSpec().GetResource()
+	groupVersionResource, err := resolveGroupVersionResource(context, resource.GetAPIVersion(), resource.GetKind())
+	if err != nil {
+		return err
+	} else if groupVersionResource == nil {
+		return errorForResourceAPINotFound(resource.GetAPIVersion())
+	}
+	allowed, err := infrastructure.NewRBACHandler(context).IsWatchAllowed(*groupVersionResource)
+	if err != nil {
+		return err
+	} else if !allowed {
+		return errorForResourceAccessDenied(*groupVersionResource, infrastructure.GetWatchNamespace())
+	}
+	return nil
+}
+
+// resolveGroupVersionResource gets the resource serving the given kind through the discovery API, nil if the cluster doesn't serve it
+func resolveGroupVersionResource(context operator.Context, apiVersion, kind string) (*schema.GroupVersionResource, error) {
+	groupVersion, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	if !context.Client.HasServerGroup(groupVersion.Group) {
+		return nil, nil
+	}
+	resources, err := context.Client.Discovery.ServerResourcesForGroupVersion(apiVersion)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for _, resource := range resources.APIResources {
+		// subresources are named after their resource, e.g. redis/status
+		if resource.Kind == kind && !strings.Contains(resource.Name, "/") {
+			groupVersionResource := groupVersion.WithResource(resource.Name)
+			return &groupVersionResource, nil
+		}
+	}
+	return nil, nil
+}
+
+// IsProvidedResourceOf checks if the given object is the resource referenced by the KogitoInfra and declared by an infra provider
+func IsProvidedResourceOf(instance api.KogitoInfraInterface, object client.Object) bool {
+	if instance.GetSpec().IsResourceEmpty() {
+		return false
+	}
+	resource := instance.GetSpec().GetResource()
+	if IsBuiltInResourceGroup(resource.GetAPIVersion()) {
+		return false
+	}
+	namespace := resource.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = instance.GetNamespace()
+	}
+	gvk := object.GetObjectKind().GroupVersionKind()
+	return resourceClassForInstance(resource) == getResourceClass(gvk.Kind, gvk.GroupVersion().String()) &&
+		resource.GetName() == object.GetName() && namespace == object.GetNamespace()
+}
+
+// IsBuiltInResourceGroup checks if the API group of the given apiVersion is handled by one of the KogitoInfra built-in reconcilers.
+// Resources of other groups might be handled by an infra provider.
+func IsBuiltInResourceGroup(apiVersion string) bool {
+	group := getAPIGroup(apiVersion)
+	for _, builtIn := range []string{
+		infrastructure.InfinispanAPIVersion,
+		infrastructure.KafkaAPIVersion,
+		infrastructure.KeycloakAPIVersion,
+		infrastructure.KnativeEventingAPIVersion,
+		infrastructure.MongoDBAPIVersion,
+		infrastructure.PostgreSQLAPIVersion,
+	} {
+		if getAPIGroup(builtIn) == group {
+			return true
+		}
+	}
+	return false
+}
+
+func getAPIGroup(apiVersion string) string {
+	return strings.ToLower(strings.Split(apiVersion, "/")[0])
+}
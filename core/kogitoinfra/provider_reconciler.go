@@ -0,0 +1,200 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// providerTemplateData is the data available in the templates of the infra provider properties
+type providerTemplateData struct {
+	// Resource is the content of the resource referenced by the KogitoInfra
+	Resource map[string]interface{}
+	// Namespace of the resource referenced by the KogitoInfra
+	Namespace string
+	// InfraProperties of the KogitoInfra
+	InfraProperties map[string]string
+}
+
+// providerInfraReconciler configures the services to connect to a resource declared by an infra provider
+type providerInfraReconciler struct {
+	infraContext
+	provider      *infraProvider
+	secretHandler infrastructure.SecretHandler
+}
+
+func initProviderInfraReconciler(context infraContext, provider *infraProvider) Reconciler {
+	context.Log = context.Log.WithValues("resource", provider.Kind)
+	return &providerInfraReconciler{
+		infraContext:  context,
+		provider:      provider,
+		secretHandler: infrastructure.NewSecretHandler(context.Context),
+	}
+}
+
+// Reconcile reconcile Kogito infra object
+func (p *providerInfraReconciler) Reconcile() error {
+	resourceNamespace := p.instance.GetSpec().GetResource().GetNamespace()
+	resourceName := p.instance.GetSpec().GetResource().GetName()
+	if len(resourceNamespace) == 0 {
+		resourceNamespace = p.instance.GetNamespace()
+	}
+	if len(resourceName) == 0 {
+		return errorForResourceConfigError(p.instance, "No resource name given")
+	}
+
+	resource, err := p.fetchResource(types.NamespacedName{Name: resourceName, Namespace: resourceNamespace})
+	if err != nil {
+		return err
+	}
+	if err := p.checkReadiness(resource); err != nil {
+		return err
+	}
+
+	data := providerTemplateData{
+		Resource:        resource.Object,
+		Namespace:       resourceNamespace,
+		InfraProperties: p.instance.GetSpec().GetInfraProperties(),
+	}
+	kind := strings.ToLower(p.provider.Kind)
+	for _, runtime := range []api.RuntimeType{api.QuarkusRuntimeType, api.SpringBootRuntimeType} {
+		runtimeProperties, ok := p.provider.RuntimeProperties[runtime]
+		if !ok {
+			continue
+		}
+		appProps, err := p.renderProperties(runtimeProperties.AppProps, data)
+		if err != nil {
+			return err
+		}
+		if err := newExternalConfigReconciler(p.infraContext, kind, runtime, appProps).Reconcile(); err != nil {
+			return err
+		}
+		if len(runtimeProperties.SecretProps) > 0 {
+			secretProps, err := p.renderProperties(runtimeProperties.SecretProps, data)
+			if err != nil {
+				return err
+			}
+			if err := newExternalCredentialReconciler(p.infraContext, kind, runtime, secretProps).Reconcile(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *providerInfraReconciler) fetchResource(key types.NamespacedName) (*unstructured.Unstructured, error) {
+	resource := &unstructured.Unstructured{}
+	resource.SetAPIVersion(p.provider.APIVersion)
+	resource.SetKind(p.provider.Kind)
+	exists, err := kubernetes.ResourceC(p.Client).FetchWithKey(key, resource)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, errorForResourceAPINotFound(p.provider.APIVersion)
+		}
+		return nil, err
+	} else if !exists {
+		return nil, errorForResourceNotFound(p.provider.Kind, key.Name, key.Namespace)
+	}
+	return resource, nil
+}
+
+// checkReadiness evaluates the readiness checks of the provider against the given resource
+func (p *providerInfraReconciler) checkReadiness(resource *unstructured.Unstructured) error {
+	for _, check := range p.provider.Readiness {
+		value, err := evaluateJSONPath(check.JSONPath, resource.Object)
+		if err != nil {
+			return errorForResourceConfigError(p.instance, fmt.Sprintf("Invalid readiness JSONPath %s: %v", check.JSONPath, err))
+		}
+		if (len(check.Value) == 0 && len(value) == 0) || (len(check.Value) > 0 && value != check.Value) {
+			return errorForResourceNotReadyError(fmt.Errorf("%s instance %s not ready. Waiting for %s == %q", p.provider.Kind, resource.GetName(), check.JSONPath, check.Value))
+		}
+	}
+	return nil
+}
+
+// renderProperties executes the templates of the given properties, sorted by key to get the same error on every reconciliation
+func (p *providerInfraReconciler) renderProperties(templates map[string]string, data providerTemplateData) (map[string]string, error) {
+	keys := make([]string, 0, len(templates))
+	for key := range templates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	funcs := template.FuncMap{"secret": p.getSecretValue}
+	props := make(map[string]string, len(templates))
+	for _, key := range keys {
+		tmpl, err := template.New(key).Option("missingkey=error").Funcs(funcs).Parse(templates[key])
+		if err != nil {
+			return nil, errorForResourceConfigError(p.instance, fmt.Sprintf("Invalid template for property %s: %v", key, err))
+		}
+		var value bytes.Buffer
+		if err := tmpl.Execute(&value, data); err != nil {
+			// a missing Secret is reported as is to wait for it to be created, as well as a Secret out of the KogitoInfra namespace
+			var reconcileErr reconciliationError
+			if errors.As(err, &reconcileErr) {
+				return nil, reconcileErr
+			}
+			return nil, errorForResourceConfigError(p.instance, fmt.Sprintf("Failed to render property %s: %v", key, err))
+		}
+		props[key] = value.String()
+	}
+	return props, nil
+}
+
+// getSecretValue is the template function reading the value of a key in a Secret.
+// Secrets are only read from the namespace of the KogitoInfra, so that a provider can't be used to copy the Secrets of other namespaces.
+func (p *providerInfraReconciler) getSecretValue(name, key string, namespace ...string) (string, error) {
+	infraNamespace := p.instance.GetNamespace()
+	for _, ns := range namespace {
+		if ns != infraNamespace {
+			return "", errorForResourceConfigError(p.instance, fmt.Sprintf("Secret %s can't be read from namespace %s, only Secrets of the KogitoInfra namespace %s are allowed", name, ns, infraNamespace))
+		}
+	}
+	secret, err := p.secretHandler.FetchSecret(types.NamespacedName{Name: name, Namespace: infraNamespace})
+	if err != nil {
+		return "", err
+	} else if secret == nil {
+		return "", errorForResourceNotFound("Secret", name, infraNamespace)
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("secret %s doesn't have the key %s", name, key)
+	}
+	return string(value), nil
+}
+
+func evaluateJSONPath(path string, object map[string]interface{}) (string, error) {
+	parser := jsonpath.New("readiness").AllowMissingKeys(true)
+	if err := parser.Parse(path); err != nil {
+		return "", err
+	}
+	var value bytes.Buffer
+	if err := parser.Execute(&value, object); err != nil {
+		return "", err
+	}
+	return value.String(), nil
+}
@@ -0,0 +1,246 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	discfake "k8s.io/client-go/discovery/fake"
+)
+
+const redisProviderDefinition = `
+apiVersion: redis.redis.opstreelabs.in/v1beta1
+kind: Redis
+readiness:
+  - jsonPath: "{.status.phase}"
+    value: Ready
+runtimeProperties:
+  quarkus:
+    appProps:
+      quarkus.redis.hosts: "redis://{{ .Resource.metadata.name }}.{{ .Namespace }}:{{ .Resource.spec.port }}"
+      quarkus.redis.database: '{{ index .InfraProperties "database" }}'
+    secretProps:
+      QUARKUS_REDIS_PASSWORD: '{{ secret "redis-secret" "password" }}'
+`
+
+func createFakeRedisProvider(namespace, definition string) *v1.ConfigMap {
+	return &v1.ConfigMap{
+		ObjectMeta: v12.ObjectMeta{
+			Name:      "redis-provider",
+			Namespace: namespace,
+			Labels:    map[string]string{InfraProviderLabelKey: "true"},
+		},
+		Data: map[string]string{InfraProviderDefinitionKey: definition},
+	}
+}
+
+func createFakeRedis(namespace, phase string) *unstructured.Unstructured {
+	redis := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec":   map[string]interface{}{"port": int64(6379)},
+			"status": map[string]interface{}{"phase": phase},
+		},
+	}
+	redis.SetAPIVersion("redis.redis.opstreelabs.in/v1beta1")
+	redis.SetKind("Redis")
+	redis.SetName("redis")
+	redis.SetNamespace(namespace)
+	return redis
+}
+
+func createFakeRedisInfra(namespace string) *v1beta1.KogitoInfra {
+	return &v1beta1.KogitoInfra{
+		ObjectMeta: v12.ObjectMeta{Name: "kogito-redis", Namespace: namespace},
+		Spec: v1beta1.KogitoInfraSpec{
+			Resource: &v1beta1.InfraResource{
+				APIVersion: "redis.redis.opstreelabs.in/v1beta1",
+				Kind:       "Redis",
+				Name:       "redis",
+			},
+			InfraProperties: map[string]string{"database": "1"},
+		},
+	}
+}
+
+func TestProviderInfraReconciler(t *testing.T) {
+	ns := t.Name()
+	instance := createFakeRedisInfra(ns)
+	secret := &v1.Secret{
+		ObjectMeta: v12.ObjectMeta{Name: "redis-secret", Namespace: ns},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, secret, createFakeRedisProvider(ns, redisProviderDefinition), createFakeRedis(ns, "Ready")).Build()
+	handler := NewReconcilerHandler(operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()})
+
+	reconciler, err := handler.GetInfraReconciler(instance)
+	assert.NoError(t, err)
+	assert.IsType(t, &providerInfraReconciler{}, reconciler)
+	assert.NoError(t, reconciler.Reconcile())
+	assert.Equal(t, []string{"kogito-redis-quarkus-config"}, instance.GetStatus().GetConfigMapEnvFromReferences())
+	assert.Equal(t, []string{"kogito-redis-quarkus-credential"}, instance.GetStatus().GetSecretEnvFromReferences())
+
+	configMap := &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-redis-quarkus-config", Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "redis://redis."+ns+":6379", configMap.Data["quarkus.redis.hosts"])
+	assert.Equal(t, "1", configMap.Data["quarkus.redis.database"])
+
+	credential := &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kogito-redis-quarkus-credential", Namespace: ns}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(credential)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "secret", string(credential.Data["QUARKUS_REDIS_PASSWORD"]))
+}
+
+func TestProviderInfraReconciler_ResourceNotReady(t *testing.T) {
+	ns := t.Name()
+	instance := createFakeRedisInfra(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, createFakeRedisProvider(ns, redisProviderDefinition), createFakeRedis(ns, "Pending")).Build()
+	handler := NewReconcilerHandler(operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()})
+
+	reconciler, err := handler.GetInfraReconciler(instance)
+	assert.NoError(t, err)
+	err = reconciler.Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, api.ResourceNotReady, reasonForError(err))
+}
+
+func TestProviderInfraReconciler_MissingSecret(t *testing.T) {
+	ns := t.Name()
+	instance := createFakeRedisInfra(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, createFakeRedisProvider(ns, redisProviderDefinition), createFakeRedis(ns, "Ready")).Build()
+	handler := NewReconcilerHandler(operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()})
+
+	reconciler, err := handler.GetInfraReconciler(instance)
+	assert.NoError(t, err)
+	err = reconciler.Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, api.ResourceNotFound, reasonForError(err))
+}
+
+func TestGetInfraReconciler_NoProvider(t *testing.T) {
+	ns := t.Name()
+	instance := createFakeRedisInfra(ns)
+	invalidProvider := createFakeRedisProvider(ns, "kind: Redis")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, invalidProvider).Build()
+	handler := NewReconcilerHandler(operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()})
+
+	_, err := handler.GetInfraReconciler(instance)
+	assert.Error(t, err)
+	assert.Equal(t, api.UnsupportedAPIKind, reasonForError(err))
+}
+
+func TestProviderInfraReconciler_SecretOutOfInfraNamespace(t *testing.T) {
+	ns := t.Name()
+	instance := createFakeRedisInfra(ns)
+	secret := &v1.Secret{
+		ObjectMeta: v12.ObjectMeta{Name: "redis-secret", Namespace: "other"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+	definition := strings.ReplaceAll(redisProviderDefinition, `{{ secret "redis-secret" "password" }}`, `{{ secret "redis-secret" "password" "other" }}`)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, secret, createFakeRedisProvider(ns, definition), createFakeRedis(ns, "Ready")).Build()
+	handler := NewReconcilerHandler(operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()})
+
+	reconciler, err := handler.GetInfraReconciler(instance)
+	assert.NoError(t, err)
+	err = reconciler.Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, api.ResourceConfigError, reasonForError(err))
+
+	credential := &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kogito-redis-quarkus-credential", Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(credential)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestGetInfraReconciler_OperatorNamespaceProvider(t *testing.T) {
+	ns := t.Name()
+	operatorNamespace := "kogito-operator-system"
+	_ = os.Setenv("OPERATOR_NAMESPACE", operatorNamespace)
+	defer os.Unsetenv("OPERATOR_NAMESPACE")
+	instance := createFakeRedisInfra(ns)
+	provider := createFakeRedisProvider(operatorNamespace, redisProviderDefinition)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, provider, createFakeRedis(ns, "Ready")).Build()
+	handler := NewReconcilerHandler(operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()})
+
+	reconciler, err := handler.GetInfraReconciler(instance)
+	assert.NoError(t, err)
+	assert.IsType(t, &providerInfraReconciler{}, reconciler)
+	assert.True(t, IsInfraProviderFor(instance, provider))
+	assert.False(t, IsInfraProviderFor(instance, createFakeRedisProvider("other", redisProviderDefinition)))
+}
+
+func TestIsProvidedResourceOf(t *testing.T) {
+	ns := t.Name()
+	instance := createFakeRedisInfra(ns)
+	assert.True(t, IsProvidedResourceOf(instance, createFakeRedis(ns, "Ready")))
+	assert.False(t, IsProvidedResourceOf(instance, createFakeRedis("other", "Ready")))
+
+	otherRedis := createFakeRedis(ns, "Ready")
+	otherRedis.SetName("other-redis")
+	assert.False(t, IsProvidedResourceOf(instance, otherRedis))
+}
+
+func TestIsInfraProviderFor_InvalidProvider(t *testing.T) {
+	ns := t.Name()
+	instance := createFakeRedisInfra(ns)
+	otherInstance := createFakeRedisInfra(ns)
+	otherInstance.Name = "other-redis"
+	provider := createFakeRedisProvider(ns, redisProviderDefinition)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, provider).Build()
+	handler := NewReconcilerHandler(operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()})
+	_, err := handler.GetInfraReconciler(instance)
+	assert.NoError(t, err)
+	defer ForgetInfraProvider(types.NamespacedName{Name: instance.Name, Namespace: ns})
+
+	invalidProvider := createFakeRedisProvider(ns, "kind: Redis")
+	assert.True(t, IsInfraProviderFor(instance, invalidProvider))
+	assert.False(t, IsInfraProviderFor(otherInstance, invalidProvider))
+	invalidProvider.Name = "other-provider"
+	assert.False(t, IsInfraProviderFor(instance, invalidProvider))
+}
+
+func TestCheckProvidedResourceAccess(t *testing.T) {
+	ns := t.Name()
+	instance := createFakeRedisInfra(ns)
+	cli := test.NewFakeClientBuilder().Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+	err := CheckProvidedResourceAccess(context, instance)
+	assert.Error(t, err)
+	assert.Equal(t, api.ResourceAPINotFound, reasonForError(err))
+
+	discovery := cli.Discovery.(*discfake.FakeDiscovery)
+	discovery.Resources = append(discovery.Resources, &v12.APIResourceList{
+		GroupVersion: "redis.redis.opstreelabs.in/v1beta1",
+		APIResources: []v12.APIResource{{Name: "redis", Kind: "Redis"}, {Name: "redis/status", Kind: "Redis"}},
+	})
+	resource, err := resolveGroupVersionResource(context, "redis.redis.opstreelabs.in/v1beta1", "Redis")
+	assert.NoError(t, err)
+	assert.Equal(t, "redis.redis.opstreelabs.in/v1beta1, Resource=redis", resource.String())
+}
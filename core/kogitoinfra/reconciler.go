@@ -19,7 +19,9 @@ import (
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sort"
 	"strings"
 	"time"
 )
@@ -63,8 +65,21 @@ func (k *reconcilerHandler) GetInfraReconciler(instance api.KogitoInfraInterface
 		Context:  k.Context,
 		instance: instance,
 	}
-	if infraRes, ok := getSupportedInfraResources(context)[resourceClassForInstance(instance.GetSpec().GetResource())]; ok {
-		return infraRes, nil
+	if !instance.GetSpec().IsExternalEmpty() {
+		if !instance.GetSpec().IsResourceEmpty() {
+			return nil, errorForResourceConfigError(instance, "Resource and external infrastructure can't be both defined")
+		}
+		return initExternalInfraReconciler(context), nil
+	}
+	if initInfraReconciler, ok := getSupportedInfraResources()[resourceClassForInstance(instance.GetSpec().GetResource())]; ok {
+		return initInfraReconciler(context), nil
+	}
+	provider, err := fetchInfraProvider(k.Context, instance.GetNamespace(), instance.GetSpec().GetResource())
+	if err != nil {
+		return nil, err
+	} else if provider != nil {
+		resolvedInfraProviders.Store(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}, provider.configMap)
+		return initProviderInfraReconciler(context, provider), nil
 	}
 	return nil, errorForUnsupportedAPI(context)
 }
@@ -107,14 +122,35 @@ func getResourceClass(kind, APIVersion string) string {
 	return strings.ToLower(fmt.Sprintf("%s.%s", kind, APIVersion))
 }
 
-func getSupportedInfraResources(context infraContext) map[string]Reconciler {
-	return map[string]Reconciler{
-		getResourceClass(infrastructure.InfinispanKind, infrastructure.InfinispanAPIVersion):                 initInfinispanInfraReconciler(context),
-		getResourceClass(infrastructure.KafkaKind, infrastructure.KafkaAPIVersion):                           initKafkaInfraReconciler(context),
-		getResourceClass(infrastructure.KeycloakKind, infrastructure.KeycloakAPIVersion):                     initkeycloakInfraReconciler(context),
-		getResourceClass(infrastructure.KnativeEventingBrokerKind, infrastructure.KnativeEventingAPIVersion): initknativeInfraReconciler(context),
-		getResourceClass(infrastructure.MongoDBKind, infrastructure.MongoDBAPIVersion):                       initMongoDBInfraReconciler(context),
+// infraReconcilerInitializer creates the Reconciler in charge of a supported infrastructure resource
+type infraReconcilerInitializer func(context infraContext) Reconciler
+
+func getSupportedInfraResources() map[string]infraReconcilerInitializer {
+	return map[string]infraReconcilerInitializer{
+		getResourceClass(infrastructure.InfinispanKind, infrastructure.InfinispanAPIVersion):                 initInfinispanInfraReconciler,
+		getResourceClass(infrastructure.KafkaKind, infrastructure.KafkaAPIVersion):                           initKafkaInfraReconciler,
+		getResourceClass(infrastructure.KeycloakKind, infrastructure.KeycloakAPIVersion):                     initkeycloakInfraReconciler,
+		getResourceClass(infrastructure.KnativeEventingBrokerKind, infrastructure.KnativeEventingAPIVersion): initknativeInfraReconciler,
+		getResourceClass(infrastructure.MongoDBKind, infrastructure.MongoDBAPIVersion):                       initMongoDBInfraReconciler,
+		getResourceClass(infrastructure.PostgreSQLKind, infrastructure.PostgreSQLAPIVersion):                 initPostgreSQLInfraReconciler,
+	}
+}
+
+// IsResourceSupported checks if the given kind and apiVersion are handled by one of the KogitoInfra reconcilers
+func IsResourceSupported(kind, apiVersion string) bool {
+	_, ok := getSupportedInfraResources()[getResourceClass(kind, apiVersion)]
+	return ok
+}
+
+// GetSupportedResources gets the sorted list of resource classes (kind.apiVersion) supported by KogitoInfra
+func GetSupportedResources() []string {
+	res := getSupportedInfraResources()
+	keys := make([]string, 0, len(res))
+	for k := range res {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
+	return keys
 }
 
 func (k *reconcilerHandler) GetReconcileResultFor(err error, requeue bool) (reconcile.Result, error) {
@@ -17,6 +17,7 @@ package kogitoinfra
 import (
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/manager"
+	"github.com/kiegroup/kogito-operator/core/metrics"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -57,6 +58,7 @@ func (s *statusHandler) UpdateBaseStatus(instance api.KogitoInfraInterface, err
 		s.setResourceSuccess(instance.GetStatus().GetConditions())
 		s.Log.Info("Kogito Infra successfully reconciled")
 	}
+	s.recordMetrics(instance, *err)
 
 	if s.isStatusChanged(instance) {
 		s.Log.Info("Updating kogitoInfra value with new properties.")
@@ -67,6 +69,23 @@ func (s *statusHandler) UpdateBaseStatus(instance api.KogitoInfraInterface, err
 	}
 }
 
+// recordMetrics counts the reconciliation of the given instance and reports its readiness
+func (s *statusHandler) recordMetrics(instance api.KogitoInfraInterface, err error) {
+	result := metrics.ReconcileSuccess
+	if err != nil {
+		result = metrics.ReconcileFailure
+	}
+	metrics.RecordReconcile(metrics.GetKind(instance, s.Scheme), result, string(reasonForError(err)))
+	resourceKind := ""
+	if !instance.GetSpec().IsResourceEmpty() {
+		resourceKind = instance.GetSpec().GetResource().GetKind()
+	} else if !instance.GetSpec().IsExternalEmpty() {
+		resourceKind = string(instance.GetSpec().GetExternal().GetKind())
+	}
+	ready := meta.IsStatusConditionTrue(*instance.GetStatus().GetConditions(), string(api.KogitoInfraConfigured))
+	metrics.SetKogitoInfraReady(instance.GetNamespace(), instance.GetName(), resourceKind, ready)
+}
+
 func (s *statusHandler) isStatusChanged(instance api.KogitoInfraInterface) bool {
 	deployedInstance, resultErr := s.infraHandler.FetchKogitoInfraInstance(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	if resultErr != nil {
@@ -0,0 +1,73 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoinfra
+
+import (
+	"bytes"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const (
+	// trustStoreCACertKey is the key holding the CA certificate in the generated truststore Secrets
+	trustStoreCACertKey = "ca.crt"
+	// trustStoreKey is the key holding the PKCS12 truststore in the generated truststore Secrets
+	trustStoreKey = "truststore.p12"
+)
+
+// reconcileTrustStoreSecret creates or updates the Secret with the given name holding a PKCS12 truststore built from the given CA certificate.
+// The PKCS12 encoding is salted, the truststore is only generated again when the CA certificate changes.
+func reconcileTrustStoreSecret(context infraContext, trustStoreSecretName string, caCert []byte) error {
+	secretHandler := infrastructure.NewSecretHandler(context.Context)
+	deployedSecret, err := secretHandler.FetchSecret(types.NamespacedName{Name: trustStoreSecretName, Namespace: context.instance.GetNamespace()})
+	if err != nil {
+		return err
+	}
+	if deployedSecret != nil && bytes.Equal(deployedSecret.Data[trustStoreCACertKey], caCert) {
+		return nil
+	}
+	caSecret := &v12.Secret{Data: map[string][]byte{trustStoreCACertKey: caCert}}
+	trustStore, err := framework.CreatePKCS12TrustStoreFromSecret(caSecret, pkcs12.DefaultPassword, trustStoreCACertKey)
+	if err != nil {
+		return err
+	}
+	trustStoreData := map[string][]byte{
+		trustStoreKey:       trustStore,
+		trustStoreCACertKey: caCert,
+	}
+	if deployedSecret != nil {
+		context.Log.Info("CA certificate changed, updating truststore", "secret", trustStoreSecretName)
+		deployedSecret.Data = trustStoreData
+		return kubernetes.ResourceC(context.Client).Update(deployedSecret)
+	}
+	trustStoreSecret := &v12.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      trustStoreSecretName,
+			Namespace: context.instance.GetNamespace(),
+		},
+		Type: v12.SecretTypeOpaque,
+		Data: trustStoreData,
+	}
+	if err := framework.SetOwner(context.instance, context.Scheme, trustStoreSecret); err != nil {
+		return err
+	}
+	return kubernetes.ResourceC(context.Client).Create(trustStoreSecret)
+}
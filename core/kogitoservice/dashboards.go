@@ -99,17 +99,21 @@ func (d *grafanaDashboardManager) fetchGrafanaDashboards(instance api.KogitoServ
 
 	kogitoServiceHandler := NewKogitoServiceHandler(d.Context)
 	svcURL := kogitoServiceHandler.GetKogitoServiceEndpoint(instance)
-	dashboardNames, err := d.fetchGrafanaDashboardNamesForURL(svcURL)
+	httpClient, err := kogitoServiceHandler.GetKogitoServiceHTTPClient(instance)
+	if err != nil {
+		return nil, err
+	}
+	dashboardNames, err := d.fetchGrafanaDashboardNamesForURL(httpClient, svcURL)
 	if err != nil {
 		return nil, err
 	}
 
-	return d.fetchDashboards(svcURL, dashboardNames)
+	return d.fetchDashboards(httpClient, svcURL, dashboardNames)
 }
 
-func (d *grafanaDashboardManager) fetchGrafanaDashboardNamesForURL(serverURL string) ([]string, error) {
+func (d *grafanaDashboardManager) fetchGrafanaDashboardNamesForURL(httpClient *http.Client, serverURL string) ([]string, error) {
 	dashboardsURL := fmt.Sprintf("%s%s%s", serverURL, dashboardsPath, "list.json")
-	resp, err := http.Get(dashboardsURL)
+	resp, err := httpClient.Get(dashboardsURL)
 	if err != nil {
 		return nil, err
 	}
@@ -129,11 +133,11 @@ func (d *grafanaDashboardManager) fetchGrafanaDashboardNamesForURL(serverURL str
 	return dashboardNames, nil
 }
 
-func (d *grafanaDashboardManager) fetchDashboards(serverURL string, dashboardNames []string) ([]GrafanaDashboard, error) {
+func (d *grafanaDashboardManager) fetchDashboards(httpClient *http.Client, serverURL string, dashboardNames []string) ([]GrafanaDashboard, error) {
 	var dashboards []GrafanaDashboard
 	for _, name := range dashboardNames {
 		dashboardURL := fmt.Sprintf("%s%s%s", serverURL, dashboardsPath, name)
-		if dashboard, err := d.fetchDashboard(name, dashboardURL); err != nil {
+		if dashboard, err := d.fetchDashboard(httpClient, name, dashboardURL); err != nil {
 			return nil, err
 		} else if dashboard != nil {
 			dashboards = append(dashboards, *dashboard)
@@ -143,8 +147,8 @@ func (d *grafanaDashboardManager) fetchDashboards(serverURL string, dashboardNam
 }
 
 // we create a separate function to be able to `defer` the HTTP response after the function call.
-func (d *grafanaDashboardManager) fetchDashboard(name, dashboardURL string) (*GrafanaDashboard, error) {
-	resp, err := http.Get(dashboardURL)
+func (d *grafanaDashboardManager) fetchDashboard(httpClient *http.Client, name, dashboardURL string) (*GrafanaDashboard, error) {
+	resp, err := httpClient.Get(dashboardURL)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@
 package kogitoservice
 
 import (
+	"net/http"
 	"testing"
 
 	grafanav1 "github.com/kiegroup/kogito-operator/core/infrastructure/grafana/v1alpha1"
@@ -38,7 +39,7 @@ func Test_fetchDashboardNames(t *testing.T) {
 		Scheme: meta.GetRegisteredSchema(),
 	}
 	dashboardManager := grafanaDashboardManager{Context: context}
-	dashboards, err := dashboardManager.fetchGrafanaDashboardNamesForURL(server.URL)
+	dashboards, err := dashboardManager.fetchGrafanaDashboardNamesForURL(http.DefaultClient, server.URL)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, dashboards)
 	assert.Equal(t, "dashboard1.json", dashboards[0])
@@ -74,9 +75,9 @@ func Test_fetchDashboards(t *testing.T) {
 		Scheme: meta.GetRegisteredSchema(),
 	}
 	dashboardManager := grafanaDashboardManager{Context: context}
-	fetchedDashboardNames, err := dashboardManager.fetchGrafanaDashboardNamesForURL(server.URL)
+	fetchedDashboardNames, err := dashboardManager.fetchGrafanaDashboardNamesForURL(http.DefaultClient, server.URL)
 	assert.NoError(t, err)
-	dashboards, err := dashboardManager.fetchDashboards(server.URL, fetchedDashboardNames)
+	dashboards, err := dashboardManager.fetchDashboards(http.DefaultClient, server.URL, fetchedDashboardNames)
 	assert.NoError(t, err)
 	assert.Equal(t, len(fetchedDashboardNames), len(dashboards))
 	assert.Equal(t, dashboard1, dashboards[0].RawJSONDashboard)
@@ -23,6 +23,7 @@ import (
 	"github.com/kiegroup/kogito-operator/core/record"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	controller "sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -32,12 +33,17 @@ type ServiceDefinition struct {
 	// DefaultImageName is the name of the default image distributed for Kogito, e.g. kogito-jobs-service, kogito-data-index and so on
 	// can be empty, in this case Request.Name will be used as image name
 	DefaultImageName string
+	// PersistenceImageNames are the images to use instead of DefaultImageName for each persistence backend.
+	// The image is selected according to the KogitoInfra resources bound to the service.
+	PersistenceImageNames map[api.PersistenceBackendType]string
 	// DefaultImageTag is the default image tag to use for this service. If left empty, will use the minor version of the operator, e.g. 0.11
 	DefaultImageTag string
 	// Request made for the service
 	Request controller.Request
 	// OnDeploymentCreate applies custom deployment configuration in the required Deployment resource
 	OnDeploymentCreate func(deployment *appsv1.Deployment) error
+	// OnNetworkPolicyCreate allows the connections between the service and the other Kogito services in the required NetworkPolicy resource
+	OnNetworkPolicyCreate func(networkPolicy *networkingv1.NetworkPolicy) error
 	// SingleReplica if set to true, avoids that the service has more than one pod replica
 	SingleReplica bool
 	// KafkaTopics is a collection of Kafka Topics to be created within the service
@@ -51,6 +57,8 @@ type ServiceDefinition struct {
 	SecretEnvFromReferences    []string
 	SecretVolumeReferences     []api.VolumeReferenceInterface
 	Envs                       []v1.EnvVar
+	// PodAnnotations are added to the pods of the service, changing them rolls out the pods
+	PodAnnotations map[string]string
 }
 
 const (
@@ -117,11 +125,27 @@ func (s *serviceDeployer) Deploy() error {
 		return err
 	}
 
+	// the Service is created first, the OpenShift service CA issues the serving certificate requested through its annotation
+	serviceReconciler := newServiceReconciler(s.Context, s.instance)
+	if err = serviceReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	tlsReconciler := newTLSReconciler(s.Context, s.instance, &s.definition)
+	if err = tlsReconciler.Reconcile(); err != nil {
+		return err
+	}
+
 	trustStoreReconciler := newTrustStoreReconciler(s.Context, s.instance, &s.definition)
 	if err = trustStoreReconciler.Reconcile(); err != nil {
 		return err
 	}
 
+	volumeReconciler := newVolumeReconciler(s.Context, s.instance)
+	if err = volumeReconciler.Reconcile(); err != nil {
+		return err
+	}
+
 	kogitoInfraReconciler := newKogitoInfraReconciler(s.Context, s.instance, &s.definition, s.infraHandler)
 	if err = kogitoInfraReconciler.Reconcile(); err != nil {
 		return err
@@ -132,13 +156,28 @@ func (s *serviceDeployer) Deploy() error {
 		return err
 	}
 
+	knativeServiceReconciler := newKnativeServiceReconciler(s.Context, s.instance, s.definition, imageHandler)
+	if err = knativeServiceReconciler.Reconcile(); err != nil {
+		return err
+	}
+
 	deploymentReconciler := newDeploymentReconciler(s.Context, s.instance, s.definition, imageHandler)
 	if err = deploymentReconciler.Reconcile(); err != nil {
 		return err
 	}
 
-	serviceReconciler := newServiceReconciler(s.Context, s.instance)
-	if err = serviceReconciler.Reconcile(); err != nil {
+	hpaReconciler := newHorizontalPodAutoscalerReconciler(s.Context, s.instance, s.definition)
+	if err = hpaReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	pdbReconciler := newPodDisruptionBudgetReconciler(s.Context, s.instance, s.definition)
+	if err = pdbReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	networkPolicyReconciler := newNetworkPolicyReconciler(s.Context, s.instance, s.definition, s.infraHandler)
+	if err = networkPolicyReconciler.Reconcile(); err != nil {
 		return err
 	}
 
@@ -147,6 +186,26 @@ func (s *serviceDeployer) Deploy() error {
 		s.Log.Info("Error occurs while reconciling route", "err", err)
 	}
 
+	virtualServiceReconciler := newVirtualServiceReconciler(s.Context, s.instance)
+	if err = virtualServiceReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	destinationRuleReconciler := newDestinationRuleReconciler(s.Context, s.instance)
+	if err = destinationRuleReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	gatewayReconciler := newGatewayReconciler(s.Context, s.instance)
+	if err = gatewayReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	ingressReconciler := newIngressReconciler(s.Context, s.instance)
+	if err = ingressReconciler.Reconcile(); err != nil {
+		s.Log.Info("Error occurs while reconciling ingress", "err", err)
+	}
+
 	err = s.configureMonitoring()
 	if err != nil {
 		return err
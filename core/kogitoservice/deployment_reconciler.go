@@ -39,6 +39,7 @@ type deploymentReconciler struct {
 	imageHandler            infrastructure.ImageHandler
 	kogitoDeploymentHandler KogitoDeploymentHandler
 	deploymentHandler       infrastructure.DeploymentHandler
+	rolloutHandler          RolloutHandler
 	deltaProcessor          infrastructure.DeltaProcessor
 }
 
@@ -50,6 +51,7 @@ func newDeploymentReconciler(context operator.Context, instance api.KogitoServic
 		definition:              definition,
 		kogitoDeploymentHandler: NewKogitoDeploymentHandler(context),
 		deploymentHandler:       infrastructure.NewDeploymentHandler(context),
+		rolloutHandler:          NewRolloutHandler(context),
 		deltaProcessor:          infrastructure.NewDeltaProcessor(context),
 	}
 }
@@ -64,14 +66,14 @@ func (d *deploymentReconciler) Reconcile() error {
 		return infrastructure.ErrorForImageNotFound()
 	}
 
-	// Create Required resource
-	requestedResources, err := d.createRequiredResources(imageName)
+	// Get Deployed resource
+	deployedResources, err := d.getDeployedResources()
 	if err != nil {
 		return err
 	}
 
-	// Get Deployed resource
-	deployedResources, err := d.getDeployedResources()
+	// Create Required resource
+	requestedResources, err := d.createRequiredResources(imageName, deployedResources)
 	if err != nil {
 		return err
 	}
@@ -84,26 +86,89 @@ func (d *deploymentReconciler) Reconcile() error {
 	return nil
 }
 
-func (d *deploymentReconciler) createRequiredResources(imageName string) (map[reflect.Type][]client.Object, error) {
+func (d *deploymentReconciler) createRequiredResources(imageName string, deployedResources map[reflect.Type][]client.Object) (map[reflect.Type][]client.Object, error) {
 	resources := make(map[reflect.Type][]client.Object)
+	if isKnativeServiceMode(d.instance) {
+		d.Log.Debug("Skipping Deployment creation. The service is deployed as a Knative Service.")
+		return resources, nil
+	}
+	deployed, candidateDeployed := d.getDeployedDeployments(deployedResources)
+	holdImage, keepCandidate := d.resolveRollout(imageName, deployed, candidateDeployed)
+
+	deploymentImage := imageName
+	if holdImage {
+		deploymentImage = deployed.Spec.Template.Spec.Containers[0].Image
+	}
+	deployment, err := d.createDeployment(deploymentImage)
+	if err != nil {
+		return resources, err
+	}
+	if holdImage && d.Client.IsOpenshift() {
+		// pauses the image stream trigger, otherwise the new image pushed to the tag would be set to the current Deployment
+		key, value := d.imageHandler.ResolvePausedImageStreamTriggerAnnotation(d.instance.GetName())
+		deployment.Annotations[key] = value
+	}
+	resources[reflect.TypeOf(appsv1.Deployment{})] = []client.Object{deployment}
+
+	if keepCandidate {
+		candidate, err := d.createDeployment(imageName)
+		if err != nil {
+			return resources, err
+		}
+		toCandidate(d.instance, candidate)
+		resources[reflect.TypeOf(appsv1.Deployment{})] = append(resources[reflect.TypeOf(appsv1.Deployment{})], candidate)
+	}
+	return resources, nil
+}
+
+// resolveRollout decides whether the current Deployment keeps its deployed image and whether a candidate Deployment runs the new one.
+// The new image is held until it's promoted, a later image is held again until promoted in turn.
+// The candidate is kept after the promotion until the current Deployment is rolled out with the new image, serving the traffic meanwhile.
+func (d *deploymentReconciler) resolveRollout(imageName string, deployed, candidateDeployed *appsv1.Deployment) (holdImage, keepCandidate bool) {
+	rollout := d.rolloutHandler.GetRollout(d.instance)
+	if rollout == nil || deployed == nil || len(deployed.Spec.Template.Spec.Containers) == 0 {
+		return false, false
+	}
+	if rollout.GetPromotedImage() != imageName {
+		holdImage = deployed.Spec.Template.Spec.Containers[0].Image != imageName
+		return holdImage, holdImage
+	}
+	return false, candidateDeployed != nil && !isRolledOut(deployed, imageName)
+}
+
+func (d *deploymentReconciler) getDeployedDeployments(deployedResources map[reflect.Type][]client.Object) (deployed, candidateDeployed *appsv1.Deployment) {
+	for _, resource := range deployedResources[reflect.TypeOf(appsv1.Deployment{})] {
+		if resource.GetName() == d.instance.GetName() {
+			deployed = resource.(*appsv1.Deployment)
+		} else {
+			candidateDeployed = resource.(*appsv1.Deployment)
+		}
+	}
+	return
+}
+
+func (d *deploymentReconciler) createDeployment(imageName string) (*appsv1.Deployment, error) {
 	deployment := d.kogitoDeploymentHandler.CreateDeployment(d.instance, imageName, d.definition)
 	if err := d.onDeploymentCreate(deployment); err != nil {
-		return resources, err
+		return nil, err
 	}
 
 	d.mountEnvsOnDeployment(deployment)
 	if err := d.mountConfigMapReferencesOnDeployment(deployment); err != nil {
-		return resources, err
+		return nil, err
 	}
 	if err := d.mountSecretReferencesOnDeployment(deployment); err != nil {
-		return resources, err
+		return nil, err
 	}
+	d.mountVolumesOnDeployment(deployment)
 	d.mountMeteringLabelsOnDeployment(deployment)
+	if err := d.patchPodTemplate(deployment); err != nil {
+		return nil, err
+	}
 	if err := framework.SetOwner(d.instance, d.Scheme, deployment); err != nil {
 		return nil, err
 	}
-	resources[reflect.TypeOf(appsv1.Deployment{})] = []client.Object{deployment}
-	return resources, nil
+	return deployment, nil
 }
 
 func (d *deploymentReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
@@ -115,6 +180,13 @@ func (d *deploymentReconciler) getDeployedResources() (map[reflect.Type][]client
 	if deployment != nil {
 		resources[reflect.TypeOf(appsv1.Deployment{})] = []client.Object{deployment}
 	}
+	candidate, err := d.rolloutHandler.FetchCandidateDeployment(d.instance)
+	if err != nil {
+		return nil, err
+	}
+	if candidate != nil {
+		resources[reflect.TypeOf(appsv1.Deployment{})] = append(resources[reflect.TypeOf(appsv1.Deployment{})], candidate)
+	}
 	return resources, nil
 }
 
@@ -165,11 +237,31 @@ func (d *deploymentReconciler) mountSecretReferencesOnDeployment(deployment *app
 	return nil
 }
 
+func (d *deploymentReconciler) mountVolumesOnDeployment(deployment *appsv1.Deployment) {
+	volumeHandler := infrastructure.NewVolumeHandler(d.Context)
+	for _, volume := range d.instance.GetSpec().GetVolumes() {
+		volumeHandler.MountAsVolume(deployment, d.instance, volume)
+	}
+}
+
 func (d *deploymentReconciler) mountEnvsOnDeployment(deployment *appsv1.Deployment) {
 	deployment.Spec.Template.Spec.Containers[0].Env = framework.EnvOverride(deployment.Spec.Template.Spec.Containers[0].Env, framework.CreateEnvVar(infrastructure.RuntimeTypeKey, string(d.instance.GetSpec().GetRuntime())))
 	deployment.Spec.Template.Spec.Containers[0].Env = framework.EnvOverride(deployment.Spec.Template.Spec.Containers[0].Env, d.definition.Envs...)
 }
 
+// patchPodTemplate applies the pod template overrides of the service on top of the rendered pods.
+// The label selecting the pods of the Deployment can't be overridden.
+func (d *deploymentReconciler) patchPodTemplate(deployment *appsv1.Deployment) error {
+	if err := framework.PatchPodTemplate(&deployment.Spec.Template, d.instance.GetSpec().GetPodTemplate()); err != nil {
+		return err
+	}
+	if deployment.Spec.Template.Labels == nil {
+		deployment.Spec.Template.Labels = map[string]string{}
+	}
+	deployment.Spec.Template.Labels[framework.LabelAppKey] = d.instance.GetName()
+	return nil
+}
+
 func (d *deploymentReconciler) mountMeteringLabelsOnDeployment(deployment *appsv1.Deployment) {
 	util.AppendToStringMap(d.Labels, deployment.Spec.Template.Labels)
 }
@@ -16,13 +16,17 @@ package kogitoservice
 
 import (
 	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/test"
 	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/apps/v1"
+	v12 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"testing"
 )
@@ -51,3 +55,75 @@ func TestDeploymentReconciler(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, exists)
 }
+
+func TestDeploymentReconciler_PodTemplate(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.PodTemplate = &v12.PodTemplateSpec{
+		ObjectMeta: v13.ObjectMeta{Labels: map[string]string{framework.LabelAppKey: "other"}},
+		Spec: v12.PodSpec{
+			Containers:        []v12.Container{{Name: "log-forwarder", Image: "fluent-bit"}},
+			PriorityClassName: "high",
+		},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	image := &api.Image{
+		Name: "test-image",
+		Tag:  "1.0",
+	}
+	imageHandler := infrastructure.NewImageHandler(context, image, "default-image", "image-stream", ns, false, false)
+	deploymentReconciler := newDeploymentReconciler(context, instance, ServiceDefinition{}, imageHandler)
+	assert.NoError(t, deploymentReconciler.Reconcile())
+
+	deployment := &v1.Deployment{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Len(t, deployment.Spec.Template.Spec.Containers, 2)
+	assert.Equal(t, instance.Name, deployment.Spec.Template.Spec.Containers[0].Name)
+	assert.Equal(t, "log-forwarder", deployment.Spec.Template.Spec.Containers[1].Name)
+	assert.Equal(t, "high", deployment.Spec.Template.Spec.PriorityClassName)
+	assert.Equal(t, instance.Name, deployment.Spec.Template.Labels[framework.LabelAppKey])
+}
+
+func TestDeploymentReconciler_Volumes(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Volumes = []v1beta1.Volume{
+		{Name: "processes", MountPath: "/home/kogito/processes", ClaimTemplate: &v1beta1.VolumeClaimTemplate{Size: resource.MustParse("1Gi")}},
+		{Name: "models", MountPath: "/home/kogito/models", ClaimName: "dmn-models", ReadOnly: true},
+		{Name: "cache", MountPath: "/tmp/cache", EmptyDir: &v12.EmptyDirVolumeSource{}},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	image := &api.Image{
+		Name: "test-image",
+		Tag:  "1.0",
+	}
+	imageHandler := infrastructure.NewImageHandler(context, image, "default-image", "image-stream", ns, false, false)
+	deploymentReconciler := newDeploymentReconciler(context, instance, ServiceDefinition{}, imageHandler)
+	assert.NoError(t, deploymentReconciler.Reconcile())
+
+	deployment := &v1.Deployment{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	volumes := map[string]v12.Volume{}
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		volumes[volume.Name] = volume
+	}
+	assert.Equal(t, instance.Name+"-processes", volumes["processes"].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "dmn-models", volumes["models"].PersistentVolumeClaim.ClaimName)
+	assert.True(t, volumes["models"].PersistentVolumeClaim.ReadOnly)
+	assert.NotNil(t, volumes["cache"].EmptyDir)
+	assert.Contains(t, deployment.Spec.Template.Spec.Containers[0].VolumeMounts, v12.VolumeMount{Name: "cache", MountPath: "/tmp/cache"})
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DestinationRuleReconciler ...
+type DestinationRuleReconciler interface {
+	Reconcile() error
+}
+
+type destinationRuleReconciler struct {
+	operator.Context
+	instance               api.KogitoService
+	destinationRuleHandler infrastructure.DestinationRuleHandler
+	rolloutHandler         RolloutHandler
+	deltaProcessor         infrastructure.DeltaProcessor
+}
+
+func newDestinationRuleReconciler(context operator.Context, instance api.KogitoService) DestinationRuleReconciler {
+	return &destinationRuleReconciler{
+		Context:                context,
+		instance:               instance,
+		destinationRuleHandler: infrastructure.NewDestinationRuleHandler(context),
+		rolloutHandler:         NewRolloutHandler(context),
+		deltaProcessor:         infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+// Reconcile applies the traffic policy of the service to its connections within the mesh,
+// including the ones to the candidate Deployment while a new image is rolled out.
+func (d *destinationRuleReconciler) Reconcile() error {
+	if !isIstioInstalled(d.Context) {
+		d.Log.Debug("Skipping DestinationRule creation. Istio is not installed in the cluster.")
+		return nil
+	}
+
+	// Create Required resource
+	requestedResources, err := d.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := d.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	if err = d.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *destinationRuleReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if !d.instance.GetSpec().IsEnableIstio() {
+		return resources, nil
+	}
+	if isKnativeServiceMode(d.instance) {
+		d.Log.Debug("Skipping DestinationRule creation. Knative routes the traffic of the service.")
+		return resources, nil
+	}
+	hosts := []string{d.instance.GetName()}
+	rolloutStatus, err := d.rolloutHandler.FetchRolloutStatus(d.instance)
+	if err != nil {
+		return nil, err
+	}
+	if rolloutStatus.IsInProgress() {
+		hosts = append(hosts, getCandidateName(d.instance))
+	}
+	for _, host := range hosts {
+		destinationRule := d.destinationRuleHandler.CreateDestinationRule(d.instance, host)
+		if err := framework.SetOwner(d.instance, d.Scheme, destinationRule); err != nil {
+			return nil, err
+		}
+		resources[reflect.TypeOf(istiov1beta1.DestinationRule{})] = append(resources[reflect.TypeOf(istiov1beta1.DestinationRule{})], destinationRule)
+	}
+	return resources, nil
+}
+
+func (d *destinationRuleReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	for _, host := range []string{d.instance.GetName(), getCandidateName(d.instance)} {
+		destinationRule, err := d.destinationRuleHandler.FetchDestinationRule(types.NamespacedName{Name: host, Namespace: d.instance.GetNamespace()})
+		if err != nil {
+			return nil, err
+		}
+		if destinationRule != nil {
+			resources[reflect.TypeOf(istiov1beta1.DestinationRule{})] = append(resources[reflect.TypeOf(istiov1beta1.DestinationRule{})], destinationRule)
+		}
+	}
+	return resources, nil
+}
+
+func (d *destinationRuleReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	excludeNotControlledResources(d.Context, d.instance, requestedResources, deployedResources)
+	comparator := d.destinationRuleHandler.GetComparator()
+	_, err = d.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
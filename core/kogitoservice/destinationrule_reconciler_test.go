@@ -0,0 +1,82 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDestinationRuleReconciler(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.EnableIstio = true
+	instance.Spec.Istio = &v1beta1.Istio{
+		TLSMode:          api.IstioDisableTLSMode,
+		ConnectionPool:   &v1beta1.IstioConnectionPool{MaxConnections: 100},
+		OutlierDetection: &v1beta1.IstioOutlierDetection{Consecutive5xxErrors: 5, Interval: "10s"},
+	}
+	cli := test.NewFakeClientBuilder().SupportIstio().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newDestinationRuleReconciler(context, instance).Reconcile())
+
+	destinationRule := &istiov1beta1.DestinationRule{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(destinationRule)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, instance.Name, destinationRule.Spec.Host)
+	assert.Equal(t, string(api.IstioDisableTLSMode), destinationRule.Spec.TrafficPolicy.TLS.Mode)
+	assert.Equal(t, int32(100), destinationRule.Spec.TrafficPolicy.ConnectionPool.TCP.MaxConnections)
+	assert.Nil(t, destinationRule.Spec.TrafficPolicy.ConnectionPool.HTTP)
+	assert.Equal(t, int32(5), destinationRule.Spec.TrafficPolicy.OutlierDetection.Consecutive5xxErrors)
+	assert.Equal(t, "10s", destinationRule.Spec.TrafficPolicy.OutlierDetection.Interval)
+
+	// disabling Istio removes the DestinationRule
+	instance.Spec.EnableIstio = false
+	assert.NoError(t, newDestinationRuleReconciler(context, instance).Reconcile())
+	exists, err = kubernetes.ResourceC(cli).Fetch(&istiov1beta1.DestinationRule{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestDestinationRuleReconciler_IstioNotInstalled(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.EnableIstio = true
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newDestinationRuleReconciler(context, instance).Reconcile())
+
+	exists, err := kubernetes.ResourceC(cli).Fetch(&istiov1beta1.DestinationRule{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
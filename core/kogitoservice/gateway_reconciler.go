@@ -0,0 +1,109 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GatewayReconciler ...
+type GatewayReconciler interface {
+	Reconcile() error
+}
+
+type gatewayReconciler struct {
+	operator.Context
+	instance       api.KogitoService
+	gatewayHandler infrastructure.GatewayHandler
+	deltaProcessor infrastructure.DeltaProcessor
+}
+
+func newGatewayReconciler(context operator.Context, instance api.KogitoService) GatewayReconciler {
+	return &gatewayReconciler{
+		Context:        context,
+		instance:       instance,
+		gatewayHandler: infrastructure.NewGatewayHandler(context),
+		deltaProcessor: infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+func (g *gatewayReconciler) Reconcile() error {
+	if !isIstioInstalled(g.Context) {
+		g.Log.Debug("Skipping Gateway creation. Istio is not installed in the cluster.")
+		return nil
+	}
+
+	// Create Required resource
+	requestedResources, err := g.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := g.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	if err = g.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *gatewayReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if !g.instance.GetSpec().IsEnableIstio() {
+		return resources, nil
+	}
+	gateway := g.gatewayHandler.CreateGateway(g.instance)
+	if gateway == nil {
+		return resources, nil
+	}
+	if err := framework.SetOwner(g.instance, g.Scheme, gateway); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(istiov1beta1.Gateway{})] = []client.Object{gateway}
+	return resources, nil
+}
+
+func (g *gatewayReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	gateway, err := g.gatewayHandler.FetchGateway(types.NamespacedName{Name: g.instance.GetName(), Namespace: g.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if gateway != nil {
+		resources[reflect.TypeOf(istiov1beta1.Gateway{})] = []client.Object{gateway}
+	}
+	return resources, nil
+}
+
+func (g *gatewayReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	excludeNotControlledResources(g.Context, g.instance, requestedResources, deployedResources)
+	comparator := g.gatewayHandler.GetComparator()
+	_, err = g.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGatewayReconciler(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.EnableIstio = true
+	instance.Spec.Istio = &v1beta1.Istio{Gateway: &v1beta1.IstioGateway{Hosts: []string{"process.example.com"}}}
+	cli := test.NewFakeClientBuilder().SupportIstio().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newGatewayReconciler(context, instance).Reconcile())
+
+	gateway := &istiov1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(gateway)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, map[string]string{"istio": "ingressgateway"}, gateway.Spec.Selector)
+	assert.Len(t, gateway.Spec.Servers, 1)
+	assert.Equal(t, []string{"process.example.com"}, gateway.Spec.Servers[0].Hosts)
+
+	// removing the Gateway configuration removes the Gateway
+	instance.Spec.Istio.Gateway = nil
+	assert.NoError(t, newGatewayReconciler(context, instance).Reconcile())
+	exists, err = kubernetes.ResourceC(cli).Fetch(&istiov1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,137 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHorizontalPodAutoscalerReconciler_Disabled(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	hpaReconciler := newHorizontalPodAutoscalerReconciler(context, instance, ServiceDefinition{})
+	err := hpaReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	hpa := &autoscalingv2beta2.HorizontalPodAutoscaler{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(hpa)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestHorizontalPodAutoscalerReconciler(t *testing.T) {
+	ns := t.Name()
+	minReplicas := int32(2)
+	targetCPU := int32(75)
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Autoscaling = &v1beta1.Autoscaling{
+		MinReplicas:                    &minReplicas,
+		MaxReplicas:                    5,
+		TargetCPUUtilizationPercentage: &targetCPU,
+		Metrics: []v1beta1.AutoscalingMetric{
+			{Name: "http_server_requests_per_second", TargetAverageValue: resource.MustParse("100")},
+		},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	hpaReconciler := newHorizontalPodAutoscalerReconciler(context, instance, ServiceDefinition{})
+	err := hpaReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	hpa := &autoscalingv2beta2.HorizontalPodAutoscaler{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(hpa)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, instance.Name, hpa.Spec.ScaleTargetRef.Name)
+	assert.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+	assert.Equal(t, minReplicas, *hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	assert.Len(t, hpa.Spec.Metrics, 2)
+	assert.Equal(t, corev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+	assert.Equal(t, targetCPU, *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+	assert.Equal(t, "http_server_requests_per_second", hpa.Spec.Metrics[1].Pods.Metric.Name)
+
+	// autoscaling removed, HPA should be deleted
+	instance.Spec.Autoscaling = nil
+	err = hpaReconciler.Reconcile()
+	assert.NoError(t, err)
+	exists, err = kubernetes.ResourceC(cli).Fetch(hpa)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestHorizontalPodAutoscalerReconciler_SingleReplica(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Autoscaling = &v1beta1.Autoscaling{MaxReplicas: 3}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	hpaReconciler := newHorizontalPodAutoscalerReconciler(context, instance, ServiceDefinition{SingleReplica: true})
+	err := hpaReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	hpa := &autoscalingv2beta2.HorizontalPodAutoscaler{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(hpa)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestHorizontalPodAutoscalerReconciler_NotControlled(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	userHPA := &autoscalingv2beta2.HorizontalPodAutoscaler{
+		ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns},
+		Spec:       autoscalingv2beta2.HorizontalPodAutoscalerSpec{MaxReplicas: 10},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, userHPA).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newHorizontalPodAutoscalerReconciler(context, instance, ServiceDefinition{}).Reconcile())
+
+	// the HorizontalPodAutoscaler of the users is not deleted
+	hpa := &autoscalingv2beta2.HorizontalPodAutoscaler{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(hpa)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, int32(10), hpa.Spec.MaxReplicas)
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IngressReconciler ...
+type IngressReconciler interface {
+	Reconcile() error
+}
+
+type ingressReconciler struct {
+	operator.Context
+	instance       api.KogitoService
+	ingressHandler infrastructure.IngressHandler
+	deltaProcessor infrastructure.DeltaProcessor
+}
+
+func newIngressReconciler(context operator.Context, instance api.KogitoService) IngressReconciler {
+	return &ingressReconciler{
+		Context:        context,
+		instance:       instance,
+		ingressHandler: infrastructure.NewIngressHandler(context),
+		deltaProcessor: infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+func (i *ingressReconciler) Reconcile() error {
+
+	if i.Client.IsOpenshift() {
+		i.Log.Debug("Skipping ingress creation. Routes are used to expose services in Openshift env.")
+		return nil
+	}
+
+	// Create Required resource
+	requestedResources, err := i.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := i.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	if err = i.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (i *ingressReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if i.instance.GetSpec().IsRouteDisabled() {
+		i.Log.Debug("Skipping ingress creation. Routes are not enabled.")
+		return resources, nil
+	}
+	if isKnativeServiceMode(i.instance) {
+		i.Log.Debug("Skipping ingress creation. The service is exposed by Knative.")
+		return resources, nil
+	}
+	if len(i.instance.GetSpec().GetIngress().GetHost()) == 0 {
+		i.Log.Debug("Skipping ingress creation. Ingress host is not defined.")
+		return resources, nil
+	}
+	ingress := i.ingressHandler.CreateIngress(i.instance)
+	if err := framework.SetOwner(i.instance, i.Scheme, ingress); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(networkingv1.Ingress{})] = []client.Object{ingress}
+	return resources, nil
+}
+
+func (i *ingressReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	ingress, err := i.ingressHandler.FetchIngress(types.NamespacedName{Name: i.instance.GetName(), Namespace: i.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if ingress != nil {
+		resources[reflect.TypeOf(networkingv1.Ingress{})] = []client.Object{ingress}
+	}
+	return resources, nil
+}
+
+func (i *ingressReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	excludeNotControlledResources(i.Context, i.instance, requestedResources, deployedResources)
+	comparator := i.ingressHandler.GetComparator()
+	_, err = i.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
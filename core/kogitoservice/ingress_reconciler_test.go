@@ -0,0 +1,160 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestIngressReconciler_K8sWithoutHost(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	ingressReconciler := newIngressReconciler(context, instance)
+	err := ingressReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	ingress := &networkingv1.Ingress{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestIngressReconciler_K8s(t *testing.T) {
+	ns := t.Name()
+	className := "nginx"
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Ingress.Host = "my-runtime.example.com"
+	instance.Spec.Ingress.IngressClassName = &className
+	instance.Spec.Ingress.TLSSecret = "my-runtime-tls"
+	instance.Spec.Ingress.Annotations = map[string]string{"nginx.ingress.kubernetes.io/ssl-redirect": "true"}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	ingressReconciler := newIngressReconciler(context, instance)
+	err := ingressReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	ingress := &networkingv1.Ingress{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "my-runtime.example.com", ingress.Spec.Rules[0].Host)
+	assert.Equal(t, &className, ingress.Spec.IngressClassName)
+	assert.Equal(t, "my-runtime-tls", ingress.Spec.TLS[0].SecretName)
+	assert.Equal(t, "true", ingress.Annotations["nginx.ingress.kubernetes.io/ssl-redirect"])
+	assert.Equal(t, instance.Name, ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name)
+
+	uri, err := infrastructure.NewIngressHandler(context).GetURIFromIngress(types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://my-runtime.example.com", uri)
+
+	// disabling routes should remove the ingress
+	instance.Spec.DisableRoute = true
+	err = ingressReconciler.Reconcile()
+	assert.NoError(t, err)
+	exists, err = kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestIngressReconciler_KnativeServiceMode(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Ingress.Host = "my-runtime.example.com"
+	instance.Spec.DeploymentMode = api.KnativeServiceDeploymentMode
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newIngressReconciler(context, instance).Reconcile())
+
+	// Knative exposes the service by itself
+	exists, err := kubernetes.ResourceC(cli).Fetch(&networkingv1.Ingress{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestIngressReconciler_Openshift(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Ingress.Host = "my-runtime.example.com"
+	cli := test.NewFakeClientBuilder().OnOpenShift().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	ingressReconciler := newIngressReconciler(context, instance)
+	err := ingressReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	ingress := &networkingv1.Ingress{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestIngressReconciler_NotControlled(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Ingress.Host = "my-runtime.example.com"
+	userIngress := &networkingv1.Ingress{
+		ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns},
+		Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "users.example.com"}}},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, userIngress).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newIngressReconciler(context, instance).Reconcile())
+
+	// the Ingress of the users is neither taken over nor deleted
+	ingress := &networkingv1.Ingress{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "users.example.com", ingress.Spec.Rules[0].Host)
+	assert.Empty(t, ingress.OwnerReferences)
+
+	instance.Spec.Ingress.Host = ""
+	assert.NoError(t, newIngressReconciler(context, instance).Reconcile())
+	exists, err = kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
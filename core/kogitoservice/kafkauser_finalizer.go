@@ -0,0 +1,83 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"github.com/kiegroup/kogito-operator/core/operator"
+)
+
+const kafkaUserFinalizer = "delete.kafkaUser.finalizer"
+
+// KafkaUserFinalizerHandler deletes the KafkaUser created for a Kogito service in the namespace of another Kafka cluster,
+// out of reach of the garbage collector
+type KafkaUserFinalizerHandler interface {
+	AddFinalizer(instance api.KogitoService) error
+	HandleFinalization(instance api.KogitoService) error
+}
+
+type kafkaUserFinalizerHandler struct {
+	operator.Context
+}
+
+// NewKafkaUserFinalizerHandler ...
+func NewKafkaUserFinalizerHandler(context operator.Context) KafkaUserFinalizerHandler {
+	return &kafkaUserFinalizerHandler{
+		Context: context,
+	}
+}
+
+// AddFinalizer add finalizer to provide KogitoService instance
+func (f *kafkaUserFinalizerHandler) AddFinalizer(instance api.KogitoService) error {
+	if instance.GetDeletionTimestamp().IsZero() && !util.Contains(kafkaUserFinalizer, instance.GetFinalizers()) {
+		f.Log.Debug("Adding KafkaUser finalizer", "instance", instance.GetName())
+		instance.SetFinalizers(append(instance.GetFinalizers(), kafkaUserFinalizer))
+		if err := kubernetes.ResourceC(f.Client).Update(instance); err != nil {
+			f.Log.Error(err, "Failed to add KafkaUser finalizer", "instance", instance.GetName())
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleFinalization deletes the KafkaUsers bound to the given KogitoService by labels and removes the finalizer from it
+func (f *kafkaUserFinalizerHandler) HandleFinalization(instance api.KogitoService) error {
+	if !util.Contains(kafkaUserFinalizer, instance.GetFinalizers()) {
+		return nil
+	}
+	kafkaUsers := &v1beta2.KafkaUserList{}
+	labels := map[string]string{
+		kafkaUserServiceNamespaceLabel: instance.GetNamespace(),
+		kafkaUserServiceNameLabel:      instance.GetName(),
+	}
+	if err := kubernetes.ResourceC(f.Client).ListWithNamespaceAndLabel("", kafkaUsers, labels); err != nil {
+		return err
+	}
+	for i := range kafkaUsers.Items {
+		f.Log.Debug("Deleting KafkaUser", "name", kafkaUsers.Items[i].Name, "namespace", kafkaUsers.Items[i].Namespace)
+		if err := kubernetes.ResourceC(f.Client).Delete(&kafkaUsers.Items[i]); err != nil {
+			return err
+		}
+	}
+
+	f.Log.Debug("Removing KafkaUser finalizer", "instance", instance.GetName())
+	finalizers := instance.GetFinalizers()
+	util.Remove(kafkaUserFinalizer, &finalizers)
+	instance.SetFinalizers(finalizers)
+	return kubernetes.ResourceC(f.Client).Update(instance)
+}
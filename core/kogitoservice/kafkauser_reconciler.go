@@ -0,0 +1,233 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"github.com/kiegroup/kogito-operator/core/kogitoinfra"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// kafkaUserServiceNamespaceLabel and kafkaUserServiceNameLabel bind to the service a KafkaUser created in the namespace of another Kafka cluster,
+	// owner references can't cross namespaces
+	kafkaUserServiceNamespaceLabel = "kogito.kie.org/service-namespace"
+	kafkaUserServiceNameLabel      = "kogito.kie.org/service-name"
+)
+
+// KafkaUserReconciler ...
+type KafkaUserReconciler interface {
+	Reconcile() error
+}
+
+type kafkaUserReconciler struct {
+	operator.Context
+	instance       api.KogitoService
+	kafkaInstance  *v1beta2.Kafka
+	authentication v1beta2.KafkaAuthenticationType
+	topics         []string
+	kafkaHandler   infrastructure.KafkaHandler
+	deltaProcessor infrastructure.DeltaProcessor
+}
+
+func newKafkaUserReconciler(context operator.Context, instance api.KogitoService, kafkaInstance *v1beta2.Kafka, authentication v1beta2.KafkaAuthenticationType, topics []string) KafkaUserReconciler {
+	return &kafkaUserReconciler{
+		Context:        context,
+		instance:       instance,
+		kafkaInstance:  kafkaInstance,
+		authentication: authentication,
+		topics:         topics,
+		kafkaHandler:   infrastructure.NewKafkaHandler(context),
+		deltaProcessor: infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+// Reconcile provisions the KafkaUser of the service, granted to access the given topics when the Kafka cluster enables authorization.
+// When the Kafka cluster lives in another namespace, the credentials generated by Strimzi are copied in the service namespace.
+func (k *kafkaUserReconciler) Reconcile() error {
+	if k.isCrossNamespace() {
+		if err := NewKafkaUserFinalizerHandler(k.Context).AddFinalizer(k.instance); err != nil {
+			return err
+		}
+	}
+
+	// Create Required resource
+	requestedResources, err := k.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := k.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	if err = k.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	if k.isCrossNamespace() {
+		userName := getServiceKafkaUserName(k.instance)
+		if exists, err := copyKafkaUserSecret(k.Context, k.instance, k.kafkaInstance.Namespace, userName); err != nil {
+			return err
+		} else if !exists {
+			return infrastructure.ErrorForKafkaUserNotReady(userName)
+		}
+	}
+	return nil
+}
+
+func (k *kafkaUserReconciler) isCrossNamespace() bool {
+	return k.kafkaInstance.Namespace != k.instance.GetNamespace()
+}
+
+func (k *kafkaUserReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	kafkaUser := k.kafkaHandler.CreateKafkaUser(getServiceKafkaUserName(k.instance), k.kafkaInstance, k.authentication, k.topics)
+	if k.isCrossNamespace() {
+		kafkaUser.Labels[kafkaUserServiceNamespaceLabel] = k.instance.GetNamespace()
+		kafkaUser.Labels[kafkaUserServiceNameLabel] = k.instance.GetName()
+	} else if err := framework.SetOwner(k.instance, k.Scheme, kafkaUser); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(v1beta2.KafkaUser{})] = []client.Object{kafkaUser}
+	return resources, nil
+}
+
+func (k *kafkaUserReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	kafkaUser, err := k.kafkaHandler.FetchKafkaUser(types.NamespacedName{Name: getServiceKafkaUserName(k.instance), Namespace: k.kafkaInstance.Namespace})
+	if err != nil {
+		return nil, err
+	}
+	if kafkaUser != nil {
+		resources[reflect.TypeOf(v1beta2.KafkaUser{})] = []client.Object{kafkaUser}
+	}
+	return resources, nil
+}
+
+func (k *kafkaUserReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	excludeNotOwnedResources(k.Context, k.instance, k.isOwned, requestedResources, deployedResources)
+	comparator := k.kafkaHandler.GetKafkaUserComparator()
+	_, err = k.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
+
+// isOwned checks if the given KafkaUser is controlled by the service, or bound to it by labels when created in another namespace
+func (k *kafkaUserReconciler) isOwned(deployed client.Object) bool {
+	if metav1.IsControlledBy(deployed, k.instance) {
+		return true
+	}
+	labels := deployed.GetLabels()
+	return labels[kafkaUserServiceNamespaceLabel] == k.instance.GetNamespace() && labels[kafkaUserServiceNameLabel] == k.instance.GetName()
+}
+
+// fetchKafkaListener gets the Kafka instance referenced by the given KogitoInfra and the listener used by the services.
+// Returns nil if the Kafka instance is not found.
+func fetchKafkaListener(context operator.Context, infra api.KogitoInfraInterface) (*v1beta2.Kafka, *v1beta2.GenericKafkaListener, error) {
+	namespace := infra.GetSpec().GetResource().GetNamespace()
+	if len(namespace) == 0 {
+		namespace = infra.GetNamespace()
+	}
+	kafkaInstance, err := infrastructure.NewKafkaHandler(context).FetchKafkaInstance(types.NamespacedName{Name: infra.GetSpec().GetResource().GetName(), Namespace: namespace})
+	if err != nil || kafkaInstance == nil {
+		return nil, nil, err
+	}
+	return kafkaInstance, infrastructure.ResolveKafkaListener(kafkaInstance, kogitoinfra.GetKafkaListenerName(infra)), nil
+}
+
+// getKafkaUserName gets the KafkaUser of the given service, either the one referenced by the KogitoInfra or the one named after the service
+func getKafkaUserName(instance api.KogitoService, infra api.KogitoInfraInterface) string {
+	if userName := kogitoinfra.GetKafkaUserName(infra); len(userName) > 0 {
+		return userName
+	}
+	return getServiceKafkaUserName(instance)
+}
+
+// getServiceKafkaUserName gets the name of the KafkaUser created for the given service, prefixed by its namespace since services of several namespaces can share the same Kafka cluster
+func getServiceKafkaUserName(instance api.KogitoService) string {
+	return instance.GetNamespace() + "-" + instance.GetName()
+}
+
+// copyKafkaUserSecret copies in the service namespace the credentials generated by Strimzi for the given KafkaUser in the namespace of the Kafka cluster.
+// Returns false if the credentials are not yet generated.
+func copyKafkaUserSecret(context operator.Context, instance api.KogitoService, kafkaNamespace, userName string) (bool, error) {
+	secretHandler := infrastructure.NewSecretHandler(context)
+	userSecret, err := secretHandler.FetchSecret(types.NamespacedName{Name: userName, Namespace: kafkaNamespace})
+	if err != nil || userSecret == nil {
+		return false, err
+	}
+	deployedSecret, err := secretHandler.FetchSecret(types.NamespacedName{Name: userName, Namespace: instance.GetNamespace()})
+	if err != nil {
+		return false, err
+	}
+	if deployedSecret != nil {
+		if !metav1.IsControlledBy(deployedSecret, instance) || reflect.DeepEqual(deployedSecret.Data, userSecret.Data) {
+			return true, nil
+		}
+		context.Log.Info("KafkaUser credentials changed, updating their copy", "secret", userName)
+		deployedSecret.Data = userSecret.Data
+		return true, kubernetes.ResourceC(context.Client).Update(deployedSecret)
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      userName,
+			Namespace: instance.GetNamespace(),
+		},
+		Type: userSecret.Type,
+		Data: userSecret.Data,
+	}
+	if err := framework.SetOwner(instance, context.Scheme, secret); err != nil {
+		return false, err
+	}
+	return true, kubernetes.ResourceC(context.Client).Create(secret)
+}
+
+// addKafkaUserCredentials injects in the service the credentials of its KafkaUser when the listener bound to the KogitoInfra requires authentication
+func addKafkaUserCredentials(context operator.Context, instance api.KogitoService, serviceDefinition *ServiceDefinition, infra api.KogitoInfraInterface) error {
+	kafkaInstance, listener, err := fetchKafkaListener(context, infra)
+	if err != nil || listener == nil || len(listener.Authentication.AuthenticationType) == 0 {
+		return err
+	}
+	userName := getKafkaUserName(instance, infra)
+	if kafkaInstance.Namespace != instance.GetNamespace() {
+		// the KafkaUser created for the service is reconciled later on, its credentials are copied once generated
+		if _, err := copyKafkaUserSecret(context, instance, kafkaInstance.Namespace, userName); err != nil {
+			return err
+		}
+	}
+	credentialEnvs := kogitoinfra.GetKafkaUserCredentialEnvs(instance.GetSpec().GetRuntime(), listener.Authentication.AuthenticationType, userName)
+	serviceDefinition.Envs = framework.EnvOverride(serviceDefinition.Envs, credentialEnvs...)
+	if listener.Authentication.AuthenticationType == v1beta2.KafkaTLSAuthentication {
+		serviceDefinition.SecretVolumeReferences = append(serviceDefinition.SecretVolumeReferences, &VolumeReference{
+			Name:      userName,
+			MountPath: kogitoinfra.KafkaUserCertMountPath,
+			FileMode:  &framework.ModeForCertificates,
+		})
+	}
+	return nil
+}
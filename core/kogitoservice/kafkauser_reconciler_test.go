@@ -0,0 +1,149 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"github.com/kiegroup/kogito-operator/core/kogitoinfra"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKafkaUserReconciler(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	kafka := test.CreateFakeKafka(ns)
+	kafka.Spec.Kafka.Authorization.AuthorizationType = "simple"
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, kafka).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newKafkaUserReconciler(context, instance, kafka, v1beta2.KafkaScramSha512Authentication, []string{"kogito-processinstances-events"}).Reconcile()
+	assert.NoError(t, err)
+
+	kafkaUser := &v1beta2.KafkaUser{ObjectMeta: metav1.ObjectMeta{Name: ns + "-" + instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(kafkaUser)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.True(t, metav1.IsControlledBy(kafkaUser, instance))
+	assert.Equal(t, kafka.Name, kafkaUser.Labels["strimzi.io/cluster"])
+	assert.Equal(t, v1beta2.KafkaScramSha512Authentication, kafkaUser.Spec.Authentication.AuthenticationType)
+	// group read plus describe, read and write on the topic
+	assert.Equal(t, 4, len(kafkaUser.Spec.Authorization.Acls))
+	assert.Equal(t, "kogito-processinstances-events", kafkaUser.Spec.Authorization.Acls[1].Resource.Name)
+}
+
+func TestKafkaUserReconciler_KafkaInOtherNamespace(t *testing.T) {
+	ns := t.Name()
+	kafkaNamespace := "kafka"
+	instance := test.CreateFakeKogitoRuntime(ns)
+	kafka := test.CreateFakeKafka(kafkaNamespace)
+	userName := ns + "-" + instance.Name
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, kafka).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	reconciler := newKafkaUserReconciler(context, instance, kafka, v1beta2.KafkaTLSAuthentication, []string{"kogito-processinstances-events"})
+	// the credentials are not yet generated by Strimzi
+	err := reconciler.Reconcile()
+	assert.Error(t, err)
+	assert.True(t, infrastructure.NewReconciliationErrorHandler(context).IsReconciliationError(err))
+
+	kafkaUser := &v1beta2.KafkaUser{ObjectMeta: metav1.ObjectMeta{Name: userName, Namespace: kafkaNamespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(kafkaUser)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Empty(t, kafkaUser.OwnerReferences)
+	assert.Equal(t, ns, kafkaUser.Labels[kafkaUserServiceNamespaceLabel])
+	assert.Equal(t, instance.Name, kafkaUser.Labels[kafkaUserServiceNameLabel])
+	assert.Contains(t, instance.GetFinalizers(), kafkaUserFinalizer)
+
+	userSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: userName, Namespace: kafkaNamespace},
+		Data:       map[string][]byte{"user.password": []byte("password")},
+	}
+	assert.NoError(t, kubernetes.ResourceC(cli).Create(userSecret))
+	assert.NoError(t, reconciler.Reconcile())
+
+	secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: userName, Namespace: ns}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(secret)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.True(t, metav1.IsControlledBy(secret, instance))
+	assert.Equal(t, userSecret.Data, secret.Data)
+
+	// the KafkaUser out of reach of the garbage collector is deleted by the finalizer
+	assert.NoError(t, NewKafkaUserFinalizerHandler(context).HandleFinalization(instance))
+	exists, err = kubernetes.ResourceC(cli).Fetch(kafkaUser)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	assert.NotContains(t, instance.GetFinalizers(), kafkaUserFinalizer)
+}
+
+func TestAddKafkaUserCredentials(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	kafka := test.CreateFakeKafka(ns)
+	kafka.Spec.Kafka.Listeners[1].Authentication.AuthenticationType = v1beta2.KafkaTLSAuthentication
+	infraKafka := test.CreateFakeKogitoKafka(ns)
+	infraKafka.GetSpec().GetResource().SetName(kafka.Name)
+	infraKafka.GetSpec().AddInfraProperties(map[string]string{"listener": "tls"})
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, kafka, infraKafka).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	definition := &ServiceDefinition{}
+	err := addKafkaUserCredentials(context, instance, definition, infraKafka)
+	assert.NoError(t, err)
+
+	assert.Contains(t, definition.Envs, framework.CreateSecretEnvVar("kafka.ssl.keystore.password", ns+"-"+instance.Name, "user.password"))
+	assert.Equal(t, 1, len(definition.SecretVolumeReferences))
+	assert.Equal(t, ns+"-"+instance.Name, definition.SecretVolumeReferences[0].GetName())
+	assert.Equal(t, kogitoinfra.KafkaUserCertMountPath, definition.SecretVolumeReferences[0].GetMountPath())
+}
+
+func TestAddKafkaUserCredentials_PlainListener(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	kafka := test.CreateFakeKafka(ns)
+	infraKafka := test.CreateFakeKogitoKafka(ns)
+	infraKafka.GetSpec().GetResource().SetName(kafka.Name)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, kafka, infraKafka).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	definition := &ServiceDefinition{}
+	err := addKafkaUserCredentials(context, instance, definition, infraKafka)
+	assert.NoError(t, err)
+	assert.Empty(t, definition.Envs)
+	assert.Empty(t, definition.SecretVolumeReferences)
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	"github.com/kiegroup/kogito-operator/core/kogitoinfra"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const keycloakClientProtocol = "openid-connect"
+
+// KeycloakClientReconciler ...
+type KeycloakClientReconciler interface {
+	Reconcile() error
+}
+
+type keycloakClientReconciler struct {
+	operator.Context
+	instance          api.KogitoService
+	serviceDefinition *ServiceDefinition
+	infra             api.KogitoInfraInterface
+	keycloakHandler   infrastructure.KeycloakHandler
+	deltaProcessor    infrastructure.DeltaProcessor
+}
+
+func newKeycloakClientReconciler(context operator.Context, instance api.KogitoService, serviceDefinition *ServiceDefinition, infra api.KogitoInfraInterface) KeycloakClientReconciler {
+	return &keycloakClientReconciler{
+		Context:           context,
+		instance:          instance,
+		serviceDefinition: serviceDefinition,
+		infra:             infra,
+		keycloakHandler:   infrastructure.NewKeycloakHandler(context),
+		deltaProcessor:    infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+// Reconcile provisions the Keycloak client of the service in the realm of the bound KogitoInfra
+// and injects its credentials in the service.
+func (k *keycloakClientReconciler) Reconcile() error {
+	// Create Required resource
+	requestedResources, err := k.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := k.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	if err = k.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	clientEnvs := kogitoinfra.GetKeycloakClientEnvs(k.instance.GetSpec().GetRuntime(), k.getClientID())
+	k.serviceDefinition.Envs = framework.EnvOverride(k.serviceDefinition.Envs, clientEnvs...)
+	return nil
+}
+
+func (k *keycloakClientReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	keycloakClient := k.createKeycloakClient()
+	if err := framework.SetOwner(k.instance, k.Scheme, keycloakClient); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(keycloakv1alpha1.KeycloakClient{})] = []client.Object{keycloakClient}
+	return resources, nil
+}
+
+func (k *keycloakClientReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	keycloakClient, err := k.keycloakHandler.FetchKeycloakClient(types.NamespacedName{Name: k.instance.GetName(), Namespace: k.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if keycloakClient != nil {
+		resources[reflect.TypeOf(keycloakv1alpha1.KeycloakClient{})] = []client.Object{keycloakClient}
+	}
+	return resources, nil
+}
+
+func (k *keycloakClientReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	excludeNotControlledResources(k.Context, k.instance, requestedResources, deployedResources)
+	comparator := k.keycloakHandler.GetKeycloakClientComparator()
+	_, err = k.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
+
+func (k *keycloakClientReconciler) createKeycloakClient() *keycloakv1alpha1.KeycloakClient {
+	apiClient := &keycloakv1alpha1.KeycloakAPIClient{
+		ClientID:                  k.getClientID(),
+		Name:                      k.instance.GetName(),
+		Enabled:                   true,
+		Protocol:                  keycloakClientProtocol,
+		StandardFlowEnabled:       true,
+		DirectAccessGrantsEnabled: true,
+		PublicClient:              false,
+	}
+	// browsers are redirected back to the service once logged in
+	if externalURI := k.instance.GetStatus().GetExternalURI(); len(externalURI) > 0 {
+		apiClient.RedirectUris = []string{externalURI + "/*"}
+		apiClient.WebOrigins = []string{externalURI}
+	}
+	return &keycloakv1alpha1.KeycloakClient{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k.instance.GetName(),
+			Namespace: k.instance.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: k.instance.GetName(),
+			},
+		},
+		Spec: keycloakv1alpha1.KeycloakClientSpec{
+			RealmSelector: kogitoinfra.GetKeycloakRealmSelector(k.infra),
+			Client:        apiClient,
+		},
+	}
+}
+
+func (k *keycloakClientReconciler) getClientID() string {
+	return k.instance.GetName()
+}
+
+// IsKeycloakResource checks if provided KogitoInfra instance is for Keycloak resource
+func IsKeycloakResource(instance api.KogitoInfraInterface) bool {
+	if !instance.GetSpec().IsResourceEmpty() {
+		return infrastructure.IsKeycloakResource(instance.GetSpec().GetResource().GetAPIVersion(), instance.GetSpec().GetResource().GetKind())
+	}
+	return false
+}
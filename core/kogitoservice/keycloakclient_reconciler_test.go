@@ -0,0 +1,81 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	keycloak "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	"github.com/kiegroup/kogito-operator/core/kogitoinfra"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKeycloakClientReconciler(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Status.ExternalURI = "http://my-service.example.com"
+	kogitoKeycloak := test.CreateFakeKogitoKeycloak(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, kogitoKeycloak).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	definition := &ServiceDefinition{}
+	err := newKeycloakClientReconciler(context, instance, definition, kogitoKeycloak).Reconcile()
+	assert.NoError(t, err)
+
+	keycloakClient := &keycloak.KeycloakClient{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(keycloakClient)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.True(t, metav1.IsControlledBy(keycloakClient, instance))
+	assert.Equal(t, kogitoinfra.GetKeycloakRealmSelector(kogitoKeycloak), keycloakClient.Spec.RealmSelector)
+	assert.Equal(t, instance.Name, keycloakClient.Spec.Client.ClientID)
+	assert.False(t, keycloakClient.Spec.Client.PublicClient)
+	assert.Equal(t, []string{"http://my-service.example.com/*"}, keycloakClient.Spec.Client.RedirectUris)
+
+	assert.Contains(t, definition.Envs, framework.CreateEnvVar("QUARKUS_OIDC_CLIENT_ID", instance.Name))
+	assert.Contains(t, definition.Envs, framework.CreateSecretEnvVar("QUARKUS_OIDC_CREDENTIALS_SECRET", "keycloak-client-secret-"+instance.Name, "CLIENT_SECRET"))
+}
+
+func TestKeycloakClientReconciler_UpdatesRedirectURIs(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	kogitoKeycloak := test.CreateFakeKogitoKeycloak(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, kogitoKeycloak).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newKeycloakClientReconciler(context, instance, &ServiceDefinition{}, kogitoKeycloak).Reconcile())
+	keycloakClient := &keycloak.KeycloakClient{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	_, err := kubernetes.ResourceC(cli).Fetch(keycloakClient)
+	assert.NoError(t, err)
+	assert.Empty(t, keycloakClient.Spec.Client.RedirectUris)
+
+	instance.Status.ExternalURI = "http://my-service.example.com"
+	assert.NoError(t, newKeycloakClientReconciler(context, instance, &ServiceDefinition{}, kogitoKeycloak).Reconcile())
+	_, err = kubernetes.ResourceC(cli).Fetch(keycloakClient)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"http://my-service.example.com"}, keycloakClient.Spec.Client.WebOrigins)
+}
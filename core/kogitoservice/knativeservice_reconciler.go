@@ -0,0 +1,138 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	servingv1 "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// knativeServingNamespace is the namespace of the Knative Serving activator
+	knativeServingNamespace = "knative-serving"
+	// knativeServingIngressNamespace is the namespace of the Knative Serving ingress gateway on OpenShift Serverless
+	knativeServingIngressNamespace = "knative-serving-ingress"
+)
+
+// KnativeServiceReconciler ...
+type KnativeServiceReconciler interface {
+	Reconcile() error
+}
+
+type knativeServiceReconciler struct {
+	operator.Context
+	instance              api.KogitoService
+	imageHandler          infrastructure.ImageHandler
+	deploymentReconciler  *deploymentReconciler
+	knativeServiceHandler infrastructure.KnativeServiceHandler
+	deltaProcessor        infrastructure.DeltaProcessor
+}
+
+func newKnativeServiceReconciler(context operator.Context, instance api.KogitoService, definition ServiceDefinition, imageHandler infrastructure.ImageHandler) KnativeServiceReconciler {
+	return &knativeServiceReconciler{
+		Context:               context,
+		instance:              instance,
+		imageHandler:          imageHandler,
+		deploymentReconciler:  newDeploymentReconciler(context, instance, definition, imageHandler).(*deploymentReconciler),
+		knativeServiceHandler: infrastructure.NewKnativeServiceHandler(context),
+		deltaProcessor:        infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+// Reconcile deploys the service as a Knative Service when it's requested by its deployment mode.
+// Its pods are configured like the ones of the Deployment, which isn't created in this mode.
+func (k *knativeServiceReconciler) Reconcile() error {
+	if !k.knativeServiceHandler.IsKnativeServingAvailable() {
+		if isKnativeServiceMode(k.instance) {
+			return infrastructure.ErrorForKnativeServingNotAvailable(k.instance.GetName())
+		}
+		k.Log.Debug("Skipping Knative Service creation. Knative Serving is not installed in the cluster.")
+		return nil
+	}
+
+	// Create Required resource
+	requestedResources, err := k.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := k.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	if err = k.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (k *knativeServiceReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if !isKnativeServiceMode(k.instance) {
+		return resources, nil
+	}
+	imageName, err := k.imageHandler.ResolveImage()
+	if err != nil {
+		return nil, err
+	} else if len(imageName) == 0 {
+		return nil, infrastructure.ErrorForImageNotFound()
+	}
+	deployment, err := k.deploymentReconciler.createDeployment(imageName)
+	if err != nil {
+		return nil, err
+	}
+	knativeService := k.knativeServiceHandler.CreateKnativeService(deployment)
+	if err := framework.SetOwner(k.instance, k.Scheme, knativeService); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(servingv1.Service{})] = []client.Object{knativeService}
+	return resources, nil
+}
+
+func (k *knativeServiceReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	knativeService, err := k.knativeServiceHandler.FetchKnativeService(types.NamespacedName{Name: k.instance.GetName(), Namespace: k.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if knativeService != nil {
+		resources[reflect.TypeOf(servingv1.Service{})] = []client.Object{knativeService}
+	}
+	return resources, nil
+}
+
+func (k *knativeServiceReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	excludeNotControlledResources(k.Context, k.instance, requestedResources, deployedResources)
+	comparator := k.knativeServiceHandler.GetComparator()
+	_, err = k.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
+
+// isKnativeServiceMode checks if the given service is deployed as a Knative Service instead of a Deployment exposed through a Service and a Route
+func isKnativeServiceMode(instance api.KogitoService) bool {
+	runtimeSpec, ok := instance.GetSpec().(api.KogitoRuntimeSpecInterface)
+	return ok && runtimeSpec.GetDeploymentMode() == api.KnativeServiceDeploymentMode
+}
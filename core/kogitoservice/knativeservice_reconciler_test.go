@@ -0,0 +1,109 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	servingv1 "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKnativeServiceReconciler_ReplacesDeployment(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.DeploymentMode = api.KnativeServiceDeploymentMode
+	instance.Spec.Env = []corev1.EnvVar{{Name: "MY_VAR", Value: "my_value"}}
+	deployment := createFakeRolloutDeployment(instance.Name, ns, "quay.io/kiegroup/test-image:1.0")
+	cli := test.NewFakeClientBuilder().SupportKnativeServing().AddK8sObjects(instance, deployment).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	image := &api.Image{Domain: "quay.io/kiegroup", Name: "test-image", Tag: "2.0"}
+	imageHandler := infrastructure.NewImageHandler(context, image, "default-image", "image-stream", ns, false, false)
+	definition := ServiceDefinition{Envs: instance.Spec.Env}
+	assert.NoError(t, newKnativeServiceReconciler(context, instance, definition, imageHandler).Reconcile())
+	assert.NoError(t, newDeploymentReconciler(context, instance, definition, imageHandler).Reconcile())
+	assert.NoError(t, newServiceReconciler(context, instance).Reconcile())
+
+	knativeService := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(knativeService)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.True(t, metav1.IsControlledBy(knativeService, instance))
+	assert.Equal(t, instance.Name, knativeService.Spec.Template.Labels[framework.LabelAppKey])
+	container := knativeService.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "quay.io/kiegroup/test-image:2.0", container.Image)
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "MY_VAR", Value: "my_value"})
+	assert.NotNil(t, container.ReadinessProbe)
+	assert.Nil(t, container.StartupProbe)
+	assert.Equal(t, []corev1.ContainerPort{{ContainerPort: int32(framework.DefaultExposedPort), Protocol: corev1.ProtocolTCP}}, container.Ports)
+
+	exists, err = kubernetes.ResourceC(cli).Fetch(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = kubernetes.ResourceC(cli).Fetch(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestKnativeServiceReconciler_KnativeServingNotInstalled(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.DeploymentMode = api.KnativeServiceDeploymentMode
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	image := &api.Image{Domain: "quay.io/kiegroup", Name: "test-image", Tag: "2.0"}
+	imageHandler := infrastructure.NewImageHandler(context, image, "default-image", "image-stream", ns, false, false)
+	err := newKnativeServiceReconciler(context, instance, ServiceDefinition{}, imageHandler).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, infrastructure.KnativeServingNotAvailableReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+}
+
+func TestKnativeServiceReconciler_DeploymentModeRemovesKnativeService(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	knativeService := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	assert.NoError(t, framework.SetOwner(instance, meta.GetRegisteredSchema(), knativeService))
+	cli := test.NewFakeClientBuilder().SupportKnativeServing().AddK8sObjects(instance, knativeService).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	image := &api.Image{Domain: "quay.io/kiegroup", Name: "test-image", Tag: "2.0"}
+	imageHandler := infrastructure.NewImageHandler(context, image, "default-image", "image-stream", ns, false, false)
+	assert.NoError(t, newKnativeServiceReconciler(context, instance, ServiceDefinition{}, imageHandler).Reconcile())
+
+	exists, err := kubernetes.ResourceC(cli).Fetch(&servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
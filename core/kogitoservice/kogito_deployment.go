@@ -17,6 +17,7 @@ package kogitoservice
 import (
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -52,6 +53,10 @@ func (d *kogitoDeploymentHandler) CreateDeployment(service api.KogitoService, re
 		d.Log.Warn("Service can't scale vertically, only one replica is allowed.", "service", service.GetName())
 	}
 	replicas := service.GetSpec().GetReplicas()
+	if service.GetSpec().IsAutoscalingEnabled() && !definition.SingleReplica {
+		// replicas are handled by the HorizontalPodAutoscaler
+		replicas = nil
+	}
 	probes := getProbeForKogitoService(service)
 	labels := service.GetSpec().GetDeploymentLabels()
 	if labels == nil {
@@ -65,18 +70,12 @@ func (d *kogitoDeploymentHandler) CreateDeployment(service api.KogitoService, re
 			Replicas: replicas,
 			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{framework.LabelAppKey: service.GetName()}},
 			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				ObjectMeta: metav1.ObjectMeta{Labels: labels, Annotations: createPodAnnotations(definition)},
 				Spec: corev1.PodSpec{
 					Containers: []corev1.Container{
 						{
-							Name: service.GetName(),
-							Ports: []corev1.ContainerPort{
-								{
-									Name:          framework.DefaultPortName,
-									ContainerPort: int32(framework.DefaultExposedPort),
-									Protocol:      corev1.ProtocolTCP,
-								},
-							},
+							Name:            service.GetName(),
+							Ports:           createContainerPorts(service),
 							Resources:       service.GetSpec().GetResources(),
 							LivenessProbe:   probes.liveness,
 							ReadinessProbe:  probes.readiness,
@@ -90,6 +89,9 @@ func (d *kogitoDeploymentHandler) CreateDeployment(service api.KogitoService, re
 		},
 	}
 	addStartupProbe(d, deployment, probes.startup)
+	if service.GetSpec().IsEnableIstio() {
+		framework.AddIstioInjectSidecarAnnotation(&deployment.Spec.Template.ObjectMeta)
+	}
 
 	return deployment
 }
@@ -108,3 +110,23 @@ func addStartupProbe(d *kogitoDeploymentHandler, deployment *appsv1.Deployment,
 		deployment.Spec.Template.Spec.Containers[0].StartupProbe = startupProbe
 	}
 }
+
+// createPodAnnotations copies the annotations of the pods set by the reconcilers of the service
+func createPodAnnotations(definition ServiceDefinition) map[string]string {
+	if len(definition.PodAnnotations) == 0 {
+		return nil
+	}
+	annotations := make(map[string]string, len(definition.PodAnnotations))
+	for key, value := range definition.PodAnnotations {
+		annotations[key] = value
+	}
+	return annotations
+}
+
+// createContainerPorts creates the port of the container, serving HTTPS when the service serves a certificate
+func createContainerPorts(service api.KogitoService) []corev1.ContainerPort {
+	if infrastructure.IsTLSEnabled(service) {
+		return []corev1.ContainerPort{{Name: framework.DefaultTLSPortName, ContainerPort: int32(framework.DefaultExposedTLSPort), Protocol: corev1.ProtocolTCP}}
+	}
+	return []corev1.ContainerPort{{Name: framework.DefaultPortName, ContainerPort: int32(framework.DefaultExposedPort), Protocol: corev1.ProtocolTCP}}
+}
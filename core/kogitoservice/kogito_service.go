@@ -15,9 +15,10 @@
 package kogitoservice
 
 import (
-	"fmt"
 	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/operator"
+	"net/http"
 	"os"
 )
 
@@ -28,6 +29,7 @@ const (
 // ServiceHandler ...
 type ServiceHandler interface {
 	GetKogitoServiceEndpoint(kogitoService api.KogitoService) string
+	GetKogitoServiceHTTPClient(kogitoService api.KogitoService) (*http.Client, error)
 }
 
 type kogitoServiceHandler struct {
@@ -53,11 +55,17 @@ func (k *kogitoServiceHandler) GetKogitoServiceEndpoint(kogitoService api.Kogito
 	return k.getKogitoServiceURL(kogitoService)
 }
 
+// GetKogitoServiceHTTPClient gets the HTTP client to call the endpoint of the given Kogito service,
+// trusting its serving certificate when it serves HTTPS.
+func (k *kogitoServiceHandler) GetKogitoServiceHTTPClient(kogitoService api.KogitoService) (*http.Client, error) {
+	return infrastructure.NewTLSHandler(k.Context).NewHTTPClient(kogitoService)
+}
+
 // getKogitoServiceURL provides kogito service URL for given instance name
 func (k *kogitoServiceHandler) getKogitoServiceURL(service api.KogitoService) string {
 	k.Log.Debug("Creating kogito service instance URL.")
-	// resolves to http://servicename.mynamespace for example
-	serviceURL := fmt.Sprintf("http://%s.%s", service.GetName(), service.GetNamespace())
+	// resolves to http://servicename.mynamespace or https://servicename.mynamespace.svc for example
+	serviceURL := infrastructure.GetKogitoServiceInternalURL(service)
 	k.Log.Debug("", "kogito service instance URL", serviceURL)
 	return serviceURL
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/kiegroup/kogito-operator/core/manager"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"k8s.io/apimachinery/pkg/types"
+	"sort"
 )
 
 // KogitoInfraReconciler ...
@@ -49,6 +50,7 @@ func newKogitoInfraReconciler(context operator.Context, instance api.KogitoServi
 
 func (k *kogitoInfraReconciler) Reconcile() error {
 	infraNames := k.instance.GetSpec().GetInfra()
+	persistenceInfras := make(map[api.PersistenceBackendType][]string)
 	for _, infraName := range infraNames {
 
 		infra, err := k.infraHandler.FetchKogitoInfraInstance(types.NamespacedName{Name: infraName, Namespace: k.instance.GetNamespace()})
@@ -69,6 +71,51 @@ func (k *kogitoInfraReconciler) Reconcile() error {
 		k.serviceDefinition.SecretEnvFromReferences = append(k.serviceDefinition.SecretEnvFromReferences, infra.GetStatus().GetSecretEnvFromReferences()...)
 		k.serviceDefinition.SecretVolumeReferences = append(k.serviceDefinition.SecretVolumeReferences, infra.GetStatus().GetSecretVolumeReferences()...)
 		k.serviceDefinition.Envs = framework.EnvOverride(k.serviceDefinition.Envs, infra.GetStatus().GetEnvs()...)
+
+		if IsKeycloakResource(infra) {
+			keycloakClientReconciler := newKeycloakClientReconciler(k.Context, k.instance, k.serviceDefinition, infra)
+			if err := keycloakClientReconciler.Reconcile(); err != nil {
+				return err
+			}
+		}
+
+		if IsKafkaResource(infra) {
+			if err := addKafkaUserCredentials(k.Context, k.instance, k.serviceDefinition, infra); err != nil {
+				return err
+			}
+		}
+
+		if persistenceBackend := getPersistenceBackend(infra); len(persistenceBackend) > 0 {
+			persistenceInfras[persistenceBackend] = append(persistenceInfras[persistenceBackend], infra.GetName())
+		}
+	}
+	return k.resolvePersistenceImage(persistenceInfras)
+}
+
+// resolvePersistenceImage selects the service image matching the persistence backend provided by the bound KogitoInfra resources.
+// Only services defining images per persistence backend are affected.
+func (k *kogitoInfraReconciler) resolvePersistenceImage(persistenceInfras map[api.PersistenceBackendType][]string) error {
+	if len(k.serviceDefinition.PersistenceImageNames) == 0 {
+		return nil
+	}
+	var persistenceBackend api.PersistenceBackendType
+	if len(persistenceInfras) > 1 {
+		var conflictingInfras []string
+		for _, infras := range persistenceInfras {
+			conflictingInfras = append(conflictingInfras, infras...)
+		}
+		sort.Strings(conflictingInfras)
+		return infrastructure.ErrorForPersistenceInfraConflict(k.instance.GetName(), conflictingInfras)
+	}
+	for backend := range persistenceInfras {
+		persistenceBackend = backend
+	}
+	if imageName, ok := k.serviceDefinition.PersistenceImageNames[persistenceBackend]; ok {
+		k.Log.Debug("Using image for persistence backend", "backend", persistenceBackend, "image", imageName)
+		k.serviceDefinition.DefaultImageName = imageName
+	}
+	if supportingService, ok := k.instance.(api.KogitoSupportingServiceInterface); ok {
+		supportingService.GetSupportingServiceStatus().SetPersistenceBackend(persistenceBackend)
 	}
 	return nil
 }
@@ -117,9 +117,21 @@ func (m *messagingDeployer) setCloudEventsStatus(instance api.KogitoService, top
 	})
 }
 
+// isServiceAvailable checks if the service is running, either its Deployment or its Knative Service
+func (m *messagingDeployer) isServiceAvailable(instance api.KogitoService) (bool, error) {
+	key := types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}
+	if isKnativeServiceMode(instance) {
+		knativeService, err := infrastructure.NewKnativeServiceHandler(m.Context).FetchKnativeService(key)
+		if err != nil {
+			return false, err
+		}
+		return knativeService != nil && knativeService.IsReady(), nil
+	}
+	return infrastructure.NewDeploymentHandler(m.Context).IsDeploymentAvailable(key)
+}
+
 func (m *messagingDeployer) fetchRequiredTopicsForURL(instance api.KogitoService, serverURL string) ([]messagingTopic, error) {
-	deploymentHandler := infrastructure.NewDeploymentHandler(m.Context)
-	available, err := deploymentHandler.IsDeploymentAvailable(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	available, err := m.isServiceAvailable(instance)
 	if err != nil {
 		return nil, err
 	}
@@ -127,8 +139,12 @@ func (m *messagingDeployer) fetchRequiredTopicsForURL(instance api.KogitoService
 		m.Log.Debug("Deployment not available yet for KogitoService", "KogitoService", instance.GetName())
 		return nil, nil
 	}
+	httpClient, err := NewKogitoServiceHandler(m.Context).GetKogitoServiceHTTPClient(instance)
+	if err != nil {
+		return nil, err
+	}
 	topicsURL := fmt.Sprintf("%s%s", serverURL, topicInfoPath)
-	resp, err := http.Get(topicsURL)
+	resp, err := httpClient.Get(topicsURL)
 	if err != nil {
 		return nil, err
 	}
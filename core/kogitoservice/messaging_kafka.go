@@ -52,11 +52,13 @@ func (k *kafkaMessagingDeployer) createRequiredKafkaTopics(infra api.KogitoInfra
 		return nil
 	}
 	// topics required by definition
+	var topicNames []string
 	for _, kafkaTopic := range k.definition.KafkaTopics {
-		err := k.createKafkaTopicIfNotExists(kafkaTopic, infra)
+		err := k.reconcileKafkaTopic(kafkaTopic, infra, service)
 		if err != nil {
 			return err
 		}
+		topicNames = append(topicNames, kafkaTopic)
 	}
 	// topics required by the deployed service
 	topics, err := k.fetchTopicsAndSetCloudEventsStatus(service)
@@ -64,15 +66,31 @@ func (k *kafkaMessagingDeployer) createRequiredKafkaTopics(infra api.KogitoInfra
 		return err
 	}
 	for _, topic := range topics {
-		if err := k.createKafkaTopicIfNotExists(topic.Name, infra); err != nil {
+		if err := k.reconcileKafkaTopic(topic.Name, infra, service); err != nil {
 			return err
 		}
+		topicNames = append(topicNames, topic.Name)
 	}
-	return nil
+	return k.createRequiredKafkaUser(infra, service, topicNames)
+}
+
+// createRequiredKafkaUser creates the KafkaUser of the service when the listener requires authentication and no existing user is referenced by the KogitoInfra.
+func (k *kafkaMessagingDeployer) createRequiredKafkaUser(infra api.KogitoInfraInterface, service api.KogitoService, topicNames []string) error {
+	if len(infra2.GetKafkaUserName(infra)) > 0 {
+		return nil
+	}
+	kafkaInstance, listener, err := fetchKafkaListener(k.Context, infra)
+	if err != nil || listener == nil || len(listener.Authentication.AuthenticationType) == 0 {
+		return err
+	}
+	kafkaUserReconciler := newKafkaUserReconciler(k.Context, service, kafkaInstance, listener.Authentication.AuthenticationType, topicNames)
+	return kafkaUserReconciler.Reconcile()
 }
 
-func (k *kafkaMessagingDeployer) createKafkaTopicIfNotExists(topicName string, instance api.KogitoInfraInterface) error {
-	k.Log.Debug("Going to create kafka topic it is not exists", "topicName", topicName)
+// reconcileKafkaTopic creates the given Kafka topic if it doesn't exist yet.
+// Existing topics are updated only when configured in the KogitoInfra or in the Kogito service.
+func (k *kafkaMessagingDeployer) reconcileKafkaTopic(topicName string, instance api.KogitoInfraInterface, service api.KogitoService) error {
+	k.Log.Debug("Going to reconcile kafka topic", "topicName", topicName)
 
 	kafkaNamespaceName, err := k.getKafkaInstanceNamespaceName(instance)
 	if err != nil {
@@ -86,10 +104,26 @@ func (k *kafkaMessagingDeployer) createKafkaTopicIfNotExists(topicName string, i
 		return err
 	}
 
+	topicConfig := getKafkaTopicConfig(topicName, instance, service)
 	if kafkaTopic == nil {
-		_, err := kafkaHandler.CreateKafkaTopic(topicName, kafkaNamespaceName.Name, kafkaNamespaceName.Namespace)
-		if err != nil {
-			return err
+		_, err := kafkaHandler.CreateKafkaTopic(topicName, kafkaNamespaceName.Name, kafkaNamespaceName.Namespace, topicConfig)
+		return err
+	}
+	_, err = kafkaHandler.UpdateKafkaTopic(kafkaTopic, topicConfig)
+	return err
+}
+
+// getKafkaTopicConfig gets the configuration for the given topic, the one defined in the Kogito service takes precedence over the KogitoInfra one.
+// Returns nil if the topic is not configured.
+func getKafkaTopicConfig(topicName string, instance api.KogitoInfraInterface, service api.KogitoService) api.KafkaTopicInterface {
+	for _, topicConfig := range service.GetSpec().GetKafkaTopics() {
+		if topicConfig.GetName() == topicName {
+			return topicConfig
+		}
+	}
+	for _, topicConfig := range instance.GetSpec().GetKafkaTopics() {
+		if topicConfig.GetName() == topicName {
+			return topicConfig
 		}
 	}
 	return nil
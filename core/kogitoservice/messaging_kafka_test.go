@@ -15,6 +15,7 @@
 package kogitoservice
 
 import (
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	"github.com/kiegroup/kogito-operator/core/kogitoinfra"
 	"github.com/kiegroup/kogito-operator/core/operator"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -63,3 +65,55 @@ func Test_createKafkaTopics(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, exists)
 }
+
+func Test_createKafkaTopics_withTopicConfiguration(t *testing.T) {
+	infraKafka := test.CreateFakeKogitoKafka(t.Name())
+	kafka := test.CreateFakeKafka(t.Name())
+	kafkaConfig := test.CreateFakeKogitoKafkaConfig(t.Name())
+	infraKafka.GetSpec().GetResource().SetName(kafka.GetName())
+	infraKafka.(*v1beta1.KogitoInfra).Spec.KafkaTopics = []v1beta1.KafkaTopic{
+		{Name: "kogito-processinstances-events", Partitions: 3, Replicas: 3},
+		{Name: "kogito-jobs-events", Partitions: 2, Config: map[string]string{"retention.ms": "604800000"}},
+	}
+	service := test.CreateFakeDataIndex(t.Name())
+	service.GetSpec().AddInfra(infraKafka.GetName())
+	service.Spec.KafkaTopics = []v1beta1.KafkaTopic{
+		{Name: "kogito-processinstances-events", Partitions: 6, Config: map[string]string{"cleanup.policy": "compact"}},
+	}
+	// deployed before the configuration was set, must be updated
+	deployedTopic := &v1beta2.KafkaTopic{
+		ObjectMeta: metav1.ObjectMeta{Name: "kogito-jobs-events", Namespace: t.Name()},
+		Spec:       v1beta2.KafkaTopicSpec{Partitions: 1, Replicas: 1, TopicName: "kogito-jobs-events"},
+	}
+	client := test.NewFakeClientBuilder().AddK8sObjects(infraKafka, service, kafka, kafkaConfig, deployedTopic).Build()
+	context := operator.Context{
+		Client: client,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	k := kafkaMessagingDeployer{
+		messagingDeployer{
+			Context:      context,
+			infraHandler: app.NewKogitoInfraHandler(context),
+			definition: ServiceDefinition{
+				KafkaTopics: []string{"kogito-processinstances-events", "kogito-jobs-events", "kogito-usertaskinstances-events"},
+			}}}
+	assert.NoError(t, k.CreateRequiredResources(service))
+
+	// the service configuration takes precedence over the infra one
+	kafkaTopic := &v1beta2.KafkaTopic{ObjectMeta: metav1.ObjectMeta{Name: "kogito-processinstances-events", Namespace: t.Name()}}
+	test.AssertFetchMustExist(t, client, kafkaTopic)
+	assert.Equal(t, int32(6), kafkaTopic.Spec.Partitions)
+	assert.Equal(t, int32(1), kafkaTopic.Spec.Replicas)
+	assert.Equal(t, map[string]string{"cleanup.policy": "compact"}, kafkaTopic.Spec.Config)
+
+	kafkaTopic = &v1beta2.KafkaTopic{ObjectMeta: metav1.ObjectMeta{Name: "kogito-jobs-events", Namespace: t.Name()}}
+	test.AssertFetchMustExist(t, client, kafkaTopic)
+	assert.Equal(t, int32(2), kafkaTopic.Spec.Partitions)
+	assert.Equal(t, "604800000", kafkaTopic.Spec.Config["retention.ms"])
+
+	kafkaTopic = &v1beta2.KafkaTopic{ObjectMeta: metav1.ObjectMeta{Name: "kogito-usertaskinstances-events", Namespace: t.Name()}}
+	test.AssertFetchMustExist(t, client, kafkaTopic)
+	assert.Equal(t, int32(1), kafkaTopic.Spec.Partitions)
+	assert.Empty(t, kafkaTopic.Spec.Config)
+}
@@ -16,22 +16,28 @@ package kogitoservice
 
 import (
 	"fmt"
+	"reflect"
+
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/client/openshift"
 	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/framework/util"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	servingv1 "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/tracker"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	topicIdentifier        = "kogito.kie.org/cloudEventType"
 	triggerFilterAttribute = "type"
+	// triggerHashLength is the length of the hash of the CloudEvent type in the name of its Trigger
+	triggerHashLength = 8
 )
 
 // knativeMessagingDeployer implementation of messagingHandler
@@ -51,41 +57,56 @@ func (k *knativeMessagingDeployer) CreateRequiredResources(service api.KogitoSer
 		return err
 	}
 
-	// fetch for incoming topics to create our triggers
+	// the topics can only be fetched from a running service, the current Triggers are kept meanwhile
+	if available, err := k.isServiceAvailable(service); err != nil || !available {
+		return err
+	}
 	topics, err := k.fetchTopicsAndSetCloudEventsStatus(service)
 	if err != nil {
 		return err
 	}
-	for _, topic := range topics {
-		if err := k.createKnativeTriggersIfNotExists(topic, service, infra); err != nil {
-			return err
-		}
-	}
-	return nil
+	return k.reconcileTriggers(topics, service, infra)
 }
 
-func (k *knativeMessagingDeployer) createKnativeTriggersIfNotExists(topic messagingTopic, service api.KogitoService, infra api.KogitoInfraInterface) error {
-	if topic.Kind == incoming {
+// reconcileTriggers creates a Trigger for each CloudEvent consumed by the service, updating the ones subscribed to another Broker
+// and deleting the ones of the CloudEvents it doesn't consume anymore
+func (k *knativeMessagingDeployer) reconcileTriggers(topics []messagingTopic, service api.KogitoService, infra api.KogitoInfraInterface) error {
+	knativeHandler := infrastructure.NewKnativeHandler(k.Context)
+	requestedResources := make(map[reflect.Type][]client.Object)
+	for _, topic := range topics {
+		if topic.Kind != incoming {
+			continue
+		}
 		for _, event := range topic.EventsMeta {
-			if exists, err := k.triggerExists(event, service); err != nil {
+			trigger := k.newTrigger(event, service, infra)
+			if err := framework.SetOwner(service, k.Scheme, trigger); err != nil {
 				return err
-			} else if !exists {
-				trigger := k.newTrigger(event, service, infra)
-				if err := kubernetes.ResourceC(k.Client).CreateForOwner(trigger, service, k.Scheme); err != nil {
-					return err
-				}
 			}
+			requestedResources[reflect.TypeOf(eventingv1.Trigger{})] = append(requestedResources[reflect.TypeOf(eventingv1.Trigger{})], trigger)
 		}
 	}
-	return nil
+
+	deployedResources := make(map[reflect.Type][]client.Object)
+	triggers, err := knativeHandler.FetchTriggers(service.GetNamespace(), map[string]string{framework.LabelAppKey: service.GetName()})
+	if err != nil {
+		return err
+	}
+	for i := range triggers.Items {
+		if framework.IsOwner(&triggers.Items[i], service) {
+			deployedResources[reflect.TypeOf(eventingv1.Trigger{})] = append(deployedResources[reflect.TypeOf(eventingv1.Trigger{})], &triggers.Items[i])
+		}
+	}
+
+	_, err = infrastructure.NewDeltaProcessor(k.Context).ProcessDelta(knativeHandler.GetTriggerComparator(), requestedResources, deployedResources)
+	return err
 }
 
-// newTrigger creates a new Knative Eventing Trigger reference for the given Event
+// newTrigger creates a new Knative Eventing Trigger reference for the given Event, named after its type
 // See: https://knative.dev/docs/eventing/broker/triggers/#trigger-filtering
 func (k *knativeMessagingDeployer) newTrigger(e messagingEventMeta, service api.KogitoService, infra api.KogitoInfraInterface) *eventingv1.Trigger {
 	return &eventingv1.Trigger{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-listener-%s", service.GetName(), util.RandomSuffix()),
+			Name:      getTriggerName(e, service),
 			Namespace: service.GetNamespace(),
 			Labels: map[string]string{
 				framework.LabelAppKey: service.GetName(),
@@ -96,12 +117,7 @@ func (k *knativeMessagingDeployer) newTrigger(e messagingEventMeta, service api.
 			Broker: infra.GetSpec().GetResource().GetName(),
 			Filter: &eventingv1.TriggerFilter{Attributes: eventingv1.TriggerFilterAttributes{triggerFilterAttribute: e.Type}},
 			Subscriber: duckv1.Destination{
-				Ref: &duckv1.KReference{
-					Name:       service.GetName(),
-					Namespace:  service.GetNamespace(),
-					Kind:       openshift.KindService.Name,
-					APIVersion: openshift.KindService.GroupVersion.Version,
-				},
+				Ref: k.newSubscriberReference(service),
 			},
 		},
 	}
@@ -138,32 +154,53 @@ func (k *knativeMessagingDeployer) newSinkBinding(service api.KogitoService, inf
 				},
 			},
 			BindingSpec: duckv1.BindingSpec{
-				Subject: tracker.Reference{
-					APIVersion: openshift.KindDeployment.GroupVersion.String(),
-					Kind:       openshift.KindDeployment.Name,
-					Namespace:  service.GetNamespace(),
-					Name:       service.GetName(),
-				},
+				Subject: k.newSubjectReference(service),
 			},
 		},
 	}
 }
 
-func (k *knativeMessagingDeployer) triggerExists(e messagingEventMeta, service api.KogitoService) (bool, error) {
-	triggers := &eventingv1.TriggerList{}
-	labels := map[string]string{
-		framework.LabelAppKey: service.GetName(),
-		topicIdentifier:       e.Type,
+// newSubscriberReference references the Service receiving the events delivered by the Triggers of the given service,
+// the Knative Service itself when it's deployed as one
+func (k *knativeMessagingDeployer) newSubscriberReference(service api.KogitoService) *duckv1.KReference {
+	if isKnativeServiceMode(service) {
+		return &duckv1.KReference{
+			Name:       service.GetName(),
+			Namespace:  service.GetNamespace(),
+			Kind:       servingv1.KindService,
+			APIVersion: servingv1.GroupVersion.String(),
+		}
 	}
-	if err := kubernetes.ResourceC(k.Client).ListWithNamespaceAndLabel(service.GetNamespace(), triggers, labels); err != nil {
-		return false, err
+	return &duckv1.KReference{
+		Name:       service.GetName(),
+		Namespace:  service.GetNamespace(),
+		Kind:       openshift.KindService.Name,
+		APIVersion: openshift.KindService.GroupVersion.Version,
 	}
-	for _, trigger := range triggers.Items {
-		if framework.IsOwner(&trigger, service) {
-			return true, nil
+}
+
+// newSubjectReference references the resource running the pods of the given service, bound to the Broker by the SinkBinding
+func (k *knativeMessagingDeployer) newSubjectReference(service api.KogitoService) tracker.Reference {
+	if isKnativeServiceMode(service) {
+		return tracker.Reference{
+			APIVersion: servingv1.GroupVersion.String(),
+			Kind:       servingv1.KindService,
+			Namespace:  service.GetNamespace(),
+			Name:       service.GetName(),
 		}
 	}
-	return false, nil
+	return tracker.Reference{
+		APIVersion: openshift.KindDeployment.GroupVersion.String(),
+		Kind:       openshift.KindDeployment.Name,
+		Namespace:  service.GetNamespace(),
+		Name:       service.GetName(),
+	}
+}
+
+// getTriggerName gets the name of the Trigger of the given Event, the same across reconciliations.
+// The CloudEvent type isn't a valid resource name, so a hash of it is used.
+func getTriggerName(e messagingEventMeta, service api.KogitoService) string {
+	return fmt.Sprintf("%s-listener-%s", service.GetName(), util.GenerateMD5Hash(map[string]string{topicIdentifier: e.Type})[:triggerHashLength])
 }
 
 // IsKnativeEventingResource checks if provided KogitoInfra instance is for Knative eventing resource
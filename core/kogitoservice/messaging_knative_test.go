@@ -15,6 +15,7 @@
 package kogitoservice
 
 import (
+	api "github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/operator"
@@ -22,6 +23,7 @@ import (
 	"github.com/kiegroup/kogito-operator/internal/app"
 	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"testing"
 )
@@ -84,3 +86,91 @@ func Test_knativeMessagingDeployer_CreateRequiredResources(t *testing.T) {
 	assert.Len(t, triggers.Items, 1)
 	assert.Equal(t, "travellers", triggers.Items[0].Spec.Filter.Attributes["type"])
 }
+
+func Test_knativeMessagingDeployer_KnativeServiceSubscriber(t *testing.T) {
+	instance := test.CreateFakeKogitoRuntime(t.Name())
+	instance.Spec.DeploymentMode = api.KnativeServiceDeploymentMode
+	knativeInfra := test.CreateFakeKogitoKnative(t.Name())
+	knativeDeployer := &knativeMessagingDeployer{}
+
+	trigger := knativeDeployer.newTrigger(messagingEventMeta{Type: "travellers"}, instance, knativeInfra)
+	assert.Equal(t, "Service", trigger.Spec.Subscriber.Ref.Kind)
+	assert.Equal(t, "serving.knative.dev/v1", trigger.Spec.Subscriber.Ref.APIVersion)
+	assert.Equal(t, instance.Name, trigger.Spec.Subscriber.Ref.Name)
+
+	sinkBinding := knativeDeployer.newSinkBinding(instance, knativeInfra)
+	assert.Equal(t, "Service", sinkBinding.Spec.Subject.Kind)
+	assert.Equal(t, "serving.knative.dev/v1", sinkBinding.Spec.Subject.APIVersion)
+	assert.Equal(t, instance.Name, sinkBinding.Spec.Subject.Name)
+}
+
+func Test_knativeMessagingDeployer_ReconcilesTriggers(t *testing.T) {
+	responseWithTopics := `[
+   {
+      "name":"kogito_incoming_stream",
+      "type":"INCOMING",
+      "eventsMeta":[
+         {
+            "type":"travellers",
+            "source":"",
+            "kind":"CONSUMED"
+         }
+      ]
+   }
+]`
+	server := mockKogitoSvcReplies(t, serverHandler{Path: topicInfoPath, JSONResponse: responseWithTopics})
+	defer server.Close()
+	deferFn := test.SetSharedEnv(envVarKogitoServiceURL, server.URL)
+	defer deferFn()
+
+	kogitoSvc := createServiceInstance(t)
+	request := newReconcileRequest(kogitoSvc.GetNamespace())
+	request.Name = kogitoSvc.GetName()
+	knativeInfra := test.CreateFakeKogitoKnative(t.Name())
+	kogitoSvc.GetSpec().AddInfra(knativeInfra.GetName())
+
+	newFakeTrigger := func(name, eventType, broker string, owned bool) *eventingv1.Trigger {
+		trigger := &eventingv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: kogitoSvc.GetNamespace(),
+				Labels:    map[string]string{framework.LabelAppKey: kogitoSvc.GetName(), topicIdentifier: eventType},
+			},
+			Spec: eventingv1.TriggerSpec{Broker: broker},
+		}
+		if owned {
+			assert.NoError(t, framework.SetOwner(kogitoSvc, meta.GetRegisteredSchema(), trigger))
+		}
+		return trigger
+	}
+	travellersName := getTriggerName(messagingEventMeta{Type: "travellers"}, kogitoSvc)
+	brokerChanged := newFakeTrigger(travellersName, "travellers", "old-broker", true)
+	randomlyNamed := newFakeTrigger(kogitoSvc.GetName()+"-listener-1234", "travellers", "", true)
+	notAdvertised := newFakeTrigger(kogitoSvc.GetName()+"-listener-5678", "removed", "", true)
+	notOwned := newFakeTrigger("manual-trigger", "removed", "", false)
+
+	client := test.NewFakeClientBuilder().
+		AddK8sObjects(kogitoSvc, knativeInfra, createAvailableDeployment(kogitoSvc), brokerChanged, randomlyNamed, notAdvertised, notOwned).
+		Build()
+	context := operator.Context{
+		Client: client,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	infraHandler := app.NewKogitoInfraHandler(context)
+	knativeDeployer := NewKnativeMessagingDeployer(context, ServiceDefinition{Request: request}, infraHandler)
+	assert.NoError(t, knativeDeployer.CreateRequiredResources(kogitoSvc))
+
+	triggers := &eventingv1.TriggerList{}
+	err := kubernetes.ResourceC(client).ListWithNamespaceAndLabel(kogitoSvc.GetNamespace(), triggers, map[string]string{framework.LabelAppKey: kogitoSvc.GetName()})
+	assert.NoError(t, err)
+	assert.Len(t, triggers.Items, 2)
+	for _, trigger := range triggers.Items {
+		if trigger.Name == travellersName {
+			assert.Equal(t, knativeInfra.GetSpec().GetResource().GetName(), trigger.Spec.Broker)
+			assert.Equal(t, "travellers", trigger.Spec.Filter.Attributes[triggerFilterAttribute])
+		} else {
+			assert.Equal(t, notOwned.Name, trigger.Name)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/manager"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NetworkPolicyReconciler ...
+type NetworkPolicyReconciler interface {
+	Reconcile() error
+}
+
+type networkPolicyReconciler struct {
+	operator.Context
+	instance             api.KogitoService
+	definition           ServiceDefinition
+	infraHandler         manager.KogitoInfraHandler
+	networkPolicyHandler infrastructure.NetworkPolicyHandler
+	deltaProcessor       infrastructure.DeltaProcessor
+}
+
+func newNetworkPolicyReconciler(context operator.Context, instance api.KogitoService, definition ServiceDefinition, infraHandler manager.KogitoInfraHandler) NetworkPolicyReconciler {
+	return &networkPolicyReconciler{
+		Context:              context,
+		instance:             instance,
+		definition:           definition,
+		infraHandler:         infraHandler,
+		networkPolicyHandler: infrastructure.NewNetworkPolicyHandler(context),
+		deltaProcessor:       infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+func (n *networkPolicyReconciler) Reconcile() error {
+
+	// Create Required resource
+	requestedResources, err := n.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := n.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	if err = n.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (n *networkPolicyReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if !n.instance.GetSpec().IsNetworkPolicyEnabled() {
+		n.Log.Debug("Skipping NetworkPolicy creation. NetworkPolicy is not enabled.")
+		return resources, nil
+	}
+	networkPolicy := n.networkPolicyHandler.CreateNetworkPolicy(n.instance)
+	if err := n.addInfraPeers(networkPolicy); err != nil {
+		return nil, err
+	}
+	if isKnativeServiceMode(n.instance) {
+		n.addKnativeServingPeers(networkPolicy)
+	}
+	if n.definition.OnNetworkPolicyCreate != nil {
+		if err := n.definition.OnNetworkPolicyCreate(networkPolicy); err != nil {
+			return nil, err
+		}
+	}
+	if err := framework.SetOwner(n.instance, n.Scheme, networkPolicy); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(networkingv1.NetworkPolicy{})] = []client.Object{networkPolicy}
+	return resources, nil
+}
+
+// addInfraPeers allows the connections to the resources of the KogitoInfra bound to the service,
+// and the events delivered by Knative Eventing to the service
+func (n *networkPolicyReconciler) addInfraPeers(networkPolicy *networkingv1.NetworkPolicy) error {
+	var egressPeers, ingressPeers []networkingv1.NetworkPolicyPeer
+	for _, infraName := range n.instance.GetSpec().GetInfra() {
+		infra, err := n.infraHandler.FetchKogitoInfraInstance(types.NamespacedName{Name: infraName, Namespace: n.instance.GetNamespace()})
+		if err != nil {
+			return err
+		}
+		if infra == nil || infra.GetSpec().IsResourceEmpty() {
+			n.Log.Debug("No resource to connect to for KogitoInfra", "infra", infraName)
+			continue
+		}
+		peer := n.networkPolicyHandler.GetInfraNetworkPolicyPeer(n.instance.GetNamespace(), infra.GetSpec().GetResource())
+		egressPeers = append(egressPeers, peer)
+		if infra.GetSpec().GetResource().GetKind() == infrastructure.KnativeEventingBrokerKind {
+			ingressPeers = append(ingressPeers, peer)
+		}
+	}
+	framework.AddNetworkPolicyEgressPeers(networkPolicy, egressPeers...)
+	framework.AddNetworkPolicyIngressPeers(networkPolicy, ingressPeers...)
+	return nil
+}
+
+// addKnativeServingPeers allows the requests routed to the Knative Service by the activator and the ingress gateway of Knative Serving
+func (n *networkPolicyReconciler) addKnativeServingPeers(networkPolicy *networkingv1.NetworkPolicy) {
+	peers := []networkingv1.NetworkPolicyPeer{n.networkPolicyHandler.GetNamespaceNetworkPolicyPeer(knativeServingNamespace)}
+	if n.Client.IsOpenshift() {
+		peers = append(peers, n.networkPolicyHandler.GetNamespaceNetworkPolicyPeer(knativeServingIngressNamespace))
+	}
+	framework.AddNetworkPolicyIngressPeers(networkPolicy, peers...)
+}
+
+func (n *networkPolicyReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	networkPolicy, err := n.networkPolicyHandler.FetchNetworkPolicy(types.NamespacedName{Name: n.instance.GetName(), Namespace: n.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if networkPolicy != nil {
+		resources[reflect.TypeOf(networkingv1.NetworkPolicy{})] = []client.Object{networkPolicy}
+	}
+	return resources, nil
+}
+
+func (n *networkPolicyReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	excludeNotControlledResources(n.Context, n.instance, requestedResources, deployedResources)
+	comparator := n.networkPolicyHandler.GetComparator()
+	_, err = n.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
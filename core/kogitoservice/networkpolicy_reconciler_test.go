@@ -0,0 +1,183 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"os"
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/internal/app"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNetworkPolicyReconciler_Disabled(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	networkPolicyReconciler := newNetworkPolicyReconciler(context, instance, ServiceDefinition{}, app.NewKogitoInfraHandler(context))
+	err := networkPolicyReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(networkPolicy)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestNetworkPolicyReconciler(t *testing.T) {
+	ns := t.Name()
+	kafka := test.CreateFakeKogitoKafka(ns)
+	kafka.GetSpec().GetResource().SetNamespace("kafka")
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Infra = []string{kafka.GetName()}
+	instance.Spec.NetworkPolicy = &v1beta1.NetworkPolicy{
+		Enabled:     true,
+		IngressFrom: []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &v13.LabelSelector{MatchLabels: map[string]string{"name": "clients"}}}},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, kafka).OnOpenShift().Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	definition := ServiceDefinition{
+		OnNetworkPolicyCreate: func(networkPolicy *networkingv1.NetworkPolicy) error {
+			framework.AddNetworkPolicyEgressPeers(networkPolicy, framework.NewNetworkPolicyPodPeer("data-index"))
+			return nil
+		},
+	}
+	networkPolicyReconciler := newNetworkPolicyReconciler(context, instance, definition, app.NewKogitoInfraHandler(context))
+	err := networkPolicyReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(networkPolicy)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, instance.Name, networkPolicy.Spec.PodSelector.MatchLabels[framework.LabelAppKey])
+	assert.Len(t, networkPolicy.Spec.PolicyTypes, 2)
+	// operator and Prometheus, router and custom sources
+	assert.Len(t, networkPolicy.Spec.Ingress, 3)
+	assert.Equal(t, "controller-manager", networkPolicy.Spec.Ingress[0].From[0].PodSelector.MatchLabels["control-plane"])
+	assert.Equal(t, "monitoring", networkPolicy.Spec.Ingress[0].From[1].NamespaceSelector.MatchLabels["network.openshift.io/policy-group"])
+	assert.Equal(t, "ingress", networkPolicy.Spec.Ingress[1].From[0].NamespaceSelector.MatchLabels["network.openshift.io/policy-group"])
+	assert.Equal(t, "clients", networkPolicy.Spec.Ingress[2].From[0].NamespaceSelector.MatchLabels["name"])
+	// DNS, infra and Kogito services
+	assert.Len(t, networkPolicy.Spec.Egress, 3)
+	assert.Empty(t, networkPolicy.Spec.Egress[0].To)
+	assert.Len(t, networkPolicy.Spec.Egress[0].Ports, 2)
+	assert.Equal(t, "kogito-kafka", networkPolicy.Spec.Egress[1].To[0].PodSelector.MatchLabels["strimzi.io/cluster"])
+	assert.Equal(t, "kafka", networkPolicy.Spec.Egress[1].To[0].NamespaceSelector.MatchLabels[framework.NamespaceNameLabelKey])
+	assert.Equal(t, "data-index", networkPolicy.Spec.Egress[2].To[0].PodSelector.MatchLabels[framework.LabelAppKey])
+
+	// network policy disabled, should be deleted
+	instance.Spec.NetworkPolicy.Enabled = false
+	err = networkPolicyReconciler.Reconcile()
+	assert.NoError(t, err)
+	exists, err = kubernetes.ResourceC(cli).Fetch(&networkingv1.NetworkPolicy{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestNetworkPolicyReconciler_Kubernetes(t *testing.T) {
+	ns := t.Name()
+	assert.NoError(t, os.Setenv("OPERATOR_NAMESPACE", "kogito-operator-system"))
+	defer os.Unsetenv("OPERATOR_NAMESPACE")
+	broker := test.CreateFakeKogitoKnative(ns)
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Infra = []string{broker.GetName()}
+	instance.Spec.Ingress = v1beta1.Ingress{Host: "process.example.com"}
+	instance.Spec.NetworkPolicy = &v1beta1.NetworkPolicy{Enabled: true}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, broker).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	networkPolicyReconciler := newNetworkPolicyReconciler(context, instance, ServiceDefinition{}, app.NewKogitoInfraHandler(context))
+	assert.NoError(t, networkPolicyReconciler.Reconcile())
+
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(networkPolicy)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	// operator and Prometheus, Ingress controller and Knative Eventing
+	assert.Len(t, networkPolicy.Spec.Ingress, 3)
+	assert.Equal(t, "kogito-operator-system", networkPolicy.Spec.Ingress[0].From[0].NamespaceSelector.MatchLabels[framework.NamespaceNameLabelKey])
+	assert.Equal(t, "monitoring", networkPolicy.Spec.Ingress[0].From[1].NamespaceSelector.MatchLabels[framework.NamespaceNameLabelKey])
+	assert.Equal(t, "ingress-nginx", networkPolicy.Spec.Ingress[1].From[0].NamespaceSelector.MatchLabels[framework.NamespaceNameLabelKey])
+	assert.Equal(t, "knative-eventing", networkPolicy.Spec.Ingress[2].From[0].NamespaceSelector.MatchLabels[framework.NamespaceNameLabelKey])
+}
+
+func TestNetworkPolicyReconciler_KnativeServiceMode(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.DeploymentMode = api.KnativeServiceDeploymentMode
+	instance.Spec.NetworkPolicy = &v1beta1.NetworkPolicy{Enabled: true}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).OnOpenShift().Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	networkPolicyReconciler := newNetworkPolicyReconciler(context, instance, ServiceDefinition{}, app.NewKogitoInfraHandler(context))
+	assert.NoError(t, networkPolicyReconciler.Reconcile())
+
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(networkPolicy)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	// operator and Prometheus, router, Knative Serving activator and ingress gateway
+	assert.Len(t, networkPolicy.Spec.Ingress, 3)
+	assert.Equal(t, "knative-serving", networkPolicy.Spec.Ingress[2].From[0].NamespaceSelector.MatchLabels[framework.NamespaceNameLabelKey])
+	assert.Equal(t, "knative-serving-ingress", networkPolicy.Spec.Ingress[2].From[1].NamespaceSelector.MatchLabels[framework.NamespaceNameLabelKey])
+}
+
+func TestNetworkPolicyReconciler_NotControlled(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	userNetworkPolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns},
+		Spec:       networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, userNetworkPolicy).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newNetworkPolicyReconciler(context, instance, ServiceDefinition{}, app.NewKogitoInfraHandler(context)).Reconcile())
+
+	// the NetworkPolicy of the users is not deleted
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(networkPolicy)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}, networkPolicy.Spec.PolicyTypes)
+}
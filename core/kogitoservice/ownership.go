@@ -0,0 +1,63 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// excludeNotControlledResources leaves the deployed objects not controlled by the given service out of both the requested and the deployed resources.
+// The operator never takes over an object created by the users with the name of one it requires, a warning event reports the conflict instead.
+func excludeNotControlledResources(context operator.Context, instance api.KogitoService, requestedResources, deployedResources map[reflect.Type][]client.Object) {
+	excludeNotOwnedResources(context, instance, func(deployed client.Object) bool {
+		return metav1.IsControlledBy(deployed, instance)
+	}, requestedResources, deployedResources)
+}
+
+// excludeNotOwnedResources leaves the deployed objects not owned by the given service, according to the given check, out of both the requested and the deployed resources.
+func excludeNotOwnedResources(context operator.Context, instance api.KogitoService, isOwned func(deployed client.Object) bool, requestedResources, deployedResources map[reflect.Type][]client.Object) {
+	for resourceType, deployedObjects := range deployedResources {
+		var controlledObjects []client.Object
+		for _, deployed := range deployedObjects {
+			if isOwned(deployed) {
+				controlledObjects = append(controlledObjects, deployed)
+				continue
+			}
+			context.Log.Info("Leaving untouched a resource not created by the operator", "kind", resourceType.Name(), "name", deployed.GetName(), "namespace", deployed.GetNamespace())
+			requestedResources[resourceType] = excludeResource(requestedResources[resourceType], deployed)
+			newRecorder(context.Scheme, instance.GetName()).Eventf(context.Client, instance, v1.EventTypeWarning, string(infrastructure.ResourceConflictReason),
+				"%s %s/%s already exists and is not managed by the operator, it's left untouched", resourceType.Name(), deployed.GetNamespace(), deployed.GetName())
+		}
+		deployedResources[resourceType] = controlledObjects
+	}
+}
+
+// excludeResource filters the given object out of the given resources, by namespace and name
+func excludeResource(resources []client.Object, object client.Object) []client.Object {
+	var filtered []client.Object
+	for _, resource := range resources {
+		if resource.GetName() != object.GetName() || resource.GetNamespace() != object.GetNamespace() {
+			filtered = append(filtered, resource)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExcludeNotControlledResources(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.UID = "kogito-runtime-uid"
+	instance.Spec.EnableIstio = true
+	instance.Spec.Istio = &v1beta1.Istio{Gateway: &v1beta1.IstioGateway{Hosts: []string{"process.example.com"}}}
+	userGateway := &istiov1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns},
+		Spec:       istiov1beta1.GatewaySpec{Selector: map[string]string{"istio": "my-gateway"}},
+	}
+	cli := test.NewFakeClientBuilder().SupportIstio().AddK8sObjects(instance, userGateway).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	// the Gateway of the users is neither created again nor taken over
+	assert.NoError(t, newGatewayReconciler(context, instance).Reconcile())
+
+	gateway := &istiov1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(gateway)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, map[string]string{"istio": "my-gateway"}, gateway.Spec.Selector)
+	assert.Empty(t, gateway.OwnerReferences)
+
+	events := &v1.EventList{}
+	assert.NoError(t, kubernetes.ResourceC(cli).ListWithNamespace(ns, events))
+	assert.Len(t, events.Items, 1)
+	assert.Equal(t, string(infrastructure.ResourceConflictReason), events.Items[0].Reason)
+	assert.Equal(t, instance.Name, events.Items[0].InvolvedObject.Name)
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+)
+
+// persistenceBackends maps the infrastructure resources referenced by a KogitoInfra to the persistence backend they provide
+var persistenceBackends = map[string]api.PersistenceBackendType{
+	getPersistenceResourceKey(infrastructure.InfinispanKind, infrastructure.InfinispanAPIVersion): api.InfinispanPersistenceBackend,
+	getPersistenceResourceKey(infrastructure.MongoDBKind, infrastructure.MongoDBAPIVersion):       api.MongoDBPersistenceBackend,
+	getPersistenceResourceKey(infrastructure.PostgreSQLKind, infrastructure.PostgreSQLAPIVersion): api.PostgreSQLPersistenceBackend,
+}
+
+// externalPersistenceBackends maps the kinds of external infrastructure to the persistence backend they provide
+var externalPersistenceBackends = map[api.ExternalInfraKind]api.PersistenceBackendType{
+	api.InfinispanExternalInfra: api.InfinispanPersistenceBackend,
+	api.MongoDBExternalInfra:    api.MongoDBPersistenceBackend,
+	api.PostgreSQLExternalInfra: api.PostgreSQLPersistenceBackend,
+}
+
+func getPersistenceResourceKey(kind, apiVersion string) string {
+	return strings.ToLower(fmt.Sprintf("%s.%s", kind, apiVersion))
+}
+
+// getPersistenceBackend gets the persistence backend provided by the given KogitoInfra, empty if it doesn't provide any
+func getPersistenceBackend(infra api.KogitoInfraInterface) api.PersistenceBackendType {
+	if !infra.GetSpec().IsExternalEmpty() {
+		return externalPersistenceBackends[infra.GetSpec().GetExternal().GetKind()]
+	}
+	if infra.GetSpec().IsResourceEmpty() {
+		return ""
+	}
+	resource := infra.GetSpec().GetResource()
+	return persistenceBackends[getPersistenceResourceKey(resource.GetKind(), resource.GetAPIVersion())]
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PodDisruptionBudgetReconciler ...
+type PodDisruptionBudgetReconciler interface {
+	Reconcile() error
+}
+
+type podDisruptionBudgetReconciler struct {
+	operator.Context
+	instance       api.KogitoService
+	definition     ServiceDefinition
+	pdbHandler     infrastructure.PodDisruptionBudgetHandler
+	deltaProcessor infrastructure.DeltaProcessor
+}
+
+func newPodDisruptionBudgetReconciler(context operator.Context, instance api.KogitoService, definition ServiceDefinition) PodDisruptionBudgetReconciler {
+	return &podDisruptionBudgetReconciler{
+		Context:        context,
+		instance:       instance,
+		definition:     definition,
+		pdbHandler:     infrastructure.NewPodDisruptionBudgetHandler(context),
+		deltaProcessor: infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+func (p *podDisruptionBudgetReconciler) Reconcile() error {
+
+	// Create Required resource
+	requestedResources, err := p.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := p.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	if err = p.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *podDisruptionBudgetReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if p.definition.SingleReplica {
+		p.Log.Debug("Skipping PodDisruptionBudget creation. Only one replica is allowed for this service.")
+		return resources, nil
+	}
+	if budget := p.instance.GetSpec().GetPodDisruptionBudget(); budget != nil && budget.IsDisabled() {
+		p.Log.Debug("Skipping PodDisruptionBudget creation. PodDisruptionBudget is disabled.")
+		return resources, nil
+	}
+	if isKnativeServiceMode(p.instance) {
+		p.Log.Debug("Skipping PodDisruptionBudget creation. Knative manages the pods of the service.")
+		return resources, nil
+	}
+	if p.getExpectedReplicas() <= 1 {
+		p.Log.Debug("Skipping PodDisruptionBudget creation. Service doesn't have more than one replica.")
+		return resources, nil
+	}
+	pdb := p.pdbHandler.CreatePodDisruptionBudget(p.instance)
+	if err := framework.SetOwner(p.instance, p.Scheme, pdb); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(policyv1beta1.PodDisruptionBudget{})] = []client.Object{pdb}
+	return resources, nil
+}
+
+// getExpectedReplicas gets the highest number of replicas the service can run with
+func (p *podDisruptionBudgetReconciler) getExpectedReplicas() int32 {
+	spec := p.instance.GetSpec()
+	if spec.IsAutoscalingEnabled() {
+		return spec.GetAutoscaling().GetMaxReplicas()
+	}
+	if spec.GetReplicas() != nil {
+		return *spec.GetReplicas()
+	}
+	return 1
+}
+
+func (p *podDisruptionBudgetReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	pdb, err := p.pdbHandler.FetchPodDisruptionBudget(types.NamespacedName{Name: p.instance.GetName(), Namespace: p.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if pdb != nil {
+		resources[reflect.TypeOf(policyv1beta1.PodDisruptionBudget{})] = []client.Object{pdb}
+	}
+	return resources, nil
+}
+
+func (p *podDisruptionBudgetReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	excludeNotControlledResources(p.Context, p.instance, requestedResources, deployedResources)
+	comparator := p.pdbHandler.GetComparator()
+	_, err = p.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
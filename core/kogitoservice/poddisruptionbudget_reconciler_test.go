@@ -0,0 +1,184 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestPodDisruptionBudgetReconciler_SingleInstance(t *testing.T) {
+	ns := t.Name()
+	replicas := int32(1)
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Replicas = &replicas
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	pdbReconciler := newPodDisruptionBudgetReconciler(context, instance, ServiceDefinition{})
+	err := pdbReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	pdb := &policyv1beta1.PodDisruptionBudget{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(pdb)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestPodDisruptionBudgetReconciler(t *testing.T) {
+	ns := t.Name()
+	replicas := int32(3)
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Replicas = &replicas
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	pdbReconciler := newPodDisruptionBudgetReconciler(context, instance, ServiceDefinition{})
+	err := pdbReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	pdb := &policyv1beta1.PodDisruptionBudget{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(pdb)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, instance.Name, pdb.Spec.Selector.MatchLabels["app"])
+	assert.Equal(t, intstr.FromInt(1), *pdb.Spec.MaxUnavailable)
+	assert.Nil(t, pdb.Spec.MinAvailable)
+
+	// custom budget
+	minAvailable := intstr.FromString("50%")
+	instance.Spec.PodDisruptionBudget = &v1beta1.PodDisruptionBudget{MinAvailable: &minAvailable}
+	err = pdbReconciler.Reconcile()
+	assert.NoError(t, err)
+	pdb = &policyv1beta1.PodDisruptionBudget{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(pdb)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, minAvailable, *pdb.Spec.MinAvailable)
+	assert.Nil(t, pdb.Spec.MaxUnavailable)
+
+	// budget disabled, PDB should be deleted
+	instance.Spec.PodDisruptionBudget.Disabled = true
+	err = pdbReconciler.Reconcile()
+	assert.NoError(t, err)
+	exists, err = kubernetes.ResourceC(cli).Fetch(pdb)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestPodDisruptionBudgetReconciler_SingleReplica(t *testing.T) {
+	ns := t.Name()
+	replicas := int32(2)
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Replicas = &replicas
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	pdbReconciler := newPodDisruptionBudgetReconciler(context, instance, ServiceDefinition{SingleReplica: true})
+	err := pdbReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	pdb := &policyv1beta1.PodDisruptionBudget{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(pdb)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestPodDisruptionBudgetReconciler_Autoscaling(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Autoscaling = &v1beta1.Autoscaling{MaxReplicas: 4}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	pdbReconciler := newPodDisruptionBudgetReconciler(context, instance, ServiceDefinition{})
+	err := pdbReconciler.Reconcile()
+	assert.NoError(t, err)
+
+	pdb := &policyv1beta1.PodDisruptionBudget{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(pdb)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestPodDisruptionBudgetReconciler_NotControlled(t *testing.T) {
+	ns := t.Name()
+	replicas := int32(3)
+	minAvailable := intstr.FromInt(2)
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Replicas = &replicas
+	userPDB := &policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns},
+		Spec:       policyv1beta1.PodDisruptionBudgetSpec{MinAvailable: &minAvailable},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, userPDB).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newPodDisruptionBudgetReconciler(context, instance, ServiceDefinition{}).Reconcile())
+
+	// the PodDisruptionBudget of the users is not taken over
+	pdb := &policyv1beta1.PodDisruptionBudget{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(pdb)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, &minAvailable, pdb.Spec.MinAvailable)
+	assert.Nil(t, pdb.Spec.MaxUnavailable)
+	assert.Empty(t, pdb.OwnerReferences)
+}
+
+func TestPodDisruptionBudgetReconciler_KnativeServiceMode(t *testing.T) {
+	ns := t.Name()
+	replicas := int32(3)
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Replicas = &replicas
+	instance.Spec.DeploymentMode = api.KnativeServiceDeploymentMode
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newPodDisruptionBudgetReconciler(context, instance, ServiceDefinition{}).Reconcile())
+
+	// Knative manages the pods of the service by itself
+	exists, err := kubernetes.ResourceC(cli).Fetch(&policyv1beta1.PodDisruptionBudget{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
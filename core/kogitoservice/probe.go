@@ -17,6 +17,7 @@ package kogitoservice
 import (
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -47,6 +48,11 @@ var defaultHTTPGetAction = corev1.HTTPGetAction{
 	Scheme: corev1.URISchemeHTTP,
 }
 
+var defaultHTTPSGetAction = corev1.HTTPGetAction{
+	Port:   intstr.IntOrString{IntVal: int32(framework.DefaultExposedTLSPort)},
+	Scheme: corev1.URISchemeHTTPS,
+}
+
 var defaultProbeValues = corev1.Probe{
 	TimeoutSeconds:   int32(1),
 	PeriodSeconds:    int32(10),
@@ -56,20 +62,22 @@ var defaultProbeValues = corev1.Probe{
 
 func getProbeForKogitoService(service api.KogitoService) healthCheckProbe {
 	runtimeType := service.GetSpec().GetRuntime()
+	tlsEnabled := infrastructure.IsTLSEnabled(service)
 	return healthCheckProbe{
-		readiness: getProbe(service.GetSpec().GetProbes().GetReadinessProbe(), runtimeType, readinessProbeType),
-		liveness:  getProbe(service.GetSpec().GetProbes().GetLivenessProbe(), runtimeType, livenessProbeType),
-		startup:   getProbe(service.GetSpec().GetProbes().GetStartupProbe(), runtimeType, startupProbeType),
+		readiness: getProbe(service.GetSpec().GetProbes().GetReadinessProbe(), runtimeType, readinessProbeType, tlsEnabled),
+		liveness:  getProbe(service.GetSpec().GetProbes().GetLivenessProbe(), runtimeType, livenessProbeType, tlsEnabled),
+		startup:   getProbe(service.GetSpec().GetProbes().GetStartupProbe(), runtimeType, startupProbeType, tlsEnabled),
 	}
 }
 
 // getProbe is a catch-all function that sets default values for all missing values
 // that have not been set by the user for all the various probe types.
-func getProbe(probe corev1.Probe, runtimeType api.RuntimeType, probeType ProbeType) *corev1.Probe {
+// The default HTTP probes are sent to the HTTPS port when the service serves a certificate.
+func getProbe(probe corev1.Probe, runtimeType api.RuntimeType, probeType ProbeType, tlsEnabled bool) *corev1.Probe {
 	if isProbeHandlerEmpty(probe.Handler) {
-		probe.Handler = corev1.Handler{HTTPGet: getDefaultHTTPGetAction(runtimeType, probeType)}
+		probe.Handler = corev1.Handler{HTTPGet: getDefaultHTTPGetAction(runtimeType, probeType, tlsEnabled)}
 	} else if probe.Handler.HTTPGet != nil {
-		setDefaultHTTPGetValues(&probe, runtimeType, probeType)
+		setDefaultHTTPGetValues(&probe, runtimeType, probeType, tlsEnabled)
 	}
 	// Remaining case is where probe handler is set to TCP by user.
 	// Port is required in YAML so need further values need to be set.
@@ -82,13 +90,16 @@ func getProbe(probe corev1.Probe, runtimeType api.RuntimeType, probeType ProbeTy
 }
 
 // setDefaultHTTPGetValues sets default HTTPGetAction values for the handler if not set already. This prevents reconciliation loops.
-func setDefaultHTTPGetValues(probe *corev1.Probe, runtimeType api.RuntimeType, probeType ProbeType) {
+func setDefaultHTTPGetValues(probe *corev1.Probe, runtimeType api.RuntimeType, probeType ProbeType, tlsEnabled bool) {
 	if probe.Handler.HTTPGet.Path == "" {
 		probe.Handler.HTTPGet.Path = getDefaultHTTPPath(runtimeType, probeType)
 	}
 	// port not needed to be set since it is a mandatory field for HTTPGetAction enforced at YAML level
 	if probe.Handler.HTTPGet.Scheme == "" {
 		probe.Handler.HTTPGet.Scheme = corev1.URISchemeHTTP
+		if tlsEnabled {
+			probe.Handler.HTTPGet.Scheme = corev1.URISchemeHTTPS
+		}
 	}
 }
 
@@ -107,8 +118,11 @@ func getDefaultHTTPPath(runtimeType api.RuntimeType, probeType ProbeType) string
 	return quarkusProbeReadinessPath
 }
 
-func getDefaultHTTPGetAction(runtimeType api.RuntimeType, probeType ProbeType) *corev1.HTTPGetAction {
+func getDefaultHTTPGetAction(runtimeType api.RuntimeType, probeType ProbeType, tlsEnabled bool) *corev1.HTTPGetAction {
 	httpGetAction := defaultHTTPGetAction.DeepCopy()
+	if tlsEnabled {
+		httpGetAction = defaultHTTPSGetAction.DeepCopy()
+	}
 	httpGetAction.Path = getDefaultHTTPPath(runtimeType, probeType)
 	return httpGetAction
 }
@@ -23,11 +23,15 @@ import (
 	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	monv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
-const prometheusServerGroup = "monitoring.coreos.com"
+const (
+	prometheusServerGroup = "monitoring.coreos.com"
+	monitoringHTTPSScheme = "https"
+)
 
 // PrometheusManager ...
 type PrometheusManager interface {
@@ -84,7 +88,11 @@ func (m *prometheusManager) isPrometheusAddOnAvailable(kogitoService api.KogitoS
 	kogitoServiceHandler := NewKogitoServiceHandler(m.Context)
 	url := kogitoServiceHandler.GetKogitoServiceEndpoint(kogitoService)
 	url = url + getMonitoringPath(kogitoService.GetSpec().GetMonitoring())
-	if resp, err := http.Head(url); err != nil {
+	httpClient, err := kogitoServiceHandler.GetKogitoServiceHTTPClient(kogitoService)
+	if err != nil {
+		return false, err
+	}
+	if resp, err := httpClient.Head(url); err != nil {
 		return false, err
 	} else if resp.StatusCode == http.StatusOK {
 		return true, nil
@@ -128,6 +136,12 @@ func (m *prometheusManager) createServiceMonitor(kogitoService api.KogitoService
 	endPoint := monv1.Endpoint{}
 	endPoint.Path = getMonitoringPath(monitoring)
 	endPoint.Scheme = getMonitoringScheme(monitoring)
+	if infrastructure.IsTLSEnabled(kogitoService) {
+		if len(monitoring.GetScheme()) == 0 {
+			endPoint.Scheme = monitoringHTTPSScheme
+		}
+		endPoint.TLSConfig = getMonitoringTLSConfig(kogitoService)
+	}
 
 	serviceSelectorLabels := make(map[string]string)
 	serviceSelectorLabels[framework.LabelAppKey] = kogitoService.GetName()
@@ -182,3 +196,20 @@ func getMonitoringScheme(monitoring api.MonitoringInterface) string {
 	}
 	return scheme
 }
+
+// getMonitoringTLSConfig gets the configuration of Prometheus to trust the serving certificate of the service
+func getMonitoringTLSConfig(kogitoService api.KogitoService) *monv1.TLSConfig {
+	tlsConfig := &monv1.TLSConfig{SafeTLSConfig: monv1.SafeTLSConfig{ServerName: infrastructure.GetKogitoServiceHostName(kogitoService)}}
+	if kogitoService.GetSpec().GetTLS().GetProvider() == api.OpenShiftCertificateProvider {
+		tlsConfig.CA.ConfigMap = &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: infrastructure.GetServiceCAConfigMapName(kogitoService)},
+			Key:                  infrastructure.ServiceCABundleKey,
+		}
+	} else {
+		tlsConfig.CA.Secret = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: infrastructure.GetTLSSecretName(kogitoService)},
+			Key:                  infrastructure.TLSCACertKey,
+		}
+	}
+	return tlsConfig
+}
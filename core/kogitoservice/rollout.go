@@ -0,0 +1,156 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// candidateSuffix is appended to the name of the resources running the new image of a service while it's rolled out
+	candidateSuffix = "-candidate"
+	// fullTrafficWeight is the weight of a backend receiving all the traffic of the service
+	fullTrafficWeight = int32(100)
+)
+
+// RolloutStatus describes the progress of the rollout of a new image of a Kogito Service
+type RolloutStatus struct {
+	// Reason of the current phase of the rollout
+	Reason infrastructure.ConditionReason
+	// CandidateWeight is the percentage of the traffic routed to the candidate Deployment running the new image
+	CandidateWeight int32
+	// CandidateImage is the new image run by the candidate Deployment, to be promoted
+	CandidateImage string
+}
+
+// IsInProgress returns true while a candidate Deployment runs the new image next to the current one
+func (r *RolloutStatus) IsInProgress() bool {
+	return r.Reason != infrastructure.RolloutCompletedReason
+}
+
+// RolloutHandler ...
+type RolloutHandler interface {
+	// GetRollout gets the rollout strategy of the given service. Nil when its new images simply replace the current ones.
+	GetRollout(instance api.KogitoService) api.RolloutInterface
+	// FetchCandidateDeployment fetches the Deployment running the new image of the given service while it's rolled out
+	FetchCandidateDeployment(instance api.KogitoService) (*appsv1.Deployment, error)
+	// FetchRolloutStatus fetches the progress of the rollout of a new image of the given service
+	FetchRolloutStatus(instance api.KogitoService) (*RolloutStatus, error)
+}
+
+type rolloutHandler struct {
+	operator.Context
+	deploymentHandler infrastructure.DeploymentHandler
+}
+
+// NewRolloutHandler ...
+func NewRolloutHandler(context operator.Context) RolloutHandler {
+	return &rolloutHandler{
+		Context:           context,
+		deploymentHandler: infrastructure.NewDeploymentHandler(context),
+	}
+}
+
+func (r *rolloutHandler) GetRollout(instance api.KogitoService) api.RolloutInterface {
+	runtimeSpec, ok := instance.GetSpec().(api.KogitoRuntimeSpecInterface)
+	if !ok {
+		return nil
+	}
+	rollout := runtimeSpec.GetRollout()
+	// Knative Services roll out their new Revisions by themselves
+	if rollout == nil || rollout.GetType() == api.RollingUpdateRolloutType || isKnativeServiceMode(instance) {
+		return nil
+	}
+	return rollout
+}
+
+func (r *rolloutHandler) FetchCandidateDeployment(instance api.KogitoService) (*appsv1.Deployment, error) {
+	return r.deploymentHandler.FetchDeployment(types.NamespacedName{Name: getCandidateName(instance), Namespace: instance.GetNamespace()})
+}
+
+func (r *rolloutHandler) FetchRolloutStatus(instance api.KogitoService) (*RolloutStatus, error) {
+	rollout := r.GetRollout(instance)
+	if rollout == nil {
+		return &RolloutStatus{Reason: infrastructure.RolloutCompletedReason}, nil
+	}
+	candidate, err := r.FetchCandidateDeployment(instance)
+	if err != nil {
+		return nil, err
+	}
+	return newRolloutStatus(rollout, candidate), nil
+}
+
+// newRolloutStatus resolves the phase of the rollout and the share of traffic of the candidate Deployment, if any
+func newRolloutStatus(rollout api.RolloutInterface, candidate *appsv1.Deployment) *RolloutStatus {
+	if candidate == nil {
+		return &RolloutStatus{Reason: infrastructure.RolloutCompletedReason}
+	}
+	candidateImage := ""
+	if len(candidate.Spec.Template.Spec.Containers) > 0 {
+		candidateImage = candidate.Spec.Template.Spec.Containers[0].Image
+	}
+	if candidate.Status.AvailableReplicas == 0 {
+		return &RolloutStatus{Reason: infrastructure.RolloutCandidateDeployingReason, CandidateImage: candidateImage}
+	}
+	if len(candidateImage) > 0 && rollout.GetPromotedImage() == candidateImage {
+		return &RolloutStatus{Reason: infrastructure.RolloutPromotingReason, CandidateWeight: fullTrafficWeight, CandidateImage: candidateImage}
+	}
+	if rollout.GetType() == api.CanaryRolloutType {
+		return &RolloutStatus{Reason: infrastructure.RolloutCanaryReason, CandidateWeight: rollout.GetCanaryWeight(), CandidateImage: candidateImage}
+	}
+	return &RolloutStatus{Reason: infrastructure.RolloutAwaitingPromotionReason, CandidateImage: candidateImage}
+}
+
+// getCandidateName gets the name of the resources running the new image of the given service while it's rolled out
+func getCandidateName(instance api.KogitoService) string {
+	return instance.GetName() + candidateSuffix
+}
+
+// isRolledOut returns true when all the replicas of the given Deployment run its current template with the given image
+func isRolledOut(deployment *appsv1.Deployment, image string) bool {
+	if deployment == nil || len(deployment.Spec.Template.Spec.Containers) == 0 ||
+		deployment.Spec.Template.Spec.Containers[0].Image != image {
+		return false
+	}
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas > 0 &&
+		deployment.Status.UpdatedReplicas == deployment.Status.Replicas &&
+		deployment.Status.AvailableReplicas == deployment.Status.UpdatedReplicas
+}
+
+// toCandidate renames the given Deployment into the candidate one, selecting only its own pods
+func toCandidate(instance api.KogitoService, deployment *appsv1.Deployment) {
+	name := getCandidateName(instance)
+	deployment.Name = name
+	deployment.Labels = withAppLabel(deployment.Labels, name)
+	deployment.Spec.Template.Labels = withAppLabel(deployment.Spec.Template.Labels, name)
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{framework.LabelAppKey: name}}
+}
+
+// withAppLabel copies the given labels, the maps might be shared with the current resources, setting the app label to the given name
+func withAppLabel(labels map[string]string, name string) map[string]string {
+	copied := make(map[string]string, len(labels)+1)
+	for key, value := range labels {
+		copied[key] = value
+	}
+	copied[framework.LabelAppKey] = name
+	return copied
+}
@@ -0,0 +1,188 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_newRolloutStatus(t *testing.T) {
+	available := &appsv1.Deployment{
+		Spec:   appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Image: "test:2.0"}}}}},
+		Status: appsv1.DeploymentStatus{AvailableReplicas: 1},
+	}
+	tests := []struct {
+		name      string
+		rollout   *v1beta1.Rollout
+		candidate *appsv1.Deployment
+		want      RolloutStatus
+	}{
+		{"NoCandidate", &v1beta1.Rollout{Type: api.CanaryRolloutType, CanaryWeight: 10}, nil, RolloutStatus{Reason: infrastructure.RolloutCompletedReason}},
+		{"CandidateNotAvailable", &v1beta1.Rollout{Type: api.CanaryRolloutType, CanaryWeight: 10}, &appsv1.Deployment{}, RolloutStatus{Reason: infrastructure.RolloutCandidateDeployingReason}},
+		{"Canary", &v1beta1.Rollout{Type: api.CanaryRolloutType, CanaryWeight: 10}, available, RolloutStatus{Reason: infrastructure.RolloutCanaryReason, CandidateWeight: 10, CandidateImage: "test:2.0"}},
+		{"BlueGreen", &v1beta1.Rollout{Type: api.BlueGreenRolloutType}, available, RolloutStatus{Reason: infrastructure.RolloutAwaitingPromotionReason, CandidateImage: "test:2.0"}},
+		{"PreviousImagePromoted", &v1beta1.Rollout{Type: api.BlueGreenRolloutType, PromotedImage: "test:1.0"}, available, RolloutStatus{Reason: infrastructure.RolloutAwaitingPromotionReason, CandidateImage: "test:2.0"}},
+		{"Promoting", &v1beta1.Rollout{Type: api.BlueGreenRolloutType, PromotedImage: "test:2.0"}, available, RolloutStatus{Reason: infrastructure.RolloutPromotingReason, CandidateWeight: 100, CandidateImage: "test:2.0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, *newRolloutStatus(tt.rollout, tt.candidate))
+		})
+	}
+}
+
+func TestDeploymentReconciler_CanaryHoldsCurrentImage(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Rollout = &v1beta1.Rollout{Type: api.CanaryRolloutType, CanaryWeight: 20}
+	deployed := createFakeRolloutDeployment(instance.Name, ns, "quay.io/kiegroup/test-image:1.0")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, deployed).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	image := &api.Image{Domain: "quay.io/kiegroup", Name: "test-image", Tag: "2.0"}
+	imageHandler := infrastructure.NewImageHandler(context, image, "default-image", "image-stream", ns, false, false)
+	deploymentReconciler := newDeploymentReconciler(context, instance, ServiceDefinition{}, imageHandler)
+	assert.NoError(t, deploymentReconciler.Reconcile())
+
+	current := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(current)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "quay.io/kiegroup/test-image:1.0", current.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, instance.Name, current.Spec.Template.Labels[framework.LabelAppKey])
+
+	candidate := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: instance.Name + candidateSuffix, Namespace: ns}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(candidate)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "quay.io/kiegroup/test-image:2.0", candidate.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, candidate.Name, candidate.Spec.Selector.MatchLabels[framework.LabelAppKey])
+	assert.Equal(t, candidate.Name, candidate.Spec.Template.Labels[framework.LabelAppKey])
+}
+
+func TestDeploymentReconciler_PromoteRemovesRolledOutCandidate(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Rollout = &v1beta1.Rollout{Type: api.BlueGreenRolloutType, PromotedImage: "quay.io/kiegroup/test-image:2.0"}
+	deployed := createFakeRolloutDeployment(instance.Name, ns, "quay.io/kiegroup/test-image:2.0")
+	deployed.Status = appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}
+	candidate := createFakeRolloutDeployment(instance.Name+candidateSuffix, ns, "quay.io/kiegroup/test-image:2.0")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, deployed, candidate).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	image := &api.Image{Domain: "quay.io/kiegroup", Name: "test-image", Tag: "2.0"}
+	imageHandler := infrastructure.NewImageHandler(context, image, "default-image", "image-stream", ns, false, false)
+	deploymentReconciler := newDeploymentReconciler(context, instance, ServiceDefinition{}, imageHandler)
+	assert.NoError(t, deploymentReconciler.Reconcile())
+
+	exists, err := kubernetes.ResourceC(cli).Fetch(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: candidate.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestDeploymentReconciler_PromotionAppliesToPromotedImageOnly(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Rollout = &v1beta1.Rollout{Type: api.BlueGreenRolloutType, PromotedImage: "quay.io/kiegroup/test-image:2.0"}
+	deployed := createFakeRolloutDeployment(instance.Name, ns, "quay.io/kiegroup/test-image:2.0")
+	cli := test.NewFakeClientBuilder().OnOpenShift().AddK8sObjects(instance, deployed).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	image := &api.Image{Domain: "quay.io/kiegroup", Name: "test-image", Tag: "3.0"}
+	imageHandler := infrastructure.NewImageHandler(context, image, "default-image", "image-stream", ns, false, false)
+	deploymentReconciler := newDeploymentReconciler(context, instance, ServiceDefinition{}, imageHandler).(*deploymentReconciler)
+	resources, err := deploymentReconciler.createRequiredResources("quay.io/kiegroup/test-image:3.0",
+		map[reflect.Type][]client.Object{reflect.TypeOf(appsv1.Deployment{}): {deployed}})
+	assert.NoError(t, err)
+
+	deployments := resources[reflect.TypeOf(appsv1.Deployment{})]
+	assert.Len(t, deployments, 2)
+	current := deployments[0].(*appsv1.Deployment)
+	assert.Equal(t, "quay.io/kiegroup/test-image:2.0", current.Spec.Template.Spec.Containers[0].Image)
+	// the new images pushed to the image stream must not reach the current Deployment
+	_, pausedTrigger := imageHandler.ResolvePausedImageStreamTriggerAnnotation(instance.Name)
+	assert.Contains(t, pausedTrigger, `"paused":"true"`)
+	assert.Equal(t, pausedTrigger, current.Annotations["image.openshift.io/triggers"])
+	candidate := deployments[1].(*appsv1.Deployment)
+	assert.Equal(t, "quay.io/kiegroup/test-image:3.0", candidate.Spec.Template.Spec.Containers[0].Image)
+	assert.NotContains(t, candidate.Annotations["image.openshift.io/triggers"], "paused")
+}
+
+func TestRouteReconciler_OpenshiftCanary(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Rollout = &v1beta1.Rollout{Type: api.CanaryRolloutType, CanaryWeight: 20}
+	candidate := createFakeRolloutDeployment(instance.Name+candidateSuffix, ns, "quay.io/kiegroup/test-image:2.0")
+	candidate.Status.AvailableReplicas = 1
+	cli := test.NewFakeClientBuilder().OnOpenShift().AddK8sObjects(instance, candidate).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newServiceReconciler(context, instance).Reconcile())
+	assert.NoError(t, newRouteReconciler(context, instance).Reconcile())
+
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: candidate.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(service)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, candidate.Name, service.Spec.Selector[framework.LabelAppKey])
+
+	route := &routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(route)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, int32(80), *route.Spec.To.Weight)
+	assert.Len(t, route.Spec.AlternateBackends, 1)
+	assert.Equal(t, candidate.Name, route.Spec.AlternateBackends[0].Name)
+	assert.Equal(t, int32(20), *route.Spec.AlternateBackends[0].Weight)
+}
+
+func createFakeRolloutDeployment(name, namespace, image string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: name, Image: image}}},
+			},
+		},
+	}
+}
@@ -35,6 +35,7 @@ type routeReconciler struct {
 	operator.Context
 	instance       api.KogitoService
 	routeHandler   infrastructure.RouteHandler
+	rolloutHandler RolloutHandler
 	deltaProcessor infrastructure.DeltaProcessor
 }
 
@@ -43,6 +44,7 @@ func newRouteReconciler(context operator.Context, instance api.KogitoService) Ro
 		Context:        context,
 		instance:       instance,
 		routeHandler:   infrastructure.NewRouteHandler(context),
+		rolloutHandler: NewRolloutHandler(context),
 		deltaProcessor: infrastructure.NewDeltaProcessor(context),
 	}
 }
@@ -81,7 +83,17 @@ func (i *routeReconciler) Reconcile() error {
 
 func (i *routeReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
 	resources := make(map[reflect.Type][]client.Object)
+	if isKnativeServiceMode(i.instance) {
+		i.Log.Debug("Skipping route creation. The service is exposed by Knative.")
+		return resources, nil
+	}
 	route := i.routeHandler.CreateRoute(i.instance)
+	if err := i.setRolloutBackends(route); err != nil {
+		return nil, err
+	}
+	if err := i.setDestinationCACertificate(route); err != nil {
+		return nil, err
+	}
 	if err := framework.SetOwner(i.instance, i.Scheme, route); err != nil {
 		return nil, err
 	}
@@ -89,6 +101,42 @@ func (i *routeReconciler) createRequiredResources() (map[reflect.Type][]client.O
 	return resources, nil
 }
 
+// setDestinationCACertificate sets the CA the router trusts to open the TLS connection to the service.
+// The router already trusts the OpenShift service CA.
+func (i *routeReconciler) setDestinationCACertificate(route *v1.Route) error {
+	if route.Spec.TLS == nil || i.instance.GetSpec().GetTLS().GetProvider() == api.OpenShiftCertificateProvider {
+		return nil
+	}
+	caCert, err := infrastructure.NewTLSHandler(i.Context).FetchCACertificate(i.instance)
+	if err != nil {
+		return err
+	}
+	route.Spec.TLS.DestinationCACertificate = string(caCert)
+	return nil
+}
+
+// setRolloutBackends splits the traffic of the Route between the current and the candidate Service while a new image is rolled out
+func (i *routeReconciler) setRolloutBackends(route *v1.Route) error {
+	rolloutStatus, err := i.rolloutHandler.FetchRolloutStatus(i.instance)
+	if err != nil {
+		return err
+	}
+	if rolloutStatus.CandidateWeight == 0 {
+		return nil
+	}
+	currentWeight := fullTrafficWeight - rolloutStatus.CandidateWeight
+	candidateWeight := rolloutStatus.CandidateWeight
+	route.Spec.To.Weight = &currentWeight
+	route.Spec.AlternateBackends = []v1.RouteTargetReference{
+		{
+			Kind:   route.Spec.To.Kind,
+			Name:   getCandidateName(i.instance),
+			Weight: &candidateWeight,
+		},
+	}
+	return nil
+}
+
 func (i *routeReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
 	resources := make(map[reflect.Type][]client.Object)
 	route, err := i.routeHandler.FetchRoute(types.NamespacedName{Name: i.instance.GetName(), Namespace: i.instance.GetNamespace()})
@@ -20,6 +20,7 @@ import (
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"reflect"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,6 +35,7 @@ type serviceReconciler struct {
 	operator.Context
 	instance       api.KogitoService
 	serviceHandler infrastructure.ServiceHandler
+	rolloutHandler RolloutHandler
 	deltaProcessor infrastructure.DeltaProcessor
 }
 
@@ -42,6 +44,7 @@ func newServiceReconciler(context operator.Context, instance api.KogitoService)
 		Context:        context,
 		instance:       instance,
 		serviceHandler: infrastructure.NewServiceHandler(context),
+		rolloutHandler: NewRolloutHandler(context),
 		deltaProcessor: infrastructure.NewDeltaProcessor(context),
 	}
 }
@@ -70,11 +73,33 @@ func (i *serviceReconciler) Reconcile() error {
 
 func (i *serviceReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
 	resources := make(map[reflect.Type][]client.Object)
+	if isKnativeServiceMode(i.instance) {
+		i.Log.Debug("Skipping Service creation. The service is deployed as a Knative Service.")
+		return resources, nil
+	}
 	service := i.serviceHandler.CreateService(i.instance)
 	if err := framework.SetOwner(i.instance, i.Scheme, service); err != nil {
 		return nil, err
 	}
 	resources[reflect.TypeOf(v1.Service{})] = []client.Object{service}
+
+	// the candidate Deployment running a new image being rolled out is reachable through its own Service
+	candidateDeployment, err := i.rolloutHandler.FetchCandidateDeployment(i.instance)
+	if err != nil {
+		return nil, err
+	}
+	if candidateDeployment != nil {
+		candidate := i.serviceHandler.CreateService(i.instance)
+		candidate.Name = getCandidateName(i.instance)
+		candidate.Labels = withAppLabel(candidate.Labels, candidate.Name)
+		candidate.Spec.Selector = map[string]string{framework.LabelAppKey: candidate.Name}
+		// the serving certificate of the service is requested once, the candidate pods mount the same one
+		delete(candidate.Annotations, infrastructure.ServingCertSecretAnnotation)
+		if err := framework.SetOwner(i.instance, i.Scheme, candidate); err != nil {
+			return nil, err
+		}
+		resources[reflect.TypeOf(v1.Service{})] = append(resources[reflect.TypeOf(v1.Service{})], candidate)
+	}
 	return resources, nil
 }
 
@@ -84,9 +109,17 @@ func (i *serviceReconciler) getDeployedResources() (map[reflect.Type][]client.Ob
 	if err != nil {
 		return nil, err
 	}
-	if service != nil {
+	// Knative manages its own Service named after the Knative Service, left untouched
+	if service != nil && (!isKnativeServiceMode(i.instance) || metav1.IsControlledBy(service, i.instance)) {
 		resources[reflect.TypeOf(v1.Service{})] = []client.Object{service}
 	}
+	candidate, err := i.serviceHandler.FetchService(types.NamespacedName{Name: getCandidateName(i.instance), Namespace: i.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if candidate != nil {
+		resources[reflect.TypeOf(v1.Service{})] = append(resources[reflect.TypeOf(v1.Service{})], candidate)
+	}
 	return resources, nil
 }
 
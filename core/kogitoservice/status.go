@@ -16,10 +16,13 @@ package kogitoservice
 
 import (
 	"fmt"
+	"time"
 
 	api "github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	servingv1 "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
+	"github.com/kiegroup/kogito-operator/core/metrics"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +49,7 @@ func NewStatusHandler(context operator.Context) StatusHandler {
 
 func (s *statusHandler) HandleStatusUpdate(instance api.KogitoService, err *error) {
 	s.Log.Info("Updating status for Kogito Service", "err", err)
+	s.recordReconcile(instance, *err)
 	if statusErr := s.ensureResourcesStatusChanges(instance, *err); statusErr != nil {
 		s.Log.Error(statusErr, "Error while updating Status for Kogito Service")
 		return
@@ -57,10 +61,18 @@ func (s *statusHandler) ensureResourcesStatusChanges(instance api.KogitoService,
 	if instance.GetStatus().GetConditions() == nil {
 		instance.GetStatus().SetConditions(&[]metav1.Condition{})
 	}
+	var previousDeployedCondition *metav1.Condition
+	if deployedCondition := meta.FindStatusCondition(*instance.GetStatus().GetConditions(), string(api.DeployedConditionType)); deployedCondition != nil {
+		previousDeployedCondition = deployedCondition.DeepCopy()
+	}
 	if errCondition != nil {
 		if err = s.setFailedConditions(instance, s.errorHandler.GetReasonForError(errCondition), errCondition); err != nil {
 			return err
 		}
+	} else if isKnativeServiceMode(instance) {
+		if err = s.updateKnativeServiceStatus(instance); err != nil {
+			return err
+		}
 	} else {
 		if err = s.handleConditionTransition(instance); err != nil {
 			return err
@@ -71,17 +83,52 @@ func (s *statusHandler) ensureResourcesStatusChanges(instance api.KogitoService,
 		if err = s.updateRouteStatus(instance); err != nil {
 			return err
 		}
+		if err = s.updateIngressStatus(instance); err != nil {
+			return err
+		}
 		if err = s.updateDeploymentStatus(instance); err != nil {
 			return err
 		}
+		if err = s.updateRolloutStatus(instance); err != nil {
+			return err
+		}
 	}
 	if err := s.updateStatus(instance); err != nil {
 		s.Log.Error(err, "Error while trying to update status")
 		return err
 	}
+	s.recordTimeToDeployed(instance, previousDeployedCondition)
 	return nil
 }
 
+// recordReconcile counts the reconciliation of the given instance, with the reason of its error
+func (s *statusHandler) recordReconcile(instance api.KogitoService, err error) {
+	result := metrics.ReconcileSuccess
+	if err != nil {
+		result = metrics.ReconcileFailure
+		if s.errorHandler.IsReconciliationError(err) {
+			result = metrics.ReconcileRequeue
+		}
+	}
+	metrics.RecordReconcile(metrics.GetKind(instance, s.Scheme), result, string(s.errorHandler.GetReasonForError(err)))
+}
+
+// recordTimeToDeployed observes how long the given instance took to become deployed once it transitions to the Deployed state,
+// either since its creation or since it was last not deployed
+func (s *statusHandler) recordTimeToDeployed(instance api.KogitoService, previousDeployedCondition *metav1.Condition) {
+	if previousDeployedCondition != nil && previousDeployedCondition.Status == metav1.ConditionTrue {
+		return
+	}
+	if !meta.IsStatusConditionTrue(*instance.GetStatus().GetConditions(), string(api.DeployedConditionType)) {
+		return
+	}
+	since := instance.GetCreationTimestamp()
+	if previousDeployedCondition != nil {
+		since = previousDeployedCondition.LastTransitionTime
+	}
+	metrics.ObserveTimeToDeployed(metrics.GetKind(instance, s.Scheme), time.Since(since.Time))
+}
+
 func (s *statusHandler) setFailedConditions(instance api.KogitoService, reason infrastructure.ConditionReason, errCondition error) error {
 	s.setFailed(instance.GetStatus().GetConditions(), metav1.ConditionTrue, reason, errCondition.Error())
 	if s.errorHandler.IsReconciliationError(errCondition) {
@@ -90,11 +137,11 @@ func (s *statusHandler) setFailedConditions(instance api.KogitoService, reason i
 		s.setProvisioning(instance.GetStatus().GetConditions(), metav1.ConditionFalse, infrastructure.FailedProvisioningReason)
 	}
 
-	availableReplicas, err := s.fetchReadyReplicas(instance)
+	isDeployed, err := s.isDeployed(instance)
 	if err != nil {
 		return err
 	}
-	if availableReplicas > 0 {
+	if isDeployed {
 		s.setDeployed(instance.GetStatus().GetConditions(), metav1.ConditionTrue)
 	} else {
 		s.setDeployed(instance.GetStatus().GetConditions(), metav1.ConditionFalse)
@@ -108,7 +155,10 @@ func (s *statusHandler) handleConditionTransition(instance api.KogitoService) er
 	if err != nil {
 		return err
 	}
-	expectedReplicas := *instance.GetSpec().GetReplicas()
+	expectedReplicas, err := s.getExpectedReplicas(instance)
+	if err != nil {
+		return err
+	}
 	if expectedReplicas == availableReplicas {
 		s.setDeployed(instance.GetStatus().GetConditions(), metav1.ConditionTrue)
 		s.setProvisioning(instance.GetStatus().GetConditions(), metav1.ConditionFalse, infrastructure.FinishedProvisioningReason)
@@ -122,6 +172,81 @@ func (s *statusHandler) handleConditionTransition(instance api.KogitoService) er
 	return nil
 }
 
+// isDeployed checks if the service has at least one pod available, or a ready Revision when it's deployed as a Knative Service
+func (s *statusHandler) isDeployed(instance api.KogitoService) (bool, error) {
+	if isKnativeServiceMode(instance) {
+		knativeService, err := s.fetchKnativeService(instance)
+		if err != nil {
+			return false, err
+		}
+		return knativeService != nil && knativeService.IsReady(), nil
+	}
+	availableReplicas, err := s.fetchReadyReplicas(instance)
+	if err != nil {
+		return false, err
+	}
+	return availableReplicas > 0, nil
+}
+
+// updateKnativeServiceStatus reports the service as deployed once the latest Revision of its Knative Service is ready.
+// The pods of the Revision can be scaled to zero, so their replicas aren't considered.
+func (s *statusHandler) updateKnativeServiceStatus(instance api.KogitoService) error {
+	s.InvalidateFailedCondition(instance.GetStatus().GetConditions())
+	knativeService, err := s.fetchKnativeService(instance)
+	if err != nil {
+		return err
+	}
+	if knativeService != nil && knativeService.IsReady() {
+		s.setDeployed(instance.GetStatus().GetConditions(), metav1.ConditionTrue)
+		s.setProvisioning(instance.GetStatus().GetConditions(), metav1.ConditionFalse, infrastructure.FinishedProvisioningReason)
+	} else {
+		s.setDeployed(instance.GetStatus().GetConditions(), metav1.ConditionFalse)
+		s.setProvisioning(instance.GetStatus().GetConditions(), metav1.ConditionTrue, infrastructure.ProvisioningInProgressReason)
+	}
+	if knativeService == nil {
+		// the URI of a previously deployed Ingress or Knative Service is stale
+		instance.GetStatus().SetExternalURI("")
+		return nil
+	}
+	if len(knativeService.Spec.Template.Spec.Containers) > 0 {
+		instance.GetStatus().SetImage(knativeService.Spec.Template.Spec.Containers[0].Image)
+	}
+	if knativeService.Status.URL != nil {
+		instance.GetStatus().SetExternalURI(knativeService.Status.URL.String())
+	} else {
+		instance.GetStatus().SetExternalURI("")
+	}
+	return nil
+}
+
+func (s *statusHandler) fetchKnativeService(instance api.KogitoService) (*servingv1.Service, error) {
+	knativeServiceHandler := infrastructure.NewKnativeServiceHandler(s.Context)
+	if !knativeServiceHandler.IsKnativeServingAvailable() {
+		return nil, nil
+	}
+	return knativeServiceHandler.FetchKnativeService(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+}
+
+// getExpectedReplicas gets the number of replicas the service should have.
+// When autoscaling is enabled, it's the number desired by the HorizontalPodAutoscaler.
+func (s *statusHandler) getExpectedReplicas(instance api.KogitoService) (int32, error) {
+	expectedReplicas := *instance.GetSpec().GetReplicas()
+	if !instance.GetSpec().IsAutoscalingEnabled() {
+		return expectedReplicas, nil
+	}
+	hpaHandler := infrastructure.NewHorizontalPodAutoscalerHandler(s.Context)
+	hpa, err := hpaHandler.FetchHorizontalPodAutoscaler(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	if err != nil || hpa == nil {
+		return expectedReplicas, err
+	}
+	if hpa.Status.DesiredReplicas > 0 {
+		return hpa.Status.DesiredReplicas, nil
+	} else if hpa.Spec.MinReplicas != nil {
+		return *hpa.Spec.MinReplicas, nil
+	}
+	return expectedReplicas, nil
+}
+
 func (s *statusHandler) updateStatus(instance api.KogitoService) error {
 	err := kubernetes.ResourceC(s.Client).UpdateStatus(instance)
 	if err != nil {
@@ -157,6 +282,29 @@ func (s *statusHandler) updateDeploymentStatus(instance api.KogitoService) error
 	return nil
 }
 
+// updateRolloutStatus reports the phase of the rollout of a new image of the service, if it has a rollout strategy
+func (s *statusHandler) updateRolloutStatus(instance api.KogitoService) error {
+	rolloutHandler := NewRolloutHandler(s.Context)
+	if rolloutHandler.GetRollout(instance) == nil &&
+		meta.FindStatusCondition(*instance.GetStatus().GetConditions(), string(api.RollingOutConditionType)) == nil {
+		return nil
+	}
+	rolloutStatus, err := rolloutHandler.FetchRolloutStatus(instance)
+	if err != nil {
+		return err
+	}
+	status := metav1.ConditionFalse
+	if rolloutStatus.IsInProgress() {
+		status = metav1.ConditionTrue
+	}
+	message := ""
+	if rolloutStatus.IsInProgress() && len(rolloutStatus.CandidateImage) > 0 {
+		message = fmt.Sprintf("Candidate image %s, set it to spec.rollout.promotedImage to promote it", rolloutStatus.CandidateImage)
+	}
+	s.setRollingOut(instance.GetStatus().GetConditions(), status, rolloutStatus.Reason, message)
+	return nil
+}
+
 func (s *statusHandler) updateRouteStatus(instance api.KogitoService) error {
 	if s.Client.IsOpenshift() {
 		if instance.GetStatus().GetRouteConditions() == nil {
@@ -191,13 +339,35 @@ func (s *statusHandler) updateRouteStatus(instance api.KogitoService) error {
 		}
 
 		if len(route) > 0 {
-			uri := fmt.Sprintf("http://%s", route)
+			scheme := "http"
+			if infrastructure.IsTLSEnabled(instance) {
+				scheme = "https"
+			}
+			uri := fmt.Sprintf("%s://%s", scheme, route)
 			instance.GetStatus().SetExternalURI(uri)
 		}
 	}
 	return nil
 }
 
+// updateIngressStatus sets the external URI from the Ingress of the service, it's cleared when no Ingress is deployed
+func (s *statusHandler) updateIngressStatus(instance api.KogitoService) error {
+	if s.Client.IsOpenshift() {
+		return nil
+	}
+	if instance.GetSpec().IsRouteDisabled() {
+		instance.GetStatus().SetExternalURI("")
+		return nil
+	}
+	ingressHandler := infrastructure.NewIngressHandler(s.Context)
+	uri, err := ingressHandler.GetURIFromIngress(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	if err != nil {
+		return err
+	}
+	instance.GetStatus().SetExternalURI(uri)
+	return nil
+}
+
 // NewDeployedCondition ...
 func (s *statusHandler) newDeployedCondition(status metav1.ConditionStatus) metav1.Condition {
 	reason := infrastructure.SuccessfulDeployedReason
@@ -220,6 +390,16 @@ func (s *statusHandler) newProvisioningCondition(status metav1.ConditionStatus,
 	}
 }
 
+// NewRollingOutCondition ...
+func (s *statusHandler) newRollingOutCondition(status metav1.ConditionStatus, reason infrastructure.ConditionReason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:    string(api.RollingOutConditionType),
+		Status:  status,
+		Reason:  string(reason),
+		Message: message,
+	}
+}
+
 // NewFailedCondition ...
 func (s *statusHandler) newFailedCondition(status metav1.ConditionStatus, reason infrastructure.ConditionReason, message string) metav1.Condition {
 	return metav1.Condition{
@@ -248,6 +428,12 @@ func (s *statusHandler) setFailed(conditions *[]metav1.Condition, status metav1.
 	meta.SetStatusCondition(conditions, failedCondition)
 }
 
+// setRollingOut Sets the condition type to RollingOut with the phase of the rollout as reason.
+func (s *statusHandler) setRollingOut(conditions *[]metav1.Condition, status metav1.ConditionStatus, reason infrastructure.ConditionReason, message string) {
+	rollingOutCondition := s.newRollingOutCondition(status, reason, message)
+	meta.SetStatusCondition(conditions, rollingOutCondition)
+}
+
 func (s *statusHandler) InvalidateFailedCondition(conditions *[]metav1.Condition) {
 	failedCondition := meta.FindStatusCondition(*conditions, string(api.FailedConditionType))
 	if failedCondition != nil {
@@ -19,16 +19,39 @@ import (
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	servingv1 "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/test"
 	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	meta2 "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	knativeapis "knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"testing"
 )
 
+// getTimeToDeployedCount gets the number of times the Kogito services of the given kind became deployed
+func getTimeToDeployedCount(t *testing.T, kind string) uint64 {
+	families, err := ctrlmetrics.Registry.Gather()
+	assert.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != "kogito_operator_time_to_deployed_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if metric.GetLabel()[0].GetValue() == kind {
+				return metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return 0
+}
+
 func TestReconciliation_ErrorOccur(t *testing.T) {
 	instance := test.CreateFakeDataIndex(t.Name())
 	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
@@ -188,6 +211,7 @@ func TestReconciliation_PodAlreadyRunning(t *testing.T) {
 		Scheme: meta.GetRegisteredSchema(),
 	}
 	statusHandler := NewStatusHandler(context)
+	timeToDeployedCount := getTimeToDeployedCount(t, "KogitoSupportingService")
 	var err error = nil
 	statusHandler.HandleStatusUpdate(instance, &err)
 	assert.NotNil(t, instance)
@@ -205,8 +229,98 @@ func TestReconciliation_PodAlreadyRunning(t *testing.T) {
 	deployedCondition := getSpecificCondition(conditions, api.DeployedConditionType)
 	assert.NotNil(t, deployedCondition)
 	assert.Equal(t, metav1.ConditionTrue, deployedCondition.Status)
+	assert.Equal(t, timeToDeployedCount+1, getTimeToDeployedCount(t, "KogitoSupportingService"))
+
+	// the service was already deployed
+	statusHandler.HandleStatusUpdate(instance, &err)
+	assert.Equal(t, timeToDeployedCount+1, getTimeToDeployedCount(t, "KogitoSupportingService"))
+}
+
+func TestReconciliation_ExternalURIFromIngress(t *testing.T) {
+	instance := test.CreateFakeDataIndex(t.Name())
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.Name,
+			Namespace: instance.Namespace,
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{}},
+		},
+		Status: networkingv1.IngressStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+			},
+		},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, ingress).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	statusHandler := NewStatusHandler(context)
+	var err error = nil
+	statusHandler.HandleStatusUpdate(instance, &err)
+
+	_, err = kubernetes.ResourceC(cli).Fetch(instance)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://10.0.0.1", instance.Status.ExternalURI)
+}
+
+func TestReconciliation_ExternalURIClearedWithoutIngress(t *testing.T) {
+	instance := test.CreateFakeDataIndex(t.Name())
+	instance.Status.ExternalURI = "http://10.0.0.1"
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	statusHandler := NewStatusHandler(context)
+	var err error = nil
+	statusHandler.HandleStatusUpdate(instance, &err)
+
+	_, err = kubernetes.ResourceC(cli).Fetch(instance)
+	assert.NoError(t, err)
+	assert.Empty(t, instance.Status.ExternalURI)
 }
 
 func getSpecificCondition(conditions []metav1.Condition, conditionType api.KogitoServiceConditionType) *metav1.Condition {
 	return meta2.FindStatusCondition(conditions, string(conditionType))
 }
+
+func TestReconciliation_KnativeServiceReady(t *testing.T) {
+	instance := test.CreateFakeKogitoRuntime(t.Name())
+	instance.Spec.DeploymentMode = api.KnativeServiceDeploymentMode
+	knativeService := &servingv1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace},
+		Spec: servingv1.ServiceSpec{
+			Template: servingv1.RevisionTemplateSpec{
+				Spec: servingv1.RevisionSpec{
+					PodSpec: corev1.PodSpec{Containers: []corev1.Container{{Image: "quay.io/kiegroup/test-image:1.0"}}},
+				},
+			},
+		},
+		Status: servingv1.ServiceStatus{
+			Status: duckv1.Status{Conditions: duckv1.Conditions{{Type: knativeapis.ConditionReady, Status: corev1.ConditionTrue}}},
+			URL:    &knativeapis.URL{Scheme: "http", Host: "example.test.example.com"},
+		},
+	}
+	cli := test.NewFakeClientBuilder().SupportKnativeServing().AddK8sObjects(instance, knativeService).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	statusHandler := NewStatusHandler(context)
+	var err error
+	statusHandler.HandleStatusUpdate(instance, &err)
+
+	_, err = kubernetes.ResourceC(cli).Fetch(instance)
+	assert.NoError(t, err)
+	deployedCondition := getSpecificCondition(*instance.Status.Conditions, api.DeployedConditionType)
+	assert.NotNil(t, deployedCondition)
+	assert.Equal(t, metav1.ConditionTrue, deployedCondition.Status)
+	assert.Equal(t, "quay.io/kiegroup/test-image:1.0", instance.Status.Image)
+	assert.Equal(t, "http://example.test.example.com", instance.Status.ExternalURI)
+}
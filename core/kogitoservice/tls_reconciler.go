@@ -0,0 +1,192 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+	"strconv"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	certmanagerv1 "github.com/kiegroup/kogito-operator/core/infrastructure/certmanager/v1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	tlsMountPath = operator.KogitoHomeDir + "/certs/tls"
+
+	quarkusHTTPSPortEnvVar          = "QUARKUS_HTTP_SSL_PORT"
+	quarkusCertificateFileEnvVar    = "QUARKUS_HTTP_SSL_CERTIFICATE_FILE"
+	quarkusCertificateKeyFileEnvVar = "QUARKUS_HTTP_SSL_CERTIFICATE_KEY_FILE"
+	quarkusInsecureRequestsEnvVar   = "QUARKUS_HTTP_INSECURE_REQUESTS"
+	quarkusInsecureRequestsDisabled = "disabled"
+
+	springBootPortEnvVar           = "SERVER_PORT"
+	springBootCertificateEnvVar    = "SERVER_SSL_CERTIFICATE"
+	springBootCertificateKeyEnvVar = "SERVER_SSL_CERTIFICATEPRIVATEKEY"
+	springBootResourceFilePrefix   = "file:"
+)
+
+// TLSReconciler requests the serving certificate of a Kogito service and configures the service to serve HTTPS with it
+type TLSReconciler interface {
+	Reconcile() error
+}
+
+type tlsReconciler struct {
+	operator.Context
+	instance          api.KogitoService
+	serviceDefinition *ServiceDefinition
+	tlsHandler        infrastructure.TLSHandler
+	secretHandler     infrastructure.SecretHandler
+	deltaProcessor    infrastructure.DeltaProcessor
+}
+
+func newTLSReconciler(context operator.Context, instance api.KogitoService, serviceDefinition *ServiceDefinition) TLSReconciler {
+	context.Log = context.Log.WithValues("resource", "TLS")
+	return &tlsReconciler{
+		Context:           context,
+		instance:          instance,
+		serviceDefinition: serviceDefinition,
+		tlsHandler:        infrastructure.NewTLSHandler(context),
+		secretHandler:     infrastructure.NewSecretHandler(context),
+		deltaProcessor:    infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+func (t *tlsReconciler) Reconcile() error {
+	if !infrastructure.IsTLSEnabled(t.instance) {
+		return nil
+	}
+	if err := t.validateProvider(); err != nil {
+		return err
+	}
+
+	// Create Required resource
+	requestedResources, err := t.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := t.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	if _, err = t.deltaProcessor.ProcessDelta(t.tlsHandler.GetComparator(), requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	// the serving certificate is mounted in the pods, the Deployment can't be created before it's issued
+	caCert, err := t.tlsHandler.FetchCACertificate(t.instance)
+	if err != nil {
+		return err
+	}
+	servingSecret, err := t.secretHandler.FetchSecret(types.NamespacedName{Name: infrastructure.GetTLSSecretName(t.instance), Namespace: t.instance.GetNamespace()})
+	if err != nil {
+		return err
+	}
+	if len(caCert) == 0 || servingSecret == nil {
+		return infrastructure.ErrorForCertificateNotReady(t.instance.GetName())
+	}
+
+	// the CA of the serving certificates is added to the truststore by the TrustStoreReconciler
+	t.mountServingCertificate()
+	return nil
+}
+
+func (t *tlsReconciler) validateProvider() error {
+	provider := t.instance.GetSpec().GetTLS().GetProvider()
+	if provider == api.OpenShiftCertificateProvider && !t.Client.IsOpenshift() ||
+		provider == api.CertManagerCertificateProvider && !t.tlsHandler.IsCertManagerAvailable() {
+		return infrastructure.ErrorForCertificateProviderNotAvailable(t.instance.GetName(), provider)
+	}
+	return nil
+}
+
+func (t *tlsReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if t.instance.GetSpec().GetTLS().GetProvider() == api.CertManagerCertificateProvider {
+		certificate := t.tlsHandler.CreateCertificate(t.instance)
+		if err := framework.SetOwner(t.instance, t.Scheme, certificate); err != nil {
+			return nil, err
+		}
+		resources[reflect.TypeOf(certmanagerv1.Certificate{})] = []client.Object{certificate}
+		return resources, nil
+	}
+	// the OpenShift service CA stores the serving certificate requested through the annotation of the Service, only its CA is requested here
+	configMap := t.tlsHandler.CreateServiceCAConfigMap(t.instance)
+	if err := framework.SetOwner(t.instance, t.Scheme, configMap); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(v1.ConfigMap{})] = []client.Object{configMap}
+	return resources, nil
+}
+
+func (t *tlsReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	key := types.NamespacedName{Name: t.instance.GetName(), Namespace: t.instance.GetNamespace()}
+	if t.instance.GetSpec().GetTLS().GetProvider() == api.CertManagerCertificateProvider {
+		certificate, err := t.tlsHandler.FetchCertificate(key)
+		if err != nil {
+			return nil, err
+		}
+		if certificate != nil {
+			resources[reflect.TypeOf(certmanagerv1.Certificate{})] = []client.Object{certificate}
+		}
+		return resources, nil
+	}
+	key.Name = infrastructure.GetServiceCAConfigMapName(t.instance)
+	configMap, err := infrastructure.NewConfigMapHandler(t.Context).FetchConfigMap(key)
+	if err != nil {
+		return nil, err
+	}
+	if configMap != nil {
+		resources[reflect.TypeOf(v1.ConfigMap{})] = []client.Object{configMap}
+	}
+	return resources, nil
+}
+
+// mountServingCertificate mounts the serving certificate in the pods and sets the runtime properties serving HTTPS with it
+func (t *tlsReconciler) mountServingCertificate() {
+	t.serviceDefinition.SecretVolumeReferences = append(t.serviceDefinition.SecretVolumeReferences, &VolumeReference{
+		Name:      infrastructure.GetTLSSecretName(t.instance),
+		MountPath: tlsMountPath,
+		FileMode:  &framework.ModeForCertificates,
+	})
+	certFile := tlsMountPath + "/" + v1.TLSCertKey
+	keyFile := tlsMountPath + "/" + v1.TLSPrivateKeyKey
+	var envs []v1.EnvVar
+	if t.instance.GetSpec().GetRuntime() == api.SpringBootRuntimeType {
+		envs = []v1.EnvVar{
+			framework.CreateEnvVar(springBootPortEnvVar, strconv.Itoa(framework.DefaultExposedTLSPort)),
+			framework.CreateEnvVar(springBootCertificateEnvVar, springBootResourceFilePrefix+certFile),
+			framework.CreateEnvVar(springBootCertificateKeyEnvVar, springBootResourceFilePrefix+keyFile),
+		}
+	} else {
+		envs = []v1.EnvVar{
+			framework.CreateEnvVar(quarkusHTTPSPortEnvVar, strconv.Itoa(framework.DefaultExposedTLSPort)),
+			framework.CreateEnvVar(quarkusCertificateFileEnvVar, certFile),
+			framework.CreateEnvVar(quarkusCertificateKeyFileEnvVar, keyFile),
+			framework.CreateEnvVar(quarkusInsecureRequestsEnvVar, quarkusInsecureRequestsDisabled),
+		}
+	}
+	t.serviceDefinition.Envs = framework.EnvOverride(t.serviceDefinition.Envs, envs...)
+}
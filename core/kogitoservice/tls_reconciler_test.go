@@ -0,0 +1,126 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"io/ioutil"
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	certmanagerv1 "github.com/kiegroup/kogito-operator/core/infrastructure/certmanager/v1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newFakeServingCertSecret(t *testing.T, instance api.KogitoService, withCA bool) *v1.Secret {
+	crtFile, err := ioutil.ReadFile("./testdata/tls.crt")
+	assert.NoError(t, err)
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: infrastructure.GetTLSSecretName(instance), Namespace: instance.GetNamespace()},
+		Data:       map[string][]byte{v1.TLSCertKey: crtFile, v1.TLSPrivateKeyKey: []byte("key")},
+	}
+	if withCA {
+		secret.Data[infrastructure.TLSCACertKey] = crtFile
+	}
+	return secret
+}
+
+func TestTLSReconciler_OpenShiftServiceCA(t *testing.T) {
+	instance := test.CreateFakeKogitoRuntime(t.Name())
+	instance.Spec.TLS = &v1beta1.TLS{}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).OnOpenShift().Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+	serviceDefinition := ServiceDefinition{}
+
+	// the CA is not yet injected by the OpenShift service CA
+	err := newTLSReconciler(context, instance, &serviceDefinition).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, infrastructure.CertificateNotReadyReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+	configMap := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: infrastructure.GetServiceCAConfigMapName(instance), Namespace: t.Name()}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "true", configMap.Annotations[infrastructure.InjectCABundleAnnotation])
+
+	servingSecret := newFakeServingCertSecret(t, instance, false)
+	configMap.Data = map[string]string{infrastructure.ServiceCABundleKey: string(servingSecret.Data[v1.TLSCertKey])}
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(configMap))
+	assert.NoError(t, kubernetes.ResourceC(cli).Create(servingSecret))
+
+	err = newTLSReconciler(context, instance, &serviceDefinition).Reconcile()
+	assert.NoError(t, err)
+	assert.Len(t, serviceDefinition.SecretVolumeReferences, 1)
+	assert.Equal(t, tlsMountPath, serviceDefinition.SecretVolumeReferences[0].GetMountPath())
+	assert.Contains(t, serviceDefinition.Envs, framework.CreateEnvVar(quarkusHTTPSPortEnvVar, "8443"))
+	assert.Contains(t, serviceDefinition.Envs, framework.CreateEnvVar(quarkusInsecureRequestsEnvVar, quarkusInsecureRequestsDisabled))
+
+	// the injected CA is kept
+	exists, err = kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NotEmpty(t, configMap.Data[infrastructure.ServiceCABundleKey])
+
+	// the truststore holding the CA is built by the TrustStoreReconciler
+	assert.NoError(t, newTrustStoreReconciler(context, instance, &serviceDefinition).Reconcile())
+	trustStoreSecret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: getManagedTrustStoreSecretName(instance), Namespace: t.Name()}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(trustStoreSecret)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NotEmpty(t, trustStoreSecret.Data[trustStoreSecretFileKey])
+	assert.Len(t, serviceDefinition.SecretVolumeReferences, 2)
+	assert.Equal(t, trustStoreSecret.Name, serviceDefinition.SecretVolumeReferences[1].GetName())
+}
+
+func TestTLSReconciler_CertManager(t *testing.T) {
+	instance := test.CreateFakeKogitoRuntime(t.Name())
+	instance.Spec.Runtime = api.SpringBootRuntimeType
+	instance.Spec.TLS = &v1beta1.TLS{IssuerName: "kogito-ca"}
+	servingSecret := newFakeServingCertSecret(t, instance, true)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, servingSecret).SupportCertManager().Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+	serviceDefinition := ServiceDefinition{}
+
+	err := newTLSReconciler(context, instance, &serviceDefinition).Reconcile()
+	assert.NoError(t, err)
+	certificate := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: t.Name()}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(certificate)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, servingSecret.Name, certificate.Spec.SecretName)
+	assert.Equal(t, "kogito-ca", certificate.Spec.IssuerRef.Name)
+	assert.Equal(t, "Issuer", certificate.Spec.IssuerRef.Kind)
+	assert.Contains(t, certificate.Spec.DNSNames, instance.Name+"."+t.Name()+".svc")
+	assert.Contains(t, serviceDefinition.Envs, framework.CreateEnvVar(springBootPortEnvVar, "8443"))
+	assert.Contains(t, serviceDefinition.Envs, framework.CreateEnvVar(springBootCertificateEnvVar, "file:"+tlsMountPath+"/tls.crt"))
+}
+
+func TestTLSReconciler_CertManagerNotAvailable(t *testing.T) {
+	instance := test.CreateFakeKogitoRuntime(t.Name())
+	instance.Spec.TLS = &v1beta1.TLS{IssuerName: "kogito-ca"}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+
+	err := newTLSReconciler(context, instance, &ServiceDefinition{}).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, infrastructure.CertificateProviderNotAvailableReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+}
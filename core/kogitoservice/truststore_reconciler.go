@@ -15,12 +15,20 @@
 package kogitoservice
 
 import (
+	"bytes"
+
 	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	certmanagerv1 "github.com/kiegroup/kogito-operator/core/infrastructure/certmanager/v1"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"software.sslmate.com/src/go-pkcs12"
 )
 
 const (
@@ -29,6 +37,11 @@ const (
 	trustStoreSecretFileKey      = "cacerts"
 	trustStoreEnvVarPassword     = "CUSTOM_TRUSTSTORE_PASSWORD"
 	trustStoreEnvVarCertFileName = "CUSTOM_TRUSTSTORE"
+
+	// managedTrustStoreSecretSuffix is the suffix of the truststore Secret built by the operator from the trusted CA certificates
+	managedTrustStoreSecretSuffix = "-truststore"
+	// trustStoreHashAnnotation holds the hash of the CA certificates in the truststore built by the operator, the pods are rolled out when it changes
+	trustStoreHashAnnotation = "kogito.kie.org/truststore-hash"
 )
 
 // TrustStoreReconciler takes care of mounting the custom TrustStoreSecret in a given Deployment based on api.KogitoService spec
@@ -41,6 +54,8 @@ type trustStoreReconciler struct {
 	instance          api.KogitoService
 	serviceDefinition *ServiceDefinition
 	secretHandler     infrastructure.SecretHandler
+	configMapHandler  infrastructure.ConfigMapHandler
+	tlsHandler        infrastructure.TLSHandler
 }
 
 func newTrustStoreReconciler(context operator.Context, instance api.KogitoService, serviceDefinition *ServiceDefinition) TrustStoreReconciler {
@@ -50,18 +65,31 @@ func newTrustStoreReconciler(context operator.Context, instance api.KogitoServic
 		instance:          instance,
 		serviceDefinition: serviceDefinition,
 		secretHandler:     infrastructure.NewSecretHandler(context),
+		configMapHandler:  infrastructure.NewConfigMapHandler(context),
+		tlsHandler:        infrastructure.NewTLSHandler(context),
 	}
 }
 
-// MountTrustStore mounts the given custom TrustStoreSecret based on api.KogitoService
+// MountTrustStore mounts the given custom TrustStoreSecret based on api.KogitoService,
+// or the truststore built from the TrustStoreSources and the CA of the serving certificates when the service serves HTTPS
 func (t *trustStoreReconciler) Reconcile() error {
-	if len(t.instance.GetSpec().GetTrustStoreSecret()) == 0 {
-		return nil
-	}
-
-	secret, err := t.fetchAndValidateTrustStoreSecret()
-	if err != nil {
-		return err
+	var secret *v1.Secret
+	var err error
+	if len(t.instance.GetSpec().GetTrustStoreSecret()) > 0 {
+		if secret, err = t.fetchAndValidateTrustStoreSecret(); err != nil {
+			return err
+		}
+	} else {
+		caCerts, err := t.fetchTrustedCACertificates()
+		if err != nil {
+			return err
+		} else if len(caCerts) == 0 {
+			return nil
+		}
+		if secret, err = t.reconcileManagedTrustStoreSecret(caCerts); err != nil {
+			return err
+		}
+		t.setTrustStoreHash(caCerts)
 	}
 
 	t.mapTrustStorePassword(secret)
@@ -73,15 +101,114 @@ func (t *trustStoreReconciler) Reconcile() error {
 }
 
 func (t *trustStoreReconciler) fetchAndValidateTrustStoreSecret() (*v1.Secret, error) {
-	secret, err := t.secretHandler.FetchSecret(types.NamespacedName{Name: t.instance.GetSpec().GetTrustStoreSecret(), Namespace: t.instance.GetNamespace()})
+	secretName := t.instance.GetSpec().GetTrustStoreSecret()
+	secret, err := t.secretHandler.FetchSecret(types.NamespacedName{Name: secretName, Namespace: t.instance.GetNamespace()})
 	if err != nil {
 		return nil, err
 	} else if secret == nil {
-		return nil, infrastructure.ErrorForTrustStoreMount("Failed to find Trust store secret named " + t.instance.GetSpec().GetTrustStoreSecret() + " in the namespace " + t.instance.GetNamespace())
+		return nil, infrastructure.ErrorForTrustStoreMount("Failed to find Trust store secret named " + secretName + " in the namespace " + t.instance.GetNamespace())
 	}
 	return secret, nil
 }
 
+// fetchTrustedCACertificates fetches the PEM encoded CA certificates of the TrustStoreSources and, when the service serves HTTPS, the CA of its serving certificates
+func (t *trustStoreReconciler) fetchTrustedCACertificates() ([]byte, error) {
+	var caCerts []byte
+	if infrastructure.IsTLSEnabled(t.instance) {
+		caCert, err := t.tlsHandler.FetchCACertificate(t.instance)
+		if err != nil {
+			return nil, err
+		}
+		caCerts = appendCACertificates(caCerts, caCert)
+	}
+	for _, source := range t.instance.GetSpec().GetTrustStoreSources() {
+		caCert, err := t.fetchTrustStoreSource(source)
+		if err != nil {
+			return nil, err
+		} else if len(caCert) == 0 {
+			return nil, infrastructure.ErrorForTrustStoreMount("Failed to find the CA certificates with key " + source.GetKey() + " in the " + string(source.GetKind()) + " named " + source.GetName() + " in the namespace " + t.instance.GetNamespace())
+		}
+		caCerts = appendCACertificates(caCerts, caCert)
+	}
+	return caCerts, nil
+}
+
+func (t *trustStoreReconciler) fetchTrustStoreSource(source api.TrustStoreSourceInterface) ([]byte, error) {
+	key := types.NamespacedName{Name: source.GetName(), Namespace: t.instance.GetNamespace()}
+	switch source.GetKind() {
+	case api.ConfigMapTrustStoreSource:
+		configMap, err := t.configMapHandler.FetchConfigMap(key)
+		if err != nil || configMap == nil {
+			return nil, err
+		}
+		return []byte(configMap.Data[source.GetKey()]), nil
+	case api.CertificateTrustStoreSource:
+		if !t.tlsHandler.IsCertManagerAvailable() {
+			return nil, infrastructure.ErrorForTrustStoreMount("Failed to read the Certificate named " + source.GetName() + ", cert-manager is not available in the cluster")
+		}
+		certificate, err := t.tlsHandler.FetchCertificate(key)
+		if err != nil || certificate == nil {
+			return nil, err
+		}
+		key.Name = certificate.Spec.SecretName
+	}
+	secret, err := t.secretHandler.FetchSecret(key)
+	if err != nil || secret == nil {
+		return nil, err
+	}
+	return secret.Data[source.GetKey()], nil
+}
+
+// reconcileManagedTrustStoreSecret creates or updates the truststore Secret built from the given CA certificates.
+// The PKCS12 encoding is salted, the truststore is only generated again when the CA certificates change.
+func (t *trustStoreReconciler) reconcileManagedTrustStoreSecret(caCerts []byte) (*v1.Secret, error) {
+	trustStoreSecretName := getManagedTrustStoreSecretName(t.instance)
+	deployedSecret, err := t.secretHandler.FetchSecret(types.NamespacedName{Name: trustStoreSecretName, Namespace: t.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if deployedSecret != nil && bytes.Equal(deployedSecret.Data[infrastructure.TLSCACertKey], caCerts) {
+		return deployedSecret, nil
+	}
+	caSecret := &v1.Secret{Data: map[string][]byte{infrastructure.TLSCACertKey: caCerts}}
+	trustStore, err := framework.CreatePKCS12TrustStoreFromSecret(caSecret, pkcs12.DefaultPassword, infrastructure.TLSCACertKey)
+	if err != nil {
+		return nil, err
+	}
+	trustStoreData := map[string][]byte{
+		trustStoreSecretFileKey:     trustStore,
+		trustStoreSecretPasswordKey: []byte(pkcs12.DefaultPassword),
+		infrastructure.TLSCACertKey: caCerts,
+	}
+	if deployedSecret != nil {
+		t.Log.Info("CA certificates changed, updating truststore", "secret", trustStoreSecretName)
+		deployedSecret.Data = trustStoreData
+		return deployedSecret, kubernetes.ResourceC(t.Client).Update(deployedSecret)
+	}
+	trustStoreSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      trustStoreSecretName,
+			Namespace: t.instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: t.instance.GetName()},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: trustStoreData,
+	}
+	if err := framework.SetOwner(t.instance, t.Scheme, trustStoreSecret); err != nil {
+		return nil, err
+	}
+	return trustStoreSecret, kubernetes.ResourceC(t.Client).Create(trustStoreSecret)
+}
+
+// setTrustStoreHash annotates the pods with the hash of the trusted CA certificates.
+// The truststore is mounted as a file, the pods must be rolled out to read a rotated truststore.
+func (t *trustStoreReconciler) setTrustStoreHash(caCerts []byte) {
+	if t.serviceDefinition.PodAnnotations == nil {
+		t.serviceDefinition.PodAnnotations = map[string]string{}
+	}
+	t.serviceDefinition.PodAnnotations[trustStoreHashAnnotation] = util.GenerateMD5Hash(map[string]string{infrastructure.TLSCACertKey: string(caCerts)})
+}
+
 func (t *trustStoreReconciler) mountTrustStoreFile(secret *v1.Secret) error {
 	if _, ok := secret.Data[trustStoreSecretFileKey]; !ok {
 		return infrastructure.ErrorForTrustStoreMount("Failed to mount Truststore. Secret " + secret.Name + " doesn't have the file with key " + trustStoreSecretFileKey)
@@ -108,3 +235,34 @@ func (t *trustStoreReconciler) mapTrustStorePassword(secret *v1.Secret) {
 	t.serviceDefinition.Envs = framework.EnvOverride(t.serviceDefinition.Envs,
 		framework.CreateSecretEnvVar(trustStoreEnvVarPassword, secret.Name, trustStoreSecretPasswordKey))
 }
+
+// appendCACertificates appends the given PEM encoded certificates to the bundle, each source starting on a new line
+func appendCACertificates(caCerts []byte, caCert []byte) []byte {
+	return append(caCerts, append(bytes.TrimSpace(caCert), '\n')...)
+}
+
+// getManagedTrustStoreSecretName gets the name of the truststore Secret built by the operator for the given service
+func getManagedTrustStoreSecretName(instance api.KogitoService) string {
+	return instance.GetName() + managedTrustStoreSecretSuffix
+}
+
+// IsTrustStoreSource tells whether the given ConfigMap or Secret holds CA certificates trusted by the given service through its TrustStoreSources
+func IsTrustStoreSource(instance api.KogitoService, object client.Object) bool {
+	if object.GetNamespace() != instance.GetNamespace() {
+		return false
+	}
+	for _, source := range instance.GetSpec().GetTrustStoreSources() {
+		switch object.(type) {
+		case *v1.ConfigMap:
+			if source.GetKind() == api.ConfigMapTrustStoreSource && source.GetName() == object.GetName() {
+				return true
+			}
+		case *v1.Secret:
+			if source.GetKind() == api.SecretTrustStoreSource && source.GetName() == object.GetName() ||
+				source.GetKind() == api.CertificateTrustStoreSource && source.GetName() == object.GetAnnotations()[certmanagerv1.CertificateNameAnnotation] {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -15,7 +15,15 @@
 package kogitoservice
 
 import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	certmanagerv1 "github.com/kiegroup/kogito-operator/core/infrastructure/certmanager/v1"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/test"
 	"github.com/kiegroup/kogito-operator/meta"
@@ -23,7 +31,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	v12 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"testing"
 )
 
 func TestTrustStoreReconciler(t *testing.T) {
@@ -71,3 +78,82 @@ func TestTrustStoreReconcilerTrustStore_MissingCM(t *testing.T) {
 	errorHandler := infrastructure.NewReconciliationErrorHandler(context)
 	assert.Equal(t, infrastructure.TrustStoreMountFailureReason, errorHandler.GetReasonForError(err))
 }
+
+func TestTrustStoreReconciler_TrustStoreSources(t *testing.T) {
+	caCert, err := ioutil.ReadFile("./testdata/tls.crt")
+	assert.NoError(t, err)
+	instance := test.CreateFakeKogitoRuntime(t.Name())
+	instance.Spec.TrustStoreSources = []v1beta1.TrustStoreSource{
+		{Kind: api.ConfigMapTrustStoreSource, Name: "trusted-ca"},
+		{Kind: api.CertificateTrustStoreSource, Name: "kafka-client"},
+	}
+	configMap := &v12.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Name: "trusted-ca", Namespace: t.Name()},
+		Data:       map[string]string{"ca-bundle.crt": string(caCert)},
+	}
+	certificate := &certmanagerv1.Certificate{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka-client", Namespace: t.Name()},
+		Spec:       certmanagerv1.CertificateSpec{SecretName: "kafka-client-tls"},
+	}
+	certificateSecret := &v12.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka-client-tls", Namespace: t.Name()},
+		Data:       map[string][]byte{"ca.crt": caCert},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, configMap, certificate, certificateSecret).SupportCertManager().Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+	serviceDefinition := ServiceDefinition{}
+
+	assert.NoError(t, newTrustStoreReconciler(context, instance, &serviceDefinition).Reconcile())
+	trustStoreSecret := &v12.Secret{ObjectMeta: v1.ObjectMeta{Name: getManagedTrustStoreSecretName(instance), Namespace: t.Name()}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(trustStoreSecret)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NotEmpty(t, trustStoreSecret.Data[trustStoreSecretFileKey])
+	assert.Equal(t, 2*countCertificates(caCert), countCertificates(trustStoreSecret.Data[infrastructure.TLSCACertKey]))
+	assert.Equal(t, 2, len(serviceDefinition.Envs))
+	assert.Equal(t, 1, len(serviceDefinition.SecretVolumeReferences))
+	hash := serviceDefinition.PodAnnotations[trustStoreHashAnnotation]
+	assert.NotEmpty(t, hash)
+
+	// the truststore is rotated with the CA certificates and the pods are rolled out
+	configMap.Data["ca-bundle.crt"] = string(caCert) + string(caCert)
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(configMap))
+	serviceDefinition = ServiceDefinition{}
+	assert.NoError(t, newTrustStoreReconciler(context, instance, &serviceDefinition).Reconcile())
+	exists, err = kubernetes.ResourceC(cli).Fetch(trustStoreSecret)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, 3*countCertificates(caCert), countCertificates(trustStoreSecret.Data[infrastructure.TLSCACertKey]))
+	assert.NotEqual(t, hash, serviceDefinition.PodAnnotations[trustStoreHashAnnotation])
+}
+
+func TestTrustStoreReconciler_MissingTrustStoreSource(t *testing.T) {
+	instance := test.CreateFakeKogitoRuntime(t.Name())
+	instance.Spec.TrustStoreSources = []v1beta1.TrustStoreSource{{Kind: api.SecretTrustStoreSource, Name: "missing"}}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+
+	err := newTrustStoreReconciler(context, instance, &ServiceDefinition{}).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, infrastructure.TrustStoreMountFailureReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+}
+
+func TestIsTrustStoreSource(t *testing.T) {
+	instance := test.CreateFakeKogitoRuntime(t.Name())
+	instance.Spec.TrustStoreSources = []v1beta1.TrustStoreSource{
+		{Kind: api.ConfigMapTrustStoreSource, Name: "trusted-ca"},
+		{Kind: api.CertificateTrustStoreSource, Name: "kafka-client"},
+	}
+	assert.True(t, IsTrustStoreSource(instance, &v12.ConfigMap{ObjectMeta: v1.ObjectMeta{Name: "trusted-ca", Namespace: t.Name()}}))
+	assert.False(t, IsTrustStoreSource(instance, &v12.Secret{ObjectMeta: v1.ObjectMeta{Name: "trusted-ca", Namespace: t.Name()}}))
+	assert.False(t, IsTrustStoreSource(instance, &v12.ConfigMap{ObjectMeta: v1.ObjectMeta{Name: "trusted-ca", Namespace: "other"}}))
+	assert.True(t, IsTrustStoreSource(instance, &v12.Secret{ObjectMeta: v1.ObjectMeta{
+		Name:        "kafka-client-tls",
+		Namespace:   t.Name(),
+		Annotations: map[string]string{certmanagerv1.CertificateNameAnnotation: "kafka-client"},
+	}}))
+}
+
+func countCertificates(caCerts []byte) int {
+	return strings.Count(string(caCerts), "-----BEGIN CERTIFICATE-----")
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VirtualServiceReconciler ...
+type VirtualServiceReconciler interface {
+	Reconcile() error
+}
+
+type virtualServiceReconciler struct {
+	operator.Context
+	instance              api.KogitoService
+	virtualServiceHandler infrastructure.VirtualServiceHandler
+	rolloutHandler        RolloutHandler
+	deltaProcessor        infrastructure.DeltaProcessor
+}
+
+func newVirtualServiceReconciler(context operator.Context, instance api.KogitoService) VirtualServiceReconciler {
+	return &virtualServiceReconciler{
+		Context:               context,
+		instance:              instance,
+		virtualServiceHandler: infrastructure.NewVirtualServiceHandler(context),
+		rolloutHandler:        NewRolloutHandler(context),
+		deltaProcessor:        infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+// Reconcile routes the traffic of the mesh addressed to the service, splitting it between the current and the candidate Deployment
+// while a new image is rolled out.
+func (v *virtualServiceReconciler) Reconcile() error {
+	if !isIstioInstalled(v.Context) {
+		v.Log.Debug("Skipping VirtualService creation. Istio is not installed in the cluster.")
+		return nil
+	}
+
+	// Create Required resource
+	requestedResources, err := v.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := v.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	if err = v.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *virtualServiceReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if !v.instance.GetSpec().IsEnableIstio() {
+		return resources, nil
+	}
+	if isKnativeServiceMode(v.instance) {
+		v.Log.Debug("Skipping VirtualService creation. Knative routes the traffic of the service.")
+		return resources, nil
+	}
+	rolloutStatus, err := v.rolloutHandler.FetchRolloutStatus(v.instance)
+	if err != nil {
+		return nil, err
+	}
+	destinations := []istiov1beta1.HTTPRouteDestination{
+		{
+			Destination: istiov1beta1.Destination{Host: v.instance.GetName()},
+			Weight:      fullTrafficWeight - rolloutStatus.CandidateWeight,
+		},
+	}
+	if rolloutStatus.IsInProgress() {
+		destinations = append(destinations, istiov1beta1.HTTPRouteDestination{
+			Destination: istiov1beta1.Destination{Host: getCandidateName(v.instance)},
+			Weight:      rolloutStatus.CandidateWeight,
+		})
+	}
+	virtualService := v.virtualServiceHandler.CreateVirtualService(v.instance, destinations)
+	if err := framework.SetOwner(v.instance, v.Scheme, virtualService); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(istiov1beta1.VirtualService{})] = []client.Object{virtualService}
+	return resources, nil
+}
+
+func (v *virtualServiceReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	virtualService, err := v.virtualServiceHandler.FetchVirtualService(types.NamespacedName{Name: v.instance.GetName(), Namespace: v.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if virtualService != nil {
+		resources[reflect.TypeOf(istiov1beta1.VirtualService{})] = []client.Object{virtualService}
+	}
+	return resources, nil
+}
+
+func (v *virtualServiceReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	excludeNotControlledResources(v.Context, v.instance, requestedResources, deployedResources)
+	comparator := v.virtualServiceHandler.GetComparator()
+	_, err = v.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
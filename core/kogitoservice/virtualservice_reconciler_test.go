@@ -0,0 +1,103 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	istiov1beta1 "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVirtualServiceReconciler(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.EnableIstio = true
+	instance.Spec.Istio = &v1beta1.Istio{Gateway: &v1beta1.IstioGateway{Hosts: []string{"process.example.com"}}}
+	cli := test.NewFakeClientBuilder().SupportIstio().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newVirtualServiceReconciler(context, instance).Reconcile())
+
+	virtualService := &istiov1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(virtualService)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, []string{instance.Name, "process.example.com"}, virtualService.Spec.Hosts)
+	assert.Equal(t, []string{"mesh", instance.Name}, virtualService.Spec.Gateways)
+	assert.Len(t, virtualService.Spec.HTTP, 1)
+	assert.Len(t, virtualService.Spec.HTTP[0].Route, 1)
+	assert.Equal(t, instance.Name, virtualService.Spec.HTTP[0].Route[0].Destination.Host)
+	assert.Equal(t, int32(100), virtualService.Spec.HTTP[0].Route[0].Weight)
+}
+
+func TestVirtualServiceReconciler_Canary(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.EnableIstio = true
+	instance.Spec.Rollout = &v1beta1.Rollout{Type: api.CanaryRolloutType, CanaryWeight: 30}
+	candidate := createFakeRolloutDeployment(instance.Name+candidateSuffix, ns, "quay.io/kiegroup/test-image:2.0")
+	candidate.Status.AvailableReplicas = 1
+	cli := test.NewFakeClientBuilder().SupportIstio().AddK8sObjects(instance, candidate).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newVirtualServiceReconciler(context, instance).Reconcile())
+
+	virtualService := &istiov1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(virtualService)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Nil(t, virtualService.Spec.Gateways)
+	assert.Len(t, virtualService.Spec.HTTP[0].Route, 2)
+	assert.Equal(t, int32(70), virtualService.Spec.HTTP[0].Route[0].Weight)
+	assert.Equal(t, candidate.Name, virtualService.Spec.HTTP[0].Route[1].Destination.Host)
+	assert.Equal(t, int32(30), virtualService.Spec.HTTP[0].Route[1].Weight)
+}
+
+func TestVirtualServiceReconciler_KnativeServiceMode(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.EnableIstio = true
+	instance.Spec.DeploymentMode = api.KnativeServiceDeploymentMode
+	cli := test.NewFakeClientBuilder().SupportIstio().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	assert.NoError(t, newVirtualServiceReconciler(context, instance).Reconcile())
+	assert.NoError(t, newDestinationRuleReconciler(context, instance).Reconcile())
+
+	// Knative routes the traffic of the service by itself
+	exists, err := kubernetes.ResourceC(cli).Fetch(&istiov1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = kubernetes.ResourceC(cli).Fetch(&istiov1beta1.DestinationRule{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetOperatorVolumeNames gets the names of the volumes mounted by the operator in the pods of the given service:
+// the properties ConfigMap, the truststore and the serving certificate
+func GetOperatorVolumeNames(instance api.KogitoService) []string {
+	names := []string{infrastructure.GetTLSSecretName(instance)}
+	if configMap := instance.GetSpec().GetPropertiesConfigMap(); len(configMap) > 0 {
+		names = append(names, configMap)
+	}
+	if trustStoreSecret := instance.GetSpec().GetTrustStoreSecret(); len(trustStoreSecret) > 0 {
+		return append(names, trustStoreSecret)
+	}
+	return append(names, getManagedTrustStoreSecretName(instance))
+}
+
+// VolumeReconciler creates the PersistentVolumeClaims requested by the claim templates of the volumes of a Kogito service
+type VolumeReconciler interface {
+	Reconcile() error
+}
+
+type volumeReconciler struct {
+	operator.Context
+	instance       api.KogitoService
+	volumeHandler  infrastructure.VolumeHandler
+	deltaProcessor infrastructure.DeltaProcessor
+}
+
+func newVolumeReconciler(context operator.Context, instance api.KogitoService) VolumeReconciler {
+	context.Log = context.Log.WithValues("resource", "Volume")
+	return &volumeReconciler{
+		Context:        context,
+		instance:       instance,
+		volumeHandler:  infrastructure.NewVolumeHandler(context),
+		deltaProcessor: infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+func (v *volumeReconciler) Reconcile() error {
+	// Create Required resource
+	requestedResources, err := v.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := v.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	_, err = v.deltaProcessor.ProcessDelta(v.volumeHandler.GetComparator(), requestedResources, deployedResources)
+	return err
+}
+
+func (v *volumeReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	for _, volume := range v.getClaimTemplateVolumes() {
+		claim := v.volumeHandler.CreatePersistentVolumeClaim(v.instance, volume)
+		if err := framework.SetOwner(v.instance, v.Scheme, claim); err != nil {
+			return nil, err
+		}
+		resources[reflect.TypeOf(v1.PersistentVolumeClaim{})] = append(resources[reflect.TypeOf(v1.PersistentVolumeClaim{})], claim)
+	}
+	return resources, nil
+}
+
+// getDeployedResources fetches the claims of the current volumes only, the claims of the removed volumes are kept with their data
+func (v *volumeReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	for _, volume := range v.getClaimTemplateVolumes() {
+		claim, err := v.volumeHandler.FetchPersistentVolumeClaim(types.NamespacedName{Name: infrastructure.GetPersistentVolumeClaimName(v.instance, volume), Namespace: v.instance.GetNamespace()})
+		if err != nil {
+			return nil, err
+		}
+		if claim != nil {
+			resources[reflect.TypeOf(v1.PersistentVolumeClaim{})] = append(resources[reflect.TypeOf(v1.PersistentVolumeClaim{})], claim)
+		}
+	}
+	return resources, nil
+}
+
+func (v *volumeReconciler) getClaimTemplateVolumes() []api.VolumeInterface {
+	var volumes []api.VolumeInterface
+	for _, volume := range v.instance.GetSpec().GetVolumes() {
+		if volume.GetClaimTemplate() != nil {
+			volumes = append(volumes, volume)
+		}
+	}
+	return volumes
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVolumeReconciler(t *testing.T) {
+	instance := test.CreateFakeKogitoRuntime(t.Name())
+	storageClass := "fast"
+	instance.Spec.Volumes = []v1beta1.Volume{
+		{Name: "processes", MountPath: "/home/kogito/processes", ClaimTemplate: &v1beta1.VolumeClaimTemplate{Size: resource.MustParse("1Gi"), StorageClassName: &storageClass}},
+		{Name: "models", MountPath: "/home/kogito/models", ClaimName: "dmn-models"},
+		{Name: "cache", MountPath: "/tmp/cache", EmptyDir: &v1.EmptyDirVolumeSource{}},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+
+	assert.NoError(t, newVolumeReconciler(context, instance).Reconcile())
+	claim := &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: instance.Name + "-processes", Namespace: t.Name()}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(claim)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, &storageClass, claim.Spec.StorageClassName)
+	assert.Equal(t, []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}, claim.Spec.AccessModes)
+	assert.True(t, resource.MustParse("1Gi").Equal(claim.Spec.Resources.Requests[v1.ResourceStorage]))
+	// existing claims are not managed by the operator
+	exists, err = kubernetes.ResourceC(cli).Fetch(&v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "dmn-models", Namespace: t.Name()}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	// the claim is expanded
+	instance.Spec.Volumes[0].ClaimTemplate.Size = resource.MustParse("2Gi")
+	assert.NoError(t, newVolumeReconciler(context, instance).Reconcile())
+	exists, err = kubernetes.ResourceC(cli).Fetch(claim)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.True(t, resource.MustParse("2Gi").Equal(claim.Spec.Resources.Requests[v1.ResourceStorage]))
+
+	// but never shrunk
+	instance.Spec.Volumes[0].ClaimTemplate.Size = resource.MustParse("1Gi")
+	assert.NoError(t, newVolumeReconciler(context, instance).Reconcile())
+	exists, err = kubernetes.ResourceC(cli).Fetch(claim)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.True(t, resource.MustParse("2Gi").Equal(claim.Spec.Resources.Requests[v1.ResourceStorage]))
+}
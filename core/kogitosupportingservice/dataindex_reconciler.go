@@ -61,10 +61,16 @@ func (d *dataIndexSupportingServiceResource) Reconcile() (err error) {
 	}
 	protoBufHandler := shared.NewProtoBufHandler(d.Context, d.supportingServiceHandler)
 	definition := kogitoservice.ServiceDefinition{
-		DefaultImageName:   DefaultDataIndexImageName,
-		KafkaTopics:        dataIndexKafkaTopics,
-		Request:            controller1.Request{NamespacedName: types.NamespacedName{Name: d.instance.GetName(), Namespace: d.instance.GetNamespace()}},
-		OnDeploymentCreate: protoBufHandler.MountAllProtoBufConfigMapOnDataIndexDeployment,
+		DefaultImageName: DefaultDataIndexImageName,
+		PersistenceImageNames: map[api.PersistenceBackendType]string{
+			api.InfinispanPersistenceBackend: DataIndexInfinispanImageName,
+			api.MongoDBPersistenceBackend:    DataIndexMongoDBImageName,
+			api.PostgreSQLPersistenceBackend: DataIndexPostgresqlImageName,
+		},
+		KafkaTopics:           dataIndexKafkaTopics,
+		Request:               controller1.Request{NamespacedName: types.NamespacedName{Name: d.instance.GetName(), Namespace: d.instance.GetNamespace()}},
+		OnDeploymentCreate:    protoBufHandler.MountAllProtoBufConfigMapOnDataIndexDeployment,
+		OnNetworkPolicyCreate: d.onNetworkPolicyCreate,
 	}
 	return kogitoservice.NewServiceDeployer(d.Context, definition, d.instance, d.infraHandler).Deploy()
 }
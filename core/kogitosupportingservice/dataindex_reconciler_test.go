@@ -15,11 +15,17 @@
 package kogitosupportingservice
 
 import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/test"
 	"github.com/kiegroup/kogito-operator/internal/app"
 	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	meta2 "k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"testing"
 )
 
@@ -50,3 +56,70 @@ func TestKogitoSupportingServiceDataIndex_Reconcile(t *testing.T) {
 	err := r.Reconcile()
 	assert.NoError(t, err)
 }
+
+func TestKogitoSupportingServiceDataIndex_ReconcileWithPostgreSQL(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQL := test.CreateFakeKogitoPostgreSQL(t.Name())
+	dataIndex := test.CreateFakeDataIndex(ns)
+	dataIndex.GetSpec().AddInfra(kogitoPostgreSQL.GetName())
+	cli := test.NewFakeClientBuilder().AddK8sObjects(dataIndex, kogitoPostgreSQL).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	r := &dataIndexSupportingServiceResource{
+		supportingServiceContext: supportingServiceContext{
+			Context:                  context,
+			instance:                 dataIndex,
+			supportingServiceHandler: app.NewKogitoSupportingServiceHandler(context),
+			infraHandler:             app.NewKogitoInfraHandler(context),
+			runtimeHandler:           app.NewKogitoRuntimeHandler(context),
+		},
+	}
+	err := r.Reconcile()
+	assert.NoError(t, err)
+
+	deployment := &appsv1.Deployment{ObjectMeta: v1.ObjectMeta{Name: dataIndex.GetName(), Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Contains(t, deployment.Spec.Template.Spec.Containers[0].Image, DataIndexPostgresqlImageName)
+	assert.Equal(t, api.PostgreSQLPersistenceBackend, dataIndex.GetSupportingServiceStatus().GetPersistenceBackend())
+}
+
+func TestKogitoSupportingServiceDataIndex_ReconcileWithConflictingPersistence(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQL := test.CreateFakeKogitoPostgreSQL(t.Name())
+	kogitoMongoDB := test.CreateFakeKogitoMongoDB(t.Name())
+	dataIndex := test.CreateFakeDataIndex(ns)
+	dataIndex.GetSpec().AddInfra(kogitoPostgreSQL.GetName())
+	dataIndex.GetSpec().AddInfra(kogitoMongoDB.GetName())
+	cli := test.NewFakeClientBuilder().AddK8sObjects(dataIndex, kogitoPostgreSQL, kogitoMongoDB).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	r := &dataIndexSupportingServiceResource{
+		supportingServiceContext: supportingServiceContext{
+			Context:                  context,
+			instance:                 dataIndex,
+			supportingServiceHandler: app.NewKogitoSupportingServiceHandler(context),
+			infraHandler:             app.NewKogitoInfraHandler(context),
+			runtimeHandler:           app.NewKogitoRuntimeHandler(context),
+		},
+	}
+	err := r.Reconcile()
+	assert.Error(t, err)
+
+	failedCondition := meta2.FindStatusCondition(*dataIndex.GetStatus().GetConditions(), string(api.FailedConditionType))
+	assert.NotNil(t, failedCondition)
+	assert.Equal(t, v1.ConditionTrue, failedCondition.Status)
+	assert.Equal(t, string(infrastructure.PersistenceInfraConflictReason), failedCondition.Reason)
+
+	deployment := &appsv1.Deployment{ObjectMeta: v1.ObjectMeta{Name: dataIndex.GetName(), Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -43,9 +43,10 @@ func initExplainabilitySupportingServiceResource(context supportingServiceContex
 func (e *explainabilitySupportingServiceResource) Reconcile() (err error) {
 	e.Log.Info("Reconciling KogitoExplainability")
 	definition := kogitoservice.ServiceDefinition{
-		DefaultImageName: DefaultExplainabilityImageName,
-		Request:          controller.Request{NamespacedName: types.NamespacedName{Name: e.instance.GetName(), Namespace: e.instance.GetNamespace()}},
-		KafkaTopics:      explainabilitykafkaTopics,
+		DefaultImageName:      DefaultExplainabilityImageName,
+		Request:               controller.Request{NamespacedName: types.NamespacedName{Name: e.instance.GetName(), Namespace: e.instance.GetNamespace()}},
+		KafkaTopics:           explainabilitykafkaTopics,
+		OnNetworkPolicyCreate: e.onNetworkPolicyCreate,
 	}
 	return kogitoservice.NewServiceDeployer(e.Context, definition, e.instance, e.infraHandler).Deploy()
 }
@@ -15,6 +15,7 @@
 package kogitosupportingservice
 
 import (
+	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/connector"
 	"github.com/kiegroup/kogito-operator/core/kogitoservice"
 	"k8s.io/apimachinery/pkg/types"
@@ -57,9 +58,15 @@ func (j *jobsServiceSupportingServiceResource) Reconcile() (err error) {
 	}
 	definition := kogitoservice.ServiceDefinition{
 		DefaultImageName: DefaultJobsServiceImageName,
-		Request:          controller.Request{NamespacedName: types.NamespacedName{Name: j.instance.GetName(), Namespace: j.instance.GetNamespace()}},
-		SingleReplica:    true,
-		KafkaTopics:      jobsServicekafkaTopics,
+		PersistenceImageNames: map[api.PersistenceBackendType]string{
+			api.InfinispanPersistenceBackend: JobsServiceInfinispanImageName,
+			api.MongoDBPersistenceBackend:    JobsServiceMongoDBImageName,
+			api.PostgreSQLPersistenceBackend: JobsServicePostgresqlImageName,
+		},
+		Request:               controller.Request{NamespacedName: types.NamespacedName{Name: j.instance.GetName(), Namespace: j.instance.GetNamespace()}},
+		SingleReplica:         IsSingleReplicaService(api.JobsService),
+		KafkaTopics:           jobsServicekafkaTopics,
+		OnNetworkPolicyCreate: j.onNetworkPolicyCreate,
 	}
 	return kogitoservice.NewServiceDeployer(j.Context, definition, j.instance, j.infraHandler).Deploy()
 }
@@ -15,12 +15,15 @@
 package kogitosupportingservice
 
 import (
+	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/test"
 	"github.com/kiegroup/kogito-operator/internal/app"
 	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"testing"
 )
 
@@ -55,3 +58,34 @@ func TestReconcileKogitoJobsService_Reconcile(t *testing.T) {
 	assert.NotNil(t, jobsService.GetStatus())
 	assert.Len(t, *jobsService.GetStatus().GetConditions(), 2)
 }
+
+func TestReconcileKogitoJobsService_ReconcileWithMongoDB(t *testing.T) {
+	ns := t.Name()
+	kogitoMongoDB := test.CreateFakeKogitoMongoDB(ns)
+	jobsService := test.CreateFakeJobsService(ns)
+	jobsService.GetSpec().AddInfra(kogitoMongoDB.GetName())
+	cli := test.NewFakeClientBuilder().AddK8sObjects(jobsService, kogitoMongoDB).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	r := &jobsServiceSupportingServiceResource{
+		supportingServiceContext: supportingServiceContext{
+			Context:                  context,
+			instance:                 jobsService,
+			supportingServiceHandler: app.NewKogitoSupportingServiceHandler(context),
+			infraHandler:             app.NewKogitoInfraHandler(context),
+			runtimeHandler:           app.NewKogitoRuntimeHandler(context),
+		},
+	}
+	err := r.Reconcile()
+	assert.NoError(t, err)
+
+	deployment := &appsv1.Deployment{ObjectMeta: v1.ObjectMeta{Name: jobsService.GetName(), Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Contains(t, deployment.Spec.Template.Spec.Containers[0].Image, JobsServiceMongoDBImageName)
+	assert.Equal(t, api.MongoDBPersistenceBackend, jobsService.GetSupportingServiceStatus().GetPersistenceBackend())
+}
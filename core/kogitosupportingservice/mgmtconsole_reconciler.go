@@ -45,10 +45,11 @@ func initMgmtConsoleSupportingServiceResource(context supportingServiceContext)
 func (m *mgmtConsoleSupportingServiceResource) Reconcile() (err error) {
 	m.Log.Info("Reconciling for KogitoMgmtConsole")
 	definition := kogitoservice.ServiceDefinition{
-		DefaultImageName:   DefaultMgmtConsoleImageName,
-		Request:            controller.Request{NamespacedName: types.NamespacedName{Name: m.instance.GetName(), Namespace: m.instance.GetNamespace()}},
-		SingleReplica:      false,
-		OnDeploymentCreate: m.mgmtConsoleOnDeploymentCreate,
+		DefaultImageName:      DefaultMgmtConsoleImageName,
+		Request:               controller.Request{NamespacedName: types.NamespacedName{Name: m.instance.GetName(), Namespace: m.instance.GetNamespace()}},
+		SingleReplica:         false,
+		OnDeploymentCreate:    m.mgmtConsoleOnDeploymentCreate,
+		OnNetworkPolicyCreate: m.onNetworkPolicyCreate,
 	}
 	return kogitoservice.NewServiceDeployer(m.Context, definition, m.instance, m.infraHandler).Deploy()
 }
@@ -16,8 +16,11 @@ package kogitosupportingservice
 
 import (
 	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/connector"
 	"github.com/kiegroup/kogito-operator/core/manager"
 	"github.com/kiegroup/kogito-operator/core/operator"
+	networkingv1 "k8s.io/api/networking/v1"
+	"sort"
 )
 
 // Reconciler Interface to represent type of kogito supporting service resources like JobsService & MgmtConcole
@@ -33,6 +36,12 @@ type supportingServiceContext struct {
 	runtimeHandler           manager.KogitoRuntimeHandler
 }
 
+// onNetworkPolicyCreate allows the connections between the supporting service and the other Kogito services in the NetworkPolicy
+func (s *supportingServiceContext) onNetworkPolicyCreate(networkPolicy *networkingv1.NetworkPolicy) error {
+	topologyHandler := connector.NewTopologyHandler(s.Context, s.runtimeHandler, s.supportingServiceHandler)
+	return topologyHandler.InjectSupportingServiceTopologyIntoNetworkPolicy(s.instance.GetNamespace(), s.instance.GetSupportingServiceSpec().GetServiceType(), networkPolicy)
+}
+
 // ReconcilerHandler ...
 type ReconcilerHandler interface {
 	GetSupportingServiceReconciler(instance api.KogitoSupportingServiceInterface) Reconciler
@@ -65,17 +74,50 @@ func (k *reconcilerHandler) GetSupportingServiceReconciler(instance api.KogitoSu
 		supportingServiceHandler: k.supportingServiceHandler,
 		runtimeHandler:           k.runtimeHandler,
 	}
-	return getSupportedResources(context)[instance.GetSupportingServiceSpec().GetServiceType()]
+	if initReconciler, ok := getSupportedResources()[instance.GetSupportingServiceSpec().GetServiceType()]; ok {
+		return initReconciler(context)
+	}
+	return nil
 }
 
-func getSupportedResources(context supportingServiceContext) map[api.ServiceType]Reconciler {
-	return map[api.ServiceType]Reconciler{
-		api.DataIndex:      initDataIndexSupportingServiceResource(context),
-		api.Explainability: initExplainabilitySupportingServiceResource(context),
-		api.JobsService:    initJobsServiceSupportingServiceResource(context),
-		api.MgmtConsole:    initMgmtConsoleSupportingServiceResource(context),
-		api.TaskConsole:    initTaskConsoleSupportingServiceResource(context),
-		api.TrustyAI:       initTrustyAISupportingServiceResource(context),
-		api.TrustyUI:       initTrustyUISupportingServiceResource(context),
+// supportingServiceReconcilerInitializer creates the Reconciler in charge of a supported service type
+type supportingServiceReconcilerInitializer func(context supportingServiceContext) Reconciler
+
+func getSupportedResources() map[api.ServiceType]supportingServiceReconcilerInitializer {
+	return map[api.ServiceType]supportingServiceReconcilerInitializer{
+		api.DataIndex:      initDataIndexSupportingServiceResource,
+		api.Explainability: initExplainabilitySupportingServiceResource,
+		api.JobsService:    initJobsServiceSupportingServiceResource,
+		api.MgmtConsole:    initMgmtConsoleSupportingServiceResource,
+		api.TaskConsole:    initTaskConsoleSupportingServiceResource,
+		api.TrustyAI:       initTrustyAISupportingServiceResource,
+		api.TrustyUI:       initTrustyUISupportingServiceResource,
 	}
 }
+
+// singleReplicaServices are the supporting services that can't be scaled beyond one replica
+var singleReplicaServices = map[api.ServiceType]bool{
+	api.JobsService: true,
+}
+
+// IsServiceTypeSupported checks if the given ServiceType is handled by one of the supporting service reconcilers
+func IsServiceTypeSupported(serviceType api.ServiceType) bool {
+	_, ok := getSupportedResources()[serviceType]
+	return ok
+}
+
+// GetSupportedServiceTypes gets the sorted list of ServiceTypes handled by the supporting service reconcilers
+func GetSupportedServiceTypes() []string {
+	res := getSupportedResources()
+	serviceTypes := make([]string, 0, len(res))
+	for serviceType := range res {
+		serviceTypes = append(serviceTypes, string(serviceType))
+	}
+	sort.Strings(serviceTypes)
+	return serviceTypes
+}
+
+// IsSingleReplicaService checks if the given ServiceType can only be deployed with one replica
+func IsSingleReplicaService(serviceType api.ServiceType) bool {
+	return singleReplicaServices[serviceType]
+}
@@ -45,10 +45,11 @@ func initTaskConsoleSupportingServiceResource(context supportingServiceContext)
 func (t *taskConsoleSupportingServiceResource) Reconcile() (err error) {
 	t.Log.Info("Reconciling for KogitoTaskConsole")
 	definition := kogitoservice.ServiceDefinition{
-		DefaultImageName:   DefaultTaskConsoleImageName,
-		Request:            controller.Request{NamespacedName: types.NamespacedName{Name: t.instance.GetName(), Namespace: t.instance.GetNamespace()}},
-		SingleReplica:      false,
-		OnDeploymentCreate: t.taskConsoleOnDeploymentCreate,
+		DefaultImageName:      DefaultTaskConsoleImageName,
+		Request:               controller.Request{NamespacedName: types.NamespacedName{Name: t.instance.GetName(), Namespace: t.instance.GetNamespace()}},
+		SingleReplica:         false,
+		OnDeploymentCreate:    t.taskConsoleOnDeploymentCreate,
+		OnNetworkPolicyCreate: t.onNetworkPolicyCreate,
 	}
 	return kogitoservice.NewServiceDeployer(t.Context, definition, t.instance, t.infraHandler).Deploy()
 }
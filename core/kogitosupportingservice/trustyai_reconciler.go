@@ -48,9 +48,10 @@ func (t *trustyAISupportingServiceResource) Reconcile() (err error) {
 		return
 	}
 	definition := kogitoservice.ServiceDefinition{
-		DefaultImageName: DefaultTrustyImageName,
-		Request:          controller.Request{NamespacedName: types.NamespacedName{Name: t.instance.GetName(), Namespace: t.instance.GetNamespace()}},
-		KafkaTopics:      trustyAiKafkaTopics,
+		DefaultImageName:      DefaultTrustyImageName,
+		Request:               controller.Request{NamespacedName: types.NamespacedName{Name: t.instance.GetName(), Namespace: t.instance.GetNamespace()}},
+		KafkaTopics:           trustyAiKafkaTopics,
+		OnNetworkPolicyCreate: t.onNetworkPolicyCreate,
 	}
 	return kogitoservice.NewServiceDeployer(t.Context, definition, t.instance, t.infraHandler).Deploy()
 }
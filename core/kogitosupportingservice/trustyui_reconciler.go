@@ -45,10 +45,11 @@ func initTrustyUISupportingServiceResource(context supportingServiceContext) Rec
 func (t *trustyUISupportingServiceResource) Reconcile() (err error) {
 	t.Log.Info("Reconciling for KogitoTrustyUI")
 	definition := kogitoservice.ServiceDefinition{
-		DefaultImageName:   DefaultTrustyUIImageName,
-		Request:            controller.Request{NamespacedName: types.NamespacedName{Name: t.instance.GetName(), Namespace: t.instance.GetNamespace()}},
-		SingleReplica:      false,
-		OnDeploymentCreate: t.trustyUIOnDeploymentCreate,
+		DefaultImageName:      DefaultTrustyUIImageName,
+		Request:               controller.Request{NamespacedName: types.NamespacedName{Name: t.instance.GetName(), Namespace: t.instance.GetNamespace()}},
+		SingleReplica:         false,
+		OnDeploymentCreate:    t.trustyUIOnDeploymentCreate,
+		OnNetworkPolicyCreate: t.onNetworkPolicyCreate,
 	}
 	return kogitoservice.NewServiceDeployer(t.Context, definition, t.instance, t.infraHandler).Deploy()
 }
@@ -0,0 +1,161 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "kogito_operator"
+
+	// ReconcileSuccess is the result of a reconciliation that completed without errors
+	ReconcileSuccess = "success"
+	// ReconcileRequeue is the result of a reconciliation waiting for resources to be ready, rescheduled later
+	ReconcileRequeue = "requeue"
+	// ReconcileFailure is the result of a reconciliation that failed
+	ReconcileFailure = "failure"
+
+	buildPhaseNew       = "New"
+	buildPhasePending   = "Pending"
+	buildPhaseRunning   = "Running"
+	buildPhaseComplete  = "Complete"
+	buildPhaseFailed    = "Failed"
+	buildPhaseError     = "Error"
+	buildPhaseCancelled = "Cancelled"
+)
+
+var (
+	reconcileTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "reconcile_total",
+			Help:      "Number of reconciliations of the Kogito resources per kind, result and reason",
+		},
+		[]string{"kind", "result", "reason"},
+	)
+	infraReady = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "kogitoinfra_ready",
+			Help:      "Whether the KogitoInfra is configured (1) or not (0), per kind of infrastructure resource",
+		},
+		[]string{"namespace", "name", "resource_kind"},
+	)
+	builds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "kogitobuild_builds",
+			Help:      "Number of builds of the KogitoBuild per phase",
+		},
+		[]string{"namespace", "name", "phase"},
+	)
+	timeToDeployed = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "time_to_deployed_seconds",
+			Help:      "Time taken by a Kogito service to become deployed, since its creation or since it was last not deployed",
+			Buckets:   []float64{10, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+		},
+		[]string{"kind"},
+	)
+
+	// infraResourceKinds keeps the resource kind last reported for each KogitoInfra, so its series can be removed once deleted
+	infraResourceKinds     = map[types.NamespacedName]string{}
+	infraResourceKindsLock sync.Mutex
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(reconcileTotal, infraReady, builds, timeToDeployed)
+}
+
+// GetKind gets the kind of the given object from the scheme, since the objects fetched by the client don't carry their TypeMeta
+func GetKind(object runtime.Object, scheme *runtime.Scheme) string {
+	if gvk := object.GetObjectKind().GroupVersionKind(); len(gvk.Kind) > 0 {
+		return gvk.Kind
+	}
+	gvk, err := apiutil.GVKForObject(object, scheme)
+	if err != nil {
+		return ""
+	}
+	return gvk.Kind
+}
+
+// RecordReconcile counts a reconciliation of a Kogito resource of the given kind with its result and the reason of the error, if any
+func RecordReconcile(kind, result, reason string) {
+	reconcileTotal.WithLabelValues(kind, result, reason).Inc()
+}
+
+// SetKogitoInfraReady reports the readiness of the given KogitoInfra
+func SetKogitoInfraReady(namespace, name, resourceKind string, ready bool) {
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	infraResourceKindsLock.Lock()
+	defer infraResourceKindsLock.Unlock()
+	if previousKind, exists := infraResourceKinds[key]; exists && previousKind != resourceKind {
+		infraReady.DeleteLabelValues(namespace, name, previousKind)
+	}
+	infraResourceKinds[key] = resourceKind
+	value := float64(0)
+	if ready {
+		value = 1
+	}
+	infraReady.WithLabelValues(namespace, name, resourceKind).Set(value)
+}
+
+// DeleteKogitoInfra removes the series of a deleted KogitoInfra
+func DeleteKogitoInfra(namespace, name string) {
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	infraResourceKindsLock.Lock()
+	defer infraResourceKindsLock.Unlock()
+	if resourceKind, exists := infraResourceKinds[key]; exists {
+		infraReady.DeleteLabelValues(namespace, name, resourceKind)
+		delete(infraResourceKinds, key)
+	}
+}
+
+// SetKogitoBuilds reports the number of builds per phase of the given KogitoBuild
+func SetKogitoBuilds(namespace, name string, buildsStatus api.BuildsInterface) {
+	if buildsStatus == nil {
+		DeleteKogitoBuild(namespace, name)
+		return
+	}
+	builds.WithLabelValues(namespace, name, buildPhaseNew).Set(float64(len(buildsStatus.GetNew())))
+	builds.WithLabelValues(namespace, name, buildPhasePending).Set(float64(len(buildsStatus.GetPending())))
+	builds.WithLabelValues(namespace, name, buildPhaseRunning).Set(float64(len(buildsStatus.GetRunning())))
+	builds.WithLabelValues(namespace, name, buildPhaseComplete).Set(float64(len(buildsStatus.GetComplete())))
+	builds.WithLabelValues(namespace, name, buildPhaseFailed).Set(float64(len(buildsStatus.GetFailed())))
+	builds.WithLabelValues(namespace, name, buildPhaseError).Set(float64(len(buildsStatus.GetError())))
+	builds.WithLabelValues(namespace, name, buildPhaseCancelled).Set(float64(len(buildsStatus.GetCancelled())))
+}
+
+// DeleteKogitoBuild removes the series of a deleted KogitoBuild
+func DeleteKogitoBuild(namespace, name string) {
+	for _, phase := range []string{buildPhaseNew, buildPhasePending, buildPhaseRunning, buildPhaseComplete, buildPhaseFailed, buildPhaseError, buildPhaseCancelled} {
+		builds.DeleteLabelValues(namespace, name, phase)
+	}
+}
+
+// ObserveTimeToDeployed records the time a Kogito service of the given kind took to become deployed
+func ObserveTimeToDeployed(kind string, duration time.Duration) {
+	timeToDeployed.WithLabelValues(kind).Observe(duration.Seconds())
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetKind(t *testing.T) {
+	assert.Equal(t, "KogitoRuntime", GetKind(&v1beta1.KogitoRuntime{}, meta.GetRegisteredSchema()))
+}
+
+func TestRecordReconcile(t *testing.T) {
+	RecordReconcile("KogitoRuntime", ReconcileRequeue, "MessagingProvisionFailure")
+	RecordReconcile("KogitoRuntime", ReconcileRequeue, "MessagingProvisionFailure")
+	assert.Equal(t, float64(2), testutil.ToFloat64(reconcileTotal.WithLabelValues("KogitoRuntime", ReconcileRequeue, "MessagingProvisionFailure")))
+}
+
+func TestSetKogitoInfraReady(t *testing.T) {
+	SetKogitoInfraReady(t.Name(), "kogito-kafka", "Kafka", false)
+	assert.Equal(t, float64(0), testutil.ToFloat64(infraReady.WithLabelValues(t.Name(), "kogito-kafka", "Kafka")))
+
+	SetKogitoInfraReady(t.Name(), "kogito-kafka", "Kafka", true)
+	assert.Equal(t, float64(1), testutil.ToFloat64(infraReady.WithLabelValues(t.Name(), "kogito-kafka", "Kafka")))
+	assert.Equal(t, 1, testutil.CollectAndCount(infraReady))
+
+	// the series of the previous resource kind is replaced
+	SetKogitoInfraReady(t.Name(), "kogito-kafka", "KafkaTopic", true)
+	assert.Equal(t, 1, testutil.CollectAndCount(infraReady))
+
+	DeleteKogitoInfra(t.Name(), "kogito-kafka")
+	assert.Equal(t, 0, testutil.CollectAndCount(infraReady))
+}
+
+func TestSetKogitoBuilds(t *testing.T) {
+	SetKogitoBuilds(t.Name(), "quarkus-example", &v1beta1.Builds{Running: []string{"quarkus-example-2"}, Complete: []string{"quarkus-example-1"}, Failed: []string{"quarkus-example-0"}})
+	assert.Equal(t, float64(1), testutil.ToFloat64(builds.WithLabelValues(t.Name(), "quarkus-example", buildPhaseRunning)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(builds.WithLabelValues(t.Name(), "quarkus-example", buildPhasePending)))
+	assert.Equal(t, 7, testutil.CollectAndCount(builds))
+
+	SetKogitoBuilds(t.Name(), "quarkus-example", nil)
+	assert.Equal(t, 0, testutil.CollectAndCount(builds))
+}
+
+func TestObserveTimeToDeployed(t *testing.T) {
+	ObserveTimeToDeployed("KogitoRuntime", 45*time.Second)
+	assert.Equal(t, 1, testutil.CollectAndCount(timeToDeployed))
+}
@@ -131,9 +131,13 @@ func (p *protobufConfigMapHandler) getProtobufData(runtimeInstance api.KogitoRun
 		return nil, infrastructure.ErrorForDeploymentNotReachable(runtimeInstance.GetName())
 	}
 
+	httpClient, err := p.kogitoServiceHandler.GetKogitoServiceHTTPClient(runtimeInstance)
+	if err != nil {
+		return nil, err
+	}
 	protobufEndpoint := p.kogitoServiceHandler.GetKogitoServiceEndpoint(runtimeInstance) + protobufSubdir
 	protobufListURL := protobufEndpoint + protobufListFileName
-	protobufListBytes, err := getHTTPFileBytes(protobufListURL)
+	protobufListBytes, err := getHTTPFileBytes(httpClient, protobufListURL)
 	if err != nil {
 		p.Log.Error(err, "failed to get protobuf file list", "protobufListURL", protobufListURL)
 		return nil, err
@@ -154,7 +158,7 @@ func (p *protobufConfigMapHandler) getProtobufData(runtimeInstance api.KogitoRun
 	data := map[string]string{}
 	for _, fileName := range protobufList {
 		protobufFileURL := protobufEndpoint + fileName
-		protobufFileBytes, err = getHTTPFileBytes(protobufFileURL)
+		protobufFileBytes, err = getHTTPFileBytes(httpClient, protobufFileURL)
 		if err != nil {
 			p.Log.Error(err, "failed to fetch protobuf", "Protobuf Url", protobufFileURL)
 			continue
@@ -173,8 +177,8 @@ func (p *protobufConfigMapHandler) getProtoBufConfigMapName(runtimeInstance api.
 	return fmt.Sprintf("%s-%s", runtimeInstance.GetName(), protobufConfigMapSuffix)
 }
 
-func getHTTPFileBytes(fileURL string) ([]byte, error) {
-	res, err := http.Get(fileURL)
+func getHTTPFileBytes(httpClient *http.Client, fileURL string) ([]byte, error) {
+	res, err := httpClient.Get(fileURL)
 	if err != nil {
 		return nil, err
 	}
@@ -19,11 +19,13 @@ import "os"
 // SetSharedEnv sets a value to a given Environment variable
 // returns the defer function that MUST be called after your test to not mess up with users' env
 func SetSharedEnv(k, v string) (deferFunc func()) {
-	backupValue := os.Getenv(k)
+	backupValue, exists := os.LookupEnv(k)
 	_ = os.Setenv(k, v)
 	return func() {
-		if len(backupValue) > 0 {
+		if exists {
 			_ = os.Setenv(k, backupValue)
+		} else {
+			_ = os.Unsetenv(k)
 		}
 	}
 }
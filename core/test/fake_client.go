@@ -36,6 +36,9 @@ type FakeClientBuilder interface {
 	OnOpenShift() FakeClientBuilder
 	SupportPrometheus() FakeClientBuilder
 	SupportOLM() FakeClientBuilder
+	SupportIstio() FakeClientBuilder
+	SupportKnativeServing() FakeClientBuilder
+	SupportCertManager() FakeClientBuilder
 	Build() *kogitocli.Client
 }
 
@@ -46,12 +49,15 @@ func NewFakeClientBuilder() FakeClientBuilder {
 }
 
 type fakeClientStruct struct {
-	objects    []runtime.Object
-	imageObjs  []runtime.Object
-	buildObjs  []runtime.Object
-	openShift  bool
-	prometheus bool
-	olm        bool
+	objects     []runtime.Object
+	imageObjs   []runtime.Object
+	buildObjs   []runtime.Object
+	openShift   bool
+	prometheus  bool
+	olm         bool
+	istio       bool
+	serving     bool
+	certManager bool
 }
 
 // AddK8sObjects ...
@@ -82,6 +88,21 @@ func (f *fakeClientStruct) SupportOLM() FakeClientBuilder {
 	return f
 }
 
+func (f *fakeClientStruct) SupportIstio() FakeClientBuilder {
+	f.istio = true
+	return f
+}
+
+func (f *fakeClientStruct) SupportKnativeServing() FakeClientBuilder {
+	f.serving = true
+	return f
+}
+
+func (f *fakeClientStruct) SupportCertManager() FakeClientBuilder {
+	f.certManager = true
+	return f
+}
+
 // OnOpenShift ...
 func (f *fakeClientStruct) OnOpenShift() FakeClientBuilder {
 	f.openShift = true
@@ -126,6 +147,7 @@ func (f *fakeClientStruct) createFakeDiscoveryClient() discovery.DiscoveryInterf
 				{GroupVersion: "kafka.strimzi.io/v1beta2"},
 				{GroupVersion: "keycloak.org/v1alpha1"},
 				{GroupVersion: "mongodbcommunity.mongodb.com/v1"},
+				{GroupVersion: "postgres-operator.crunchydata.com/v1beta1"},
 				{GroupVersion: "app.kiegroup.org/v1beta1"},
 			},
 		},
@@ -146,6 +168,21 @@ func (f *fakeClientStruct) createFakeDiscoveryClient() discovery.DiscoveryInterf
 		disco.Fake.Resources = append(disco.Fake.Resources,
 			&metav1.APIResourceList{GroupVersion: "operators.coreos.com/v1"})
 	}
+
+	if f.istio {
+		disco.Fake.Resources = append(disco.Fake.Resources,
+			&metav1.APIResourceList{GroupVersion: "networking.istio.io/v1beta1"})
+	}
+
+	if f.serving {
+		disco.Fake.Resources = append(disco.Fake.Resources,
+			&metav1.APIResourceList{GroupVersion: "serving.knative.dev/v1"})
+	}
+
+	if f.certManager {
+		disco.Fake.Resources = append(disco.Fake.Resources,
+			&metav1.APIResourceList{GroupVersion: "cert-manager.io/v1"})
+	}
 	return disco
 }
 
@@ -15,7 +15,10 @@
 package test
 
 import (
+	"io/ioutil"
+
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -77,3 +80,20 @@ func CreateFakeKafka(namespace string) *v1beta2.Kafka {
 		},
 	}
 }
+
+// CreateFakeKafkaClusterCACertSecret creates the Secret holding the cluster CA certificate generated by Strimzi for the fake Kafka instance
+func CreateFakeKafkaClusterCACertSecret(namespace string) (*v1.Secret, error) {
+	crtFile, err := ioutil.ReadFile("./testdata/tls.crt")
+	if err != nil {
+		return nil, err
+	}
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kogito-kafka-cluster-ca-cert",
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"ca.crt": crtFile,
+		},
+	}, nil
+}
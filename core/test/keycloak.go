@@ -0,0 +1,37 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	keycloak "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CreateFakeKeycloak ...
+func CreateFakeKeycloak(namespace string) *keycloak.Keycloak {
+	return &keycloak.Keycloak{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kogito-keycloak",
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app": "sso",
+			},
+		},
+		Status: keycloak.KeycloakStatus{
+			Ready:       true,
+			InternalURL: "https://keycloak." + namespace + ".svc:8443",
+		},
+	}
+}
@@ -137,3 +137,83 @@ func CreateFakeKogitoMongoDB(namespace string) api.KogitoInfraInterface {
 		},
 	}
 }
+
+// CreateFakeKogitoPostgreSQL create fake kogito infra instance for PostgreSQL
+func CreateFakeKogitoPostgreSQL(namespace string) api.KogitoInfraInterface {
+	return &v1beta1.KogitoInfra{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "kogito-postgresql-infra",
+			Namespace: namespace,
+		},
+		Spec: v1beta1.KogitoInfraSpec{
+			Resource: &v1beta1.InfraResource{
+				Kind:       "PostgresCluster",
+				APIVersion: "postgres-operator.crunchydata.com/v1beta1",
+				Name:       "kogito-postgresql",
+			},
+			InfraProperties: map[string]string{
+				"username": "kogito",
+			},
+		},
+		Status: v1beta1.KogitoInfraStatus{
+			Conditions: &[]v1.Condition{
+				{
+					Type:   string(api.KogitoInfraConfigured),
+					Status: v1.ConditionTrue,
+				},
+			},
+		},
+	}
+}
+
+// CreateFakeKogitoKeycloak create fake kogito infra instance for Keycloak
+func CreateFakeKogitoKeycloak(namespace string) api.KogitoInfraInterface {
+	return &v1beta1.KogitoInfra{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "kogito-keycloak-infra",
+			Namespace: namespace,
+		},
+		Spec: v1beta1.KogitoInfraSpec{
+			Resource: &v1beta1.InfraResource{
+				Kind:       "Keycloak",
+				APIVersion: "keycloak.org/v1alpha1",
+				Name:       "kogito-keycloak",
+			},
+			InfraProperties: map[string]string{
+				"realm": "kogito-realm",
+			},
+		},
+		Status: v1beta1.KogitoInfraStatus{
+			Conditions: &[]v1.Condition{
+				{
+					Type:   string(api.KogitoInfraConfigured),
+					Status: v1.ConditionTrue,
+				},
+			},
+		},
+	}
+}
+
+// CreateFakeKogitoExternal create fake kogito infra instance for an external infrastructure of the given kind
+func CreateFakeKogitoExternal(namespace string, kind api.ExternalInfraKind, uri string) api.KogitoInfraInterface {
+	return &v1beta1.KogitoInfra{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "kogito-external-infra",
+			Namespace: namespace,
+		},
+		Spec: v1beta1.KogitoInfraSpec{
+			External: &v1beta1.ExternalInfra{
+				Kind: kind,
+				URI:  uri,
+			},
+		},
+		Status: v1beta1.KogitoInfraStatus{
+			Conditions: &[]v1.Condition{
+				{
+					Type:   string(api.KogitoInfraConfigured),
+					Status: v1.ConditionTrue,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	postgresql "github.com/kiegroup/kogito-operator/core/infrastructure/postgresql/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CreateFakePostgreSQL ...
+func CreateFakePostgreSQL(namespace string) *postgresql.PostgresCluster {
+	return &postgresql.PostgresCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kogito-postgresql",
+			Namespace: namespace,
+		},
+		Spec: postgresql.PostgresClusterSpec{
+			PostgresVersion: 13,
+			Users: []postgresql.PostgresUserSpec{
+				{
+					Name:      "kogito",
+					Databases: []string{"kogito"},
+				},
+			},
+		},
+		Status: postgresql.PostgresClusterStatus{
+			InstanceSets: []postgresql.PostgresInstanceSetStatus{
+				{
+					Name:          "instance1",
+					Replicas:      1,
+					ReadyReplicas: 1,
+				},
+			},
+		},
+	}
+}
+
+// CreateFakePostgreSQLUserSecret ...
+func CreateFakePostgreSQLUserSecret(namespace string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kogito-postgresql-pguser-kogito",
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"host":     []byte("kogito-postgresql-primary." + namespace + ".svc"),
+			"port":     []byte("5432"),
+			"dbname":   []byte("kogito"),
+			"user":     []byte("kogito"),
+			"password": []byte("passwordToFind"),
+		},
+	}
+}
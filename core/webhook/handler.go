@@ -0,0 +1,136 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Defaulter sets the default values in the given Kogito resource
+type Defaulter func(object client.Object)
+
+// Validator verifies the given Kogito resource, returning every invalid field found.
+// The reader gets the objects of the cluster the resource depends on, e.g. the claims referenced by its volumes.
+type Validator func(reader client.Reader, object client.Object) field.ErrorList
+
+// Register registers the mutating and validating admission webhooks for the type of the given Kogito resource.
+// Paths follow the kubebuilder convention, e.g. /mutate-app-kiegroup-org-v1beta1-kogitoruntime.
+func Register(mgr ctrl.Manager, object client.Object, defaulter Defaulter, validator Validator) error {
+	gvk, err := apiutil.GVKForObject(object, mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+	mutatingHandler, err := NewMutatingHandler(mgr.GetScheme(), object, defaulter)
+	if err != nil {
+		return err
+	}
+	// the API reader doesn't start informers for the objects read by the validators
+	validatingHandler, err := NewValidatingHandler(mgr.GetScheme(), mgr.GetAPIReader(), object, validator)
+	if err != nil {
+		return err
+	}
+	server := mgr.GetWebhookServer()
+	server.Register(getWebhookPath("mutate", gvk), &admission.Webhook{Handler: mutatingHandler})
+	server.Register(getWebhookPath("validate", gvk), &admission.Webhook{Handler: validatingHandler})
+	return nil
+}
+
+func getWebhookPath(prefix string, gvk schema.GroupVersionKind) string {
+	return fmt.Sprintf("/%s-%s-%s-%s", prefix, strings.ReplaceAll(gvk.Group, ".", "-"), gvk.Version, strings.ToLower(gvk.Kind))
+}
+
+type mutatingHandler struct {
+	object    client.Object
+	defaulter Defaulter
+	decoder   *admission.Decoder
+}
+
+// NewMutatingHandler creates an admission.Handler that applies the given Defaulter to the admitted objects
+func NewMutatingHandler(scheme *runtime.Scheme, object client.Object, defaulter Defaulter) (admission.Handler, error) {
+	decoder, err := admission.NewDecoder(scheme)
+	if err != nil {
+		return nil, err
+	}
+	return &mutatingHandler{object: object, defaulter: defaulter, decoder: decoder}, nil
+}
+
+// Handle decodes the admitted object, sets its defaults and replies with the resulting patch
+func (m *mutatingHandler) Handle(_ context.Context, req admission.Request) admission.Response {
+	object := m.object.DeepCopyObject().(client.Object)
+	if err := m.decoder.Decode(req, object); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	// on creation the namespace might only be in the request
+	if len(object.GetNamespace()) == 0 {
+		object.SetNamespace(req.Namespace)
+	}
+	m.defaulter(object)
+	marshalled, err := json.Marshal(object)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshalled)
+}
+
+type validatingHandler struct {
+	reader    client.Reader
+	object    client.Object
+	validator Validator
+	decoder   *admission.Decoder
+}
+
+// NewValidatingHandler creates an admission.Handler that denies the admitted objects rejected by the given Validator
+func NewValidatingHandler(scheme *runtime.Scheme, reader client.Reader, object client.Object, validator Validator) (admission.Handler, error) {
+	decoder, err := admission.NewDecoder(scheme)
+	if err != nil {
+		return nil, err
+	}
+	return &validatingHandler{reader: reader, object: object, validator: validator, decoder: decoder}, nil
+}
+
+// Handle decodes the created or updated object and denies the request if it's not valid
+func (v *validatingHandler) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+	object := v.object.DeepCopyObject().(client.Object)
+	if err := v.decoder.Decode(req, object); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if errs := v.validator(v.reader, object); len(errs) > 0 {
+		status := errors.NewInvalid(schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}, object.GetName(), errs).Status()
+		return admission.Response{
+			AdmissionResponse: admissionv1.AdmissionResponse{
+				Allowed: false,
+				Result:  &status,
+			},
+		}
+	}
+	return admission.Allowed("")
+}
@@ -0,0 +1,119 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// newFakeReader creates a reader of a cluster holding the given objects
+func newFakeReader(objects ...client.Object) client.Reader {
+	return fake.NewClientBuilder().WithScheme(meta.GetRegisteredSchema()).WithObjects(objects...).Build()
+}
+
+func newAdmissionRequest(t *testing.T, operation admissionv1.Operation, object client.Object) admission.Request {
+	raw, err := json.Marshal(object)
+	assert.NoError(t, err)
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: operation,
+			Namespace: t.Name(),
+			Kind:      metav1.GroupVersionKind{Group: v1beta1.GroupVersion.Group, Version: v1beta1.GroupVersion.Version, Kind: "KogitoRuntime"},
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func TestMutatingHandler_SetsDefaults(t *testing.T) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.GroupVersion.String(), Kind: "KogitoRuntime"},
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example"},
+	}
+	handler, err := NewMutatingHandler(meta.GetRegisteredSchema(), &v1beta1.KogitoRuntime{}, DefaultKogitoRuntime)
+	assert.NoError(t, err)
+
+	response := handler.Handle(context.TODO(), newAdmissionRequest(t, admissionv1.Create, kogitoRuntime))
+	assert.True(t, response.Allowed)
+	paths := map[string]interface{}{}
+	for _, patch := range response.Patches {
+		paths[patch.Path] = patch.Value
+	}
+	assert.Contains(t, paths, "/spec/replicas")
+	assert.Contains(t, paths, "/spec/runtime")
+	assert.Equal(t, t.Name(), paths["/metadata/namespace"])
+}
+
+func TestMutatingHandler_NoPatchWhenDefaultsAreSet(t *testing.T) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.GroupVersion.String(), Kind: "KogitoRuntime"},
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec:       v1beta1.KogitoRuntimeSpec{Runtime: "springboot"},
+	}
+	kogitoRuntime.Spec.SetReplicas(2)
+	handler, err := NewMutatingHandler(meta.GetRegisteredSchema(), &v1beta1.KogitoRuntime{}, DefaultKogitoRuntime)
+	assert.NoError(t, err)
+
+	response := handler.Handle(context.TODO(), newAdmissionRequest(t, admissionv1.Update, kogitoRuntime))
+	assert.True(t, response.Allowed)
+	assert.Empty(t, response.Patches)
+}
+
+func TestValidatingHandler_DeniesInvalidObject(t *testing.T) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.GroupVersion.String(), Kind: "KogitoRuntime"},
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+	}
+	kogitoRuntime.Spec.SetImage("quay.io/kiegroup/Process Quarkus")
+	handler, err := NewValidatingHandler(meta.GetRegisteredSchema(), newFakeReader(), &v1beta1.KogitoRuntime{}, ValidateKogitoRuntime)
+	assert.NoError(t, err)
+
+	response := handler.Handle(context.TODO(), newAdmissionRequest(t, admissionv1.Create, kogitoRuntime))
+	assert.False(t, response.Allowed)
+	assert.Equal(t, int32(http.StatusUnprocessableEntity), response.Result.Code)
+	assert.Equal(t, metav1.StatusReasonInvalid, response.Result.Reason)
+	assert.Equal(t, "spec.image", response.Result.Details.Causes[0].Field)
+}
+
+func TestValidatingHandler_AllowsValidObjectAndDelete(t *testing.T) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.GroupVersion.String(), Kind: "KogitoRuntime"},
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+	}
+	kogitoRuntime.Spec.SetImage("quay.io/kiegroup/process-quarkus-example:latest")
+	handler, err := NewValidatingHandler(meta.GetRegisteredSchema(), newFakeReader(), &v1beta1.KogitoRuntime{}, ValidateKogitoRuntime)
+	assert.NoError(t, err)
+
+	assert.True(t, handler.Handle(context.TODO(), newAdmissionRequest(t, admissionv1.Update, kogitoRuntime)).Allowed)
+	assert.True(t, handler.Handle(context.TODO(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Delete}}).Allowed)
+}
+
+func TestGetWebhookPath(t *testing.T) {
+	gvk := v1beta1.GroupVersion.WithKind("KogitoSupportingService")
+	assert.Equal(t, "/mutate-app-kiegroup-org-v1beta1-kogitosupportingservice", getWebhookPath("mutate", gvk))
+	assert.Equal(t, "/validate-app-kiegroup-org-v1beta1-kogitosupportingservice", getWebhookPath("validate", gvk))
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultKogitoBuild sets the default values for the given KogitoBuild.
+// The backend is left to the operator, which picks it from the platform it runs on.
+func DefaultKogitoBuild(object client.Object) {
+	build := object.(api.KogitoBuildInterface)
+	if len(build.GetSpec().GetRuntime()) == 0 {
+		build.GetSpec().SetRuntime(api.QuarkusRuntimeType)
+	}
+}
+
+// ValidateKogitoBuild verifies the spec attributes for the given KogitoBuild
+func ValidateKogitoBuild(_ client.Reader, object client.Object) field.ErrorList {
+	var errs field.ErrorList
+	spec := object.(api.KogitoBuildInterface).GetSpec()
+	if len(spec.GetType()) == 0 {
+		errs = append(errs, field.Required(specPath.Child("type"), "build type is required"))
+	}
+	if spec.GetType() == api.RemoteSourceBuildType && len(spec.GetGitSource().GetURI()) == 0 {
+		errs = append(errs, field.Required(specPath.Child("gitSource", "uri"), "Git URL is required when build type is "+string(api.RemoteSourceBuildType)))
+	}
+	if image := spec.GetBuildImage(); len(image) > 0 && !framework.IsValidImage(image) {
+		errs = append(errs, field.Invalid(specPath.Child("buildImage"), image, invalidImageMessage))
+	}
+	if image := spec.GetRuntimeImage(); len(image) > 0 && !framework.IsValidImage(image) {
+		errs = append(errs, field.Invalid(specPath.Child("runtimeImage"), image, invalidImageMessage))
+	}
+	if spec.GetBackend() == api.TektonBuildBackend {
+		if spec.GetRegistry() == nil || len(spec.GetRegistry().GetURL()) == 0 {
+			errs = append(errs, field.Required(specPath.Child("registry", "url"), "Registry URL is required when build backend is "+string(api.TektonBuildBackend)))
+		}
+		if spec.GetType() != api.RemoteSourceBuildType && len(spec.GetSourceVolumeClaim()) == 0 {
+			errs = append(errs, field.Required(specPath.Child("sourceVolumeClaim"), "Source volume claim is required for "+string(spec.GetType())+" builds when build backend is "+string(api.TektonBuildBackend)))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestDefaultKogitoBuild(t *testing.T) {
+	kogitoBuild := &v1beta1.KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec:       v1beta1.KogitoBuildSpec{Type: api.BinaryBuildType},
+	}
+	DefaultKogitoBuild(kogitoBuild)
+	assert.Equal(t, api.QuarkusRuntimeType, kogitoBuild.Spec.Runtime)
+	assert.Empty(t, kogitoBuild.Spec.Backend)
+}
+
+func TestValidateKogitoBuild(t *testing.T) {
+	kogitoBuild := &v1beta1.KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoBuildSpec{
+			Type:         api.RemoteSourceBuildType,
+			GitSource:    v1beta1.GitSource{URI: "https://github.com/kiegroup/kogito-examples"},
+			RuntimeImage: "quay.io/kiegroup/kogito-runtime-jvm:latest",
+		},
+	}
+	assert.Empty(t, ValidateKogitoBuild(newFakeReader(), kogitoBuild))
+
+	kogitoBuild.Spec.GitSource.URI = ""
+	kogitoBuild.Spec.BuildImage = "quay.io//kogito-builder"
+	errs := ValidateKogitoBuild(newFakeReader(), kogitoBuild)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+	assert.Equal(t, "spec.gitSource.uri", errs[0].Field)
+	assert.Equal(t, "spec.buildImage", errs[1].Field)
+
+	kogitoBuild.Spec = v1beta1.KogitoBuildSpec{}
+	errs = ValidateKogitoBuild(newFakeReader(), kogitoBuild)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "spec.type", errs[0].Field)
+}
+
+func TestValidateKogitoBuild_TektonBackend(t *testing.T) {
+	kogitoBuild := &v1beta1.KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoBuildSpec{
+			Type:    api.LocalSourceBuildType,
+			Backend: api.TektonBuildBackend,
+		},
+	}
+	errs := ValidateKogitoBuild(newFakeReader(), kogitoBuild)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "spec.registry.url", errs[0].Field)
+	assert.Equal(t, "spec.sourceVolumeClaim", errs[1].Field)
+
+	kogitoBuild.Spec.Registry = &v1beta1.ImageRegistry{URL: "quay.io/kiegroup"}
+	kogitoBuild.Spec.SourceVolumeClaim = "process-quarkus-example-sources"
+	assert.Empty(t, ValidateKogitoBuild(newFakeReader(), kogitoBuild))
+
+	kogitoBuild.Spec.Type = api.RemoteSourceBuildType
+	kogitoBuild.Spec.GitSource = v1beta1.GitSource{URI: "https://github.com/kiegroup/kogito-examples"}
+	kogitoBuild.Spec.SourceVolumeClaim = ""
+	assert.Empty(t, ValidateKogitoBuild(newFakeReader(), kogitoBuild))
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/kogitoinfra"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"strings"
+)
+
+// DefaultKogitoInfra sets the default values for the given KogitoInfra
+func DefaultKogitoInfra(object client.Object) {
+	infra := object.(api.KogitoInfraInterface)
+	if !infra.GetSpec().IsResourceEmpty() && len(infra.GetSpec().GetResource().GetNamespace()) == 0 {
+		infra.GetSpec().GetResource().SetNamespace(infra.GetNamespace())
+	}
+}
+
+// ValidateKogitoInfra verifies the spec attributes for the given KogitoInfra
+func ValidateKogitoInfra(_ client.Reader, object client.Object) field.ErrorList {
+	infra := object.(api.KogitoInfraInterface)
+	errs := validateKafkaTopics(infra.GetSpec().GetKafkaTopics())
+	if !infra.GetSpec().IsExternalEmpty() {
+		errs = append(errs, validateExternalInfra(infra)...)
+	}
+	if infra.GetSpec().IsResourceEmpty() {
+		return errs
+	}
+	resource := infra.GetSpec().GetResource()
+	// resources of other API groups might be handled by an infra provider defined in the namespace
+	if kogitoinfra.IsBuiltInResourceGroup(resource.GetAPIVersion()) && !kogitoinfra.IsResourceSupported(resource.GetKind(), resource.GetAPIVersion()) {
+		errs = append(errs,
+			field.NotSupported(specPath.Child("resource"), strings.ToLower(resource.GetKind()+"."+resource.GetAPIVersion()), kogitoinfra.GetSupportedResources()))
+	}
+	return errs
+}
+
+// validateExternalInfra verifies that the external infrastructure is supported and is the only infrastructure of the KogitoInfra
+func validateExternalInfra(infra api.KogitoInfraInterface) field.ErrorList {
+	var errs field.ErrorList
+	externalPath := specPath.Child("external")
+	external := infra.GetSpec().GetExternal()
+	if !infra.GetSpec().IsResourceEmpty() {
+		errs = append(errs, field.Forbidden(externalPath, "resource and external can't be both defined"))
+	}
+	if !kogitoinfra.IsExternalInfraSupported(external.GetKind()) {
+		errs = append(errs, field.NotSupported(externalPath.Child("kind"), external.GetKind(), kogitoinfra.GetSupportedExternalInfraKinds()))
+	}
+	if len(external.GetURI()) == 0 {
+		errs = append(errs, field.Required(externalPath.Child("uri"), "URI of the external infrastructure can't be empty"))
+	}
+	return errs
+}
+
+// validateKafkaTopics verifies that every Kafka topic configuration has a unique name
+func validateKafkaTopics(kafkaTopics []api.KafkaTopicInterface) field.ErrorList {
+	var errs field.ErrorList
+	names := map[string]bool{}
+	for i, kafkaTopic := range kafkaTopics {
+		namePath := specPath.Child("kafkaTopics").Index(i).Child("name")
+		if len(kafkaTopic.GetName()) == 0 {
+			errs = append(errs, field.Required(namePath, "Kafka topic name can't be empty"))
+		} else if names[kafkaTopic.GetName()] {
+			errs = append(errs, field.Duplicate(namePath, kafkaTopic.GetName()))
+		}
+		names[kafkaTopic.GetName()] = true
+	}
+	return errs
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestDefaultKogitoInfra(t *testing.T) {
+	kogitoInfra := &v1beta1.KogitoInfra{
+		ObjectMeta: metav1.ObjectMeta{Name: "kogito-kafka", Namespace: t.Name()},
+		Spec: v1beta1.KogitoInfraSpec{
+			Resource: &v1beta1.InfraResource{APIVersion: infrastructure.KafkaAPIVersion, Kind: infrastructure.KafkaKind, Name: "kogito-kafka"},
+		},
+	}
+	DefaultKogitoInfra(kogitoInfra)
+	assert.Equal(t, t.Name(), kogitoInfra.Spec.Resource.Namespace)
+}
+
+func TestValidateKogitoInfra(t *testing.T) {
+	kogitoInfra := &v1beta1.KogitoInfra{
+		ObjectMeta: metav1.ObjectMeta{Name: "kogito-kafka", Namespace: t.Name()},
+	}
+	assert.Empty(t, ValidateKogitoInfra(newFakeReader(), kogitoInfra))
+
+	kogitoInfra.Spec.Resource = &v1beta1.InfraResource{APIVersion: infrastructure.KafkaAPIVersion, Kind: infrastructure.KafkaKind, Name: "kogito-kafka"}
+	assert.Empty(t, ValidateKogitoInfra(newFakeReader(), kogitoInfra))
+
+	kogitoInfra.Spec.Resource.APIVersion = "kafka.strimzi.io/v1alpha1"
+	errs := ValidateKogitoInfra(newFakeReader(), kogitoInfra)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+	assert.Equal(t, "spec.resource", errs[0].Field)
+
+	// might be handled by an infra provider
+	kogitoInfra.Spec.Resource = &v1beta1.InfraResource{APIVersion: "redis.redis.opstreelabs.in/v1beta1", Kind: "Redis", Name: "redis"}
+	assert.Empty(t, ValidateKogitoInfra(newFakeReader(), kogitoInfra))
+}
+
+func TestValidateKogitoInfra_KafkaTopics(t *testing.T) {
+	kogitoInfra := &v1beta1.KogitoInfra{
+		ObjectMeta: metav1.ObjectMeta{Name: "kogito-kafka", Namespace: t.Name()},
+		Spec: v1beta1.KogitoInfraSpec{
+			KafkaTopics: []v1beta1.KafkaTopic{{Name: "travellers", Partitions: 3}, {Name: "visas", Replicas: 2}},
+		},
+	}
+	assert.Empty(t, ValidateKogitoInfra(newFakeReader(), kogitoInfra))
+
+	kogitoInfra.Spec.KafkaTopics = append(kogitoInfra.Spec.KafkaTopics, v1beta1.KafkaTopic{Name: "travellers"}, v1beta1.KafkaTopic{})
+	errs := ValidateKogitoInfra(newFakeReader(), kogitoInfra)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, field.ErrorTypeDuplicate, errs[0].Type)
+	assert.Equal(t, "spec.kafkaTopics[2].name", errs[0].Field)
+	assert.Equal(t, field.ErrorTypeRequired, errs[1].Type)
+	assert.Equal(t, "spec.kafkaTopics[3].name", errs[1].Field)
+}
+
+func TestValidateKogitoInfra_External(t *testing.T) {
+	kogitoInfra := &v1beta1.KogitoInfra{
+		ObjectMeta: metav1.ObjectMeta{Name: "kogito-kafka", Namespace: t.Name()},
+		Spec: v1beta1.KogitoInfraSpec{
+			External: &v1beta1.ExternalInfra{Kind: api.KafkaExternalInfra, URI: "broker.example.com:9093"},
+		},
+	}
+	assert.Empty(t, ValidateKogitoInfra(newFakeReader(), kogitoInfra))
+
+	kogitoInfra.Spec.Resource = &v1beta1.InfraResource{APIVersion: infrastructure.KafkaAPIVersion, Kind: infrastructure.KafkaKind, Name: "kogito-kafka"}
+	kogitoInfra.Spec.External.URI = ""
+	errs := ValidateKogitoInfra(newFakeReader(), kogitoInfra)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.external", errs[0].Field)
+	assert.Equal(t, field.ErrorTypeRequired, errs[1].Type)
+	assert.Equal(t, "spec.external.uri", errs[1].Field)
+
+	kogitoInfra.Spec.Resource = nil
+	kogitoInfra.Spec.External = &v1beta1.ExternalInfra{Kind: "Redis", URI: "redis.example.com:6379"}
+	errs = ValidateKogitoInfra(newFakeReader(), kogitoInfra)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+	assert.Equal(t, "spec.external.kind", errs[0].Field)
+}
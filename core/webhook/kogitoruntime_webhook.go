@@ -0,0 +1,36 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultKogitoRuntime sets the default values for the given KogitoRuntime
+func DefaultKogitoRuntime(object client.Object) {
+	defaultKogitoService(object.(api.KogitoRuntimeInterface))
+}
+
+// ValidateKogitoRuntime verifies the spec attributes for the given KogitoRuntime
+func ValidateKogitoRuntime(reader client.Reader, object client.Object) field.ErrorList {
+	runtime := object.(api.KogitoRuntimeInterface)
+	errs := validateKogitoService(reader, runtime, false)
+	if runtime.GetSpec().GetTLS() != nil && runtime.GetRuntimeSpec().GetDeploymentMode() == api.KnativeServiceDeploymentMode {
+		errs = append(errs, field.Forbidden(specPath.Child("tls"), "can't be set along with the KnativeService deployment mode, Knative serves the service"))
+	}
+	return errs
+}
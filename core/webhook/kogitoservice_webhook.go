@@ -0,0 +1,201 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/kogitoservice"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultReplicas = int32(1)
+	singleReplica   = int32(1)
+
+	invalidImageMessage = "must be a valid image reference, e.g. quay.io/kiegroup/kogito-service:latest"
+)
+
+var specPath = field.NewPath("spec")
+
+// defaultKogitoService sets the defaults shared by every Kogito Service
+func defaultKogitoService(service api.KogitoService) {
+	spec := service.GetSpec()
+	if spec.GetReplicas() == nil {
+		spec.SetReplicas(defaultReplicas)
+	}
+	// GetRuntime sets the default runtime in place when it's missing
+	spec.GetRuntime()
+	if spec.IsAutoscalingEnabled() && spec.GetAutoscaling().GetMinReplicas() == nil {
+		minReplicas := api.AutoscalingDefaultMinReplicas
+		spec.GetAutoscaling().SetMinReplicas(&minReplicas)
+	}
+}
+
+// validateKogitoService verifies the attributes shared by every Kogito Service
+func validateKogitoService(reader client.Reader, service api.KogitoService, isSingleReplica bool) field.ErrorList {
+	var errs field.ErrorList
+	spec := service.GetSpec()
+	if image := spec.GetImage(); len(image) > 0 && !framework.IsValidImage(image) {
+		errs = append(errs, field.Invalid(specPath.Child("image"), image, invalidImageMessage))
+	}
+	if isSingleReplica && spec.GetReplicas() != nil && *spec.GetReplicas() > singleReplica {
+		errs = append(errs, field.Invalid(specPath.Child("replicas"), *spec.GetReplicas(), "this service can't have more than one replica"))
+	}
+	if spec.IsAutoscalingEnabled() {
+		autoscalingPath := specPath.Child("autoscaling")
+		if isSingleReplica {
+			errs = append(errs, field.Forbidden(autoscalingPath, "this service can't have more than one replica"))
+		} else if minReplicas := spec.GetAutoscaling().GetMinReplicas(); minReplicas != nil && *minReplicas > spec.GetAutoscaling().GetMaxReplicas() {
+			errs = append(errs, field.Invalid(autoscalingPath.Child("maxReplicas"), spec.GetAutoscaling().GetMaxReplicas(), "must be greater than or equal to minReplicas"))
+		}
+	}
+	if budget := spec.GetPodDisruptionBudget(); budget != nil && !budget.IsDisabled() {
+		budgetPath := specPath.Child("podDisruptionBudget")
+		if isSingleReplica {
+			errs = append(errs, field.Forbidden(budgetPath, "this service can't have more than one replica"))
+		} else if budget.GetMinAvailable() != nil && budget.GetMaxUnavailable() != nil {
+			errs = append(errs, field.Invalid(budgetPath.Child("maxUnavailable"), budget.GetMaxUnavailable().String(), "can't be set together with minAvailable"))
+		}
+	}
+	for i, infra := range spec.GetInfra() {
+		if len(infra) == 0 {
+			errs = append(errs, field.Required(specPath.Child("infra").Index(i), "KogitoInfra name can't be empty"))
+		}
+	}
+	errs = append(errs, validateKafkaTopics(spec.GetKafkaTopics())...)
+	errs = append(errs, validateTLS(spec)...)
+	errs = append(errs, validateTrustStoreSources(spec)...)
+	errs = append(errs, validateVolumes(reader, service)...)
+	return errs
+}
+
+// validateTLS verifies the serving certificate can be requested to its provider and served by the pods
+func validateTLS(spec api.KogitoServiceSpecInterface) field.ErrorList {
+	var errs field.ErrorList
+	tls := spec.GetTLS()
+	if tls == nil {
+		return errs
+	}
+	tlsPath := specPath.Child("tls")
+	if spec.IsEnableIstio() {
+		errs = append(errs, field.Forbidden(tlsPath, "can't be set along with enableIstio, the mesh secures the connections to the service"))
+	}
+	if tls.GetProvider() == api.CertManagerCertificateProvider && len(tls.GetIssuerName()) == 0 {
+		errs = append(errs, field.Required(tlsPath.Child("issuerName"), "the CertManager provider requires an issuer"))
+	}
+	return errs
+}
+
+// validateTrustStoreSources verifies the truststore of the service is either given or built by the operator
+func validateTrustStoreSources(spec api.KogitoServiceSpecInterface) field.ErrorList {
+	var errs field.ErrorList
+	if len(spec.GetTrustStoreSources()) > 0 && len(spec.GetTrustStoreSecret()) > 0 {
+		errs = append(errs, field.Forbidden(specPath.Child("trustStoreSources"), "can't be set along with trustStoreSecret, the truststore is built from the sources"))
+	}
+	return errs
+}
+
+// validateVolumes verifies each volume is backed by exactly one kind of storage and doesn't take the name of a volume mounted by the operator.
+// Claims attachable to a single node can't be mounted by several pods at once: the new pods would be stuck in Multi-Attach errors.
+func validateVolumes(reader client.Reader, service api.KogitoService) field.ErrorList {
+	var errs field.ErrorList
+	operatorVolumeNames := kogitoservice.GetOperatorVolumeNames(service)
+	multiplePodsReason := getMultiplePodsReason(service)
+	for i, volume := range service.GetSpec().GetVolumes() {
+		volumePath := specPath.Child("volumes").Index(i)
+		if containsString(operatorVolumeNames, volume.GetName()) {
+			errs = append(errs, field.Invalid(volumePath.Child("name"), volume.GetName(), "is the name of a volume mounted by the operator"))
+		}
+		sources := 0
+		if claimName := volume.GetClaimName(); len(claimName) > 0 {
+			sources++
+			if len(multiplePodsReason) > 0 {
+				claim := &corev1.PersistentVolumeClaim{}
+				if err := reader.Get(context.TODO(), types.NamespacedName{Name: claimName, Namespace: service.GetNamespace()}, claim); err != nil {
+					if !errors.IsNotFound(err) {
+						errs = append(errs, field.InternalError(volumePath.Child("claimName"), err))
+					}
+				} else if isSingleNodeAccessModes(claim.Spec.AccessModes) {
+					errs = append(errs, field.Forbidden(volumePath.Child("claimName"), singleNodeClaimMessage(multiplePodsReason)))
+				}
+			}
+		}
+		if volume.GetEmptyDir() != nil {
+			sources++
+		}
+		if claimTemplate := volume.GetClaimTemplate(); claimTemplate != nil {
+			sources++
+			if size := claimTemplate.GetSize(); size.Sign() <= 0 {
+				errs = append(errs, field.Invalid(volumePath.Child("claimTemplate", "size"), size.String(), "must be greater than zero"))
+			}
+			if len(multiplePodsReason) > 0 && isSingleNodeAccessModes(claimTemplate.GetAccessModes()) {
+				errs = append(errs, field.Forbidden(volumePath.Child("claimTemplate", "accessModes"), singleNodeClaimMessage(multiplePodsReason)))
+			}
+		}
+		if sources != 1 {
+			errs = append(errs, field.Invalid(volumePath, volume.GetName(), "exactly one of claimName, claimTemplate or emptyDir must be set"))
+		}
+	}
+	return errs
+}
+
+// getMultiplePodsReason gets the attribute of the service running several pods at once, empty if it runs a single one
+func getMultiplePodsReason(service api.KogitoService) string {
+	spec := service.GetSpec()
+	if spec.IsAutoscalingEnabled() {
+		return "autoscaling"
+	}
+	if spec.GetReplicas() != nil && *spec.GetReplicas() > singleReplica {
+		return "replicas greater than 1"
+	}
+	if runtime, ok := service.(api.KogitoRuntimeInterface); ok {
+		// the candidate pods run next to the current ones until they're promoted
+		if rollout := runtime.GetRuntimeSpec().GetRollout(); rollout != nil && rollout.GetType() != api.RollingUpdateRolloutType {
+			return fmt.Sprintf("the %s rollout", rollout.GetType())
+		}
+	}
+	return ""
+}
+
+// isSingleNodeAccessModes checks if a claim with the given access modes can only be mounted by the pods of a single node
+func isSingleNodeAccessModes(accessModes []corev1.PersistentVolumeAccessMode) bool {
+	for _, accessMode := range accessModes {
+		if accessMode == corev1.ReadWriteMany || accessMode == corev1.ReadOnlyMany {
+			return false
+		}
+	}
+	return true
+}
+
+func singleNodeClaimMessage(multiplePodsReason string) string {
+	return fmt.Sprintf("a ReadWriteOnce claim can't be mounted by the several pods required by %s, use a ReadWriteMany claim instead", multiplePodsReason)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,232 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestDefaultKogitoRuntime(t *testing.T) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoRuntimeSpec{
+			KogitoServiceSpec: v1beta1.KogitoServiceSpec{Autoscaling: &v1beta1.Autoscaling{MaxReplicas: 3}},
+		},
+	}
+	DefaultKogitoRuntime(kogitoRuntime)
+	assert.Equal(t, int32(1), *kogitoRuntime.Spec.Replicas)
+	assert.Equal(t, api.QuarkusRuntimeType, kogitoRuntime.Spec.Runtime)
+	assert.Equal(t, api.AutoscalingDefaultMinReplicas, *kogitoRuntime.Spec.Autoscaling.MinReplicas)
+}
+
+func TestValidateKogitoRuntime(t *testing.T) {
+	minReplicas := int32(4)
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoRuntimeSpec{
+			KogitoServiceSpec: v1beta1.KogitoServiceSpec{
+				Image:       "quay.io/kiegroup/process-quarkus-example:latest",
+				Autoscaling: &v1beta1.Autoscaling{MinReplicas: &minReplicas, MaxReplicas: 8},
+				Infra:       []string{"kogito-infinispan-infra"},
+			},
+		},
+	}
+	assert.Empty(t, ValidateKogitoRuntime(newFakeReader(), kogitoRuntime))
+
+	kogitoRuntime.Spec.Image = "quay.io/kiegroup/process-quarkus-example:"
+	kogitoRuntime.Spec.Autoscaling.MaxReplicas = 2
+	kogitoRuntime.Spec.Infra = append(kogitoRuntime.Spec.Infra, "")
+	errs := ValidateKogitoRuntime(newFakeReader(), kogitoRuntime)
+	assert.Len(t, errs, 3)
+	assert.Equal(t, "spec.image", errs[0].Field)
+	assert.Equal(t, "spec.autoscaling.maxReplicas", errs[1].Field)
+	assert.Equal(t, "spec.infra[1]", errs[2].Field)
+}
+
+func TestValidateKogitoRuntime_PodDisruptionBudget(t *testing.T) {
+	minAvailable := intstr.FromInt(2)
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoRuntimeSpec{
+			KogitoServiceSpec: v1beta1.KogitoServiceSpec{
+				PodDisruptionBudget: &v1beta1.PodDisruptionBudget{MinAvailable: &minAvailable},
+			},
+		},
+	}
+	assert.Empty(t, ValidateKogitoRuntime(newFakeReader(), kogitoRuntime))
+
+	maxUnavailable := intstr.FromString("25%")
+	kogitoRuntime.Spec.PodDisruptionBudget.MaxUnavailable = &maxUnavailable
+	errs := ValidateKogitoRuntime(newFakeReader(), kogitoRuntime)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "spec.podDisruptionBudget.maxUnavailable", errs[0].Field)
+}
+
+func TestValidateKogitoRuntime_KafkaTopics(t *testing.T) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoRuntimeSpec{
+			KogitoServiceSpec: v1beta1.KogitoServiceSpec{
+				KafkaTopics: []v1beta1.KafkaTopic{{Name: "travellers", Partitions: 3}},
+			},
+		},
+	}
+	assert.Empty(t, ValidateKogitoRuntime(newFakeReader(), kogitoRuntime))
+
+	kogitoRuntime.Spec.KafkaTopics = append(kogitoRuntime.Spec.KafkaTopics, v1beta1.KafkaTopic{Name: "travellers", Partitions: 6})
+	errs := ValidateKogitoRuntime(newFakeReader(), kogitoRuntime)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "spec.kafkaTopics[1].name", errs[0].Field)
+}
+
+func TestValidateKogitoRuntime_TLS(t *testing.T) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoRuntimeSpec{
+			KogitoServiceSpec: v1beta1.KogitoServiceSpec{
+				TLS: &v1beta1.TLS{IssuerName: "kogito-ca"},
+			},
+		},
+	}
+	assert.Empty(t, ValidateKogitoRuntime(newFakeReader(), kogitoRuntime))
+
+	kogitoRuntime.Spec.TLS = &v1beta1.TLS{Provider: api.CertManagerCertificateProvider}
+	kogitoRuntime.Spec.EnableIstio = true
+	kogitoRuntime.Spec.DeploymentMode = api.KnativeServiceDeploymentMode
+	errs := ValidateKogitoRuntime(newFakeReader(), kogitoRuntime)
+	assert.Len(t, errs, 3)
+	assert.Equal(t, "spec.tls", errs[0].Field)
+	assert.Equal(t, "spec.tls.issuerName", errs[1].Field)
+	assert.Equal(t, "spec.tls", errs[2].Field)
+}
+
+func TestValidateKogitoRuntime_TrustStoreSources(t *testing.T) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoRuntimeSpec{
+			KogitoServiceSpec: v1beta1.KogitoServiceSpec{
+				TrustStoreSources: []v1beta1.TrustStoreSource{{Kind: api.ConfigMapTrustStoreSource, Name: "trusted-ca"}},
+			},
+		},
+	}
+	assert.Empty(t, ValidateKogitoRuntime(newFakeReader(), kogitoRuntime))
+
+	kogitoRuntime.Spec.TrustStoreSecret = "custom-truststore"
+	errs := ValidateKogitoRuntime(newFakeReader(), kogitoRuntime)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "spec.trustStoreSources", errs[0].Field)
+}
+
+func TestValidateKogitoRuntime_Volumes(t *testing.T) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoRuntimeSpec{
+			KogitoServiceSpec: v1beta1.KogitoServiceSpec{
+				Volumes: []v1beta1.Volume{
+					{Name: "processes", MountPath: "/home/kogito/processes", ClaimTemplate: &v1beta1.VolumeClaimTemplate{Size: resource.MustParse("1Gi")}},
+					{Name: "cache", MountPath: "/tmp/cache", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				},
+			},
+		},
+	}
+	assert.Empty(t, ValidateKogitoRuntime(newFakeReader(), kogitoRuntime))
+
+	kogitoRuntime.Spec.Volumes = []v1beta1.Volume{
+		{Name: "processes", MountPath: "/home/kogito/processes", ClaimName: "processes", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		{Name: "cache", MountPath: "/tmp/cache", ClaimTemplate: &v1beta1.VolumeClaimTemplate{}},
+	}
+	errs := ValidateKogitoRuntime(newFakeReader(), kogitoRuntime)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "spec.volumes[0]", errs[0].Field)
+	assert.Equal(t, "spec.volumes[1].claimTemplate.size", errs[1].Field)
+}
+
+func TestValidateKogitoRuntime_SingleNodeVolumes(t *testing.T) {
+	replicas := int32(2)
+	sharedClaim := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: t.Name()},
+		Spec:       corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}},
+	}
+	singleNodeClaim := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "single-node", Namespace: t.Name()},
+		Spec:       corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+	}
+	reader := newFakeReader(sharedClaim, singleNodeClaim)
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoRuntimeSpec{
+			KogitoServiceSpec: v1beta1.KogitoServiceSpec{
+				Replicas: &replicas,
+				Volumes: []v1beta1.Volume{
+					{Name: "processes", MountPath: "/home/kogito/processes", ClaimTemplate: &v1beta1.VolumeClaimTemplate{
+						Size:        resource.MustParse("1Gi"),
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+					}},
+					{Name: "shared", MountPath: "/home/kogito/shared", ClaimName: sharedClaim.Name},
+				},
+			},
+		},
+	}
+	assert.Empty(t, ValidateKogitoRuntime(reader, kogitoRuntime))
+
+	kogitoRuntime.Spec.Volumes = []v1beta1.Volume{
+		{Name: "processes", MountPath: "/home/kogito/processes", ClaimTemplate: &v1beta1.VolumeClaimTemplate{Size: resource.MustParse("1Gi")}},
+		{Name: "single-node", MountPath: "/home/kogito/single-node", ClaimName: singleNodeClaim.Name},
+	}
+	errs := ValidateKogitoRuntime(reader, kogitoRuntime)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "spec.volumes[0].claimTemplate.accessModes", errs[0].Field)
+	assert.Equal(t, "spec.volumes[1].claimName", errs[1].Field)
+
+	// a single pod can mount them, unless a candidate runs next to it during the rollout
+	replicas = 1
+	assert.Empty(t, ValidateKogitoRuntime(reader, kogitoRuntime))
+	kogitoRuntime.Spec.Rollout = &v1beta1.Rollout{Type: api.BlueGreenRolloutType}
+	assert.Len(t, ValidateKogitoRuntime(reader, kogitoRuntime), 2)
+	kogitoRuntime.Spec.Rollout = nil
+	kogitoRuntime.Spec.Autoscaling = &v1beta1.Autoscaling{MaxReplicas: 3}
+	assert.Len(t, ValidateKogitoRuntime(reader, kogitoRuntime), 2)
+}
+
+func TestValidateKogitoRuntime_OperatorVolumeNames(t *testing.T) {
+	kogitoRuntime := &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "process-quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoRuntimeSpec{
+			KogitoServiceSpec: v1beta1.KogitoServiceSpec{
+				PropertiesConfigMap: "custom-properties",
+				Volumes: []v1beta1.Volume{
+					{Name: "process-quarkus-example-tls", MountPath: "/tmp/tls", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					{Name: "process-quarkus-example-truststore", MountPath: "/tmp/truststore", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					{Name: "custom-properties", MountPath: "/tmp/properties", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					{Name: "cache", MountPath: "/tmp/cache", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				},
+			},
+		},
+	}
+	errs := ValidateKogitoRuntime(newFakeReader(), kogitoRuntime)
+	assert.Len(t, errs, 3)
+	for i, err := range errs {
+		assert.Equal(t, fmt.Sprintf("spec.volumes[%d].name", i), err.Field)
+	}
+}
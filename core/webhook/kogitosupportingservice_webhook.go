@@ -0,0 +1,37 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/kogitosupportingservice"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultKogitoSupportingService sets the default values for the given KogitoSupportingService
+func DefaultKogitoSupportingService(object client.Object) {
+	defaultKogitoService(object.(api.KogitoSupportingServiceInterface))
+}
+
+// ValidateKogitoSupportingService verifies the spec attributes for the given KogitoSupportingService
+func ValidateKogitoSupportingService(reader client.Reader, object client.Object) field.ErrorList {
+	service := object.(api.KogitoSupportingServiceInterface)
+	serviceType := service.GetSupportingServiceSpec().GetServiceType()
+	if !kogitosupportingservice.IsServiceTypeSupported(serviceType) {
+		return field.ErrorList{field.NotSupported(specPath.Child("serviceType"), serviceType, kogitosupportingservice.GetSupportedServiceTypes())}
+	}
+	return validateKogitoService(reader, service, kogitosupportingservice.IsSingleReplicaService(serviceType))
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateKogitoSupportingService_UnknownServiceType(t *testing.T) {
+	supportingService := &v1beta1.KogitoSupportingService{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-index", Namespace: t.Name()},
+		Spec:       v1beta1.KogitoSupportingServiceSpec{ServiceType: "DataIndexer"},
+	}
+	errs := ValidateKogitoSupportingService(newFakeReader(), supportingService)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+	assert.Equal(t, "spec.serviceType", errs[0].Field)
+}
+
+func TestValidateKogitoSupportingService_SingleReplica(t *testing.T) {
+	jobsService := &v1beta1.KogitoSupportingService{
+		ObjectMeta: metav1.ObjectMeta{Name: "jobs-service", Namespace: t.Name()},
+		Spec:       v1beta1.KogitoSupportingServiceSpec{ServiceType: api.JobsService},
+	}
+	jobsService.Spec.SetReplicas(1)
+	assert.Empty(t, ValidateKogitoSupportingService(newFakeReader(), jobsService))
+
+	jobsService.Spec.SetReplicas(2)
+	jobsService.Spec.Autoscaling = &v1beta1.Autoscaling{MaxReplicas: 2}
+	jobsService.Spec.PodDisruptionBudget = &v1beta1.PodDisruptionBudget{}
+	errs := ValidateKogitoSupportingService(newFakeReader(), jobsService)
+	assert.Len(t, errs, 3)
+	assert.Equal(t, "spec.replicas", errs[0].Field)
+	assert.Equal(t, "spec.autoscaling", errs[1].Field)
+	assert.Equal(t, "spec.podDisruptionBudget", errs[2].Field)
+
+	dataIndex := &v1beta1.KogitoSupportingService{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-index", Namespace: t.Name()},
+		Spec:       v1beta1.KogitoSupportingServiceSpec{ServiceType: api.DataIndex},
+	}
+	dataIndex.Spec.SetReplicas(2)
+	assert.Empty(t, ValidateKogitoSupportingService(newFakeReader(), dataIndex))
+}
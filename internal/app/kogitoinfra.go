@@ -47,3 +47,12 @@ func (k *kogitoInfraHandler) FetchKogitoInfraInstance(key types.NamespacedName)
 		return instance, nil
 	}
 }
+
+// FetchKogitoInfraList fetches the list of KogitoInfras in the given namespace
+func (k *kogitoInfraHandler) FetchKogitoInfraList(namespace string) (api.KogitoInfraListInterface, error) {
+	infraList := &v1beta1.KogitoInfraList{}
+	if err := kubernetes.ResourceC(k.Client).ListWithNamespace(namespace, infraList); err != nil {
+		return nil, err
+	}
+	return infraList, nil
+}
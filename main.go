@@ -24,6 +24,7 @@ import (
 	"github.com/kiegroup/kogito-operator/core/logger"
 	"github.com/kiegroup/kogito-operator/meta"
 	"os"
+	"path/filepath"
 	"strings"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -99,6 +100,12 @@ func main() {
 			setupLog.Error(err, "unable to create controller", "controller", "KogitoInfra")
 			os.Exit(1)
 		}
+		if isWebhooksEnabled() {
+			if err = app.SetupWebhooksWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhooks")
+				os.Exit(1)
+			}
+		}
 	} else {
 		if err = rhpam.NewKogitoRuntimeReconciler(kubeCli, mgr.GetScheme()).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "KogitoRuntime")
@@ -116,6 +123,12 @@ func main() {
 			setupLog.Error(err, "unable to create controller", "controller", "KogitoInfra")
 			os.Exit(1)
 		}
+		if isWebhooksEnabled() {
+			if err = rhpam.SetupWebhooksWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhooks")
+				os.Exit(1)
+			}
+		}
 	}
 
 	//+kubebuilder:scaffold:builder
@@ -154,6 +167,28 @@ func getWatchNamespace() string {
 	return ns
 }
 
+// isWebhooksEnabled returns whether the admission webhooks should be served.
+// ENABLE_WEBHOOKS forces them on or off, otherwise they are enabled only when the serving certificate,
+// provided by OLM or cert-manager, is mounted in the manager
+func isWebhooksEnabled() bool {
+	var enableWebhooks = "ENABLE_WEBHOOKS"
+	enabled, _ := os.LookupEnv(enableWebhooks)
+
+	switch strings.ToUpper(enabled) {
+	case "FALSE":
+		setupLog.Info("Admission webhooks are disabled")
+		return false
+	case "TRUE":
+		return true
+	}
+	certFile := filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs", "tls.crt")
+	if _, err := os.Stat(certFile); err != nil {
+		setupLog.Info("Admission webhooks are disabled, serving certificate not found", "Certificate", certFile)
+		return false
+	}
+	return true
+}
+
 func isDebugMode() bool {
 	var debug = "DEBUG"
 	devMode, _ := os.LookupEnv(debug)
@@ -15,14 +15,20 @@
 package meta
 
 import (
+	appv1 "github.com/kiegroup/kogito-operator/apis/app/v1"
 	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
 	v1 "github.com/kiegroup/kogito-operator/apis/rhpam/v1"
 	"github.com/kiegroup/kogito-operator/core/framework/util"
+	certmanagerv1 "github.com/kiegroup/kogito-operator/core/infrastructure/certmanager/v1"
 	grafana "github.com/kiegroup/kogito-operator/core/infrastructure/grafana/v1alpha1"
 	infinispan "github.com/kiegroup/kogito-operator/core/infrastructure/infinispan/v1"
+	istio "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
 	mongodb "github.com/kiegroup/kogito-operator/core/infrastructure/mongodb/v1"
+	postgresql "github.com/kiegroup/kogito-operator/core/infrastructure/postgresql/v1beta1"
+	servingv1 "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
+	tekton "github.com/kiegroup/kogito-operator/core/infrastructure/tekton/v1beta1"
 	appsv1 "github.com/openshift/api/apps/v1"
 	buildv1 "github.com/openshift/api/build/v1"
 	imgv1 "github.com/openshift/api/image/v1"
@@ -55,6 +61,7 @@ func GetRegisteredSchema() *runtime.Scheme {
 		metav1.AddToGroupVersion(s, v1.GroupVersion)
 	} else {
 		metav1.AddToGroupVersion(s, v1beta1.GroupVersion)
+		metav1.AddToGroupVersion(s, appv1.GroupVersion)
 	}
 	// https://issues.jboss.org/browse/KOGITO-617
 	metav1.AddToGroupVersion(s, apiextensionsv1.SchemeGroupVersion)
@@ -63,10 +70,15 @@ func GetRegisteredSchema() *runtime.Scheme {
 	metav1.AddToGroupVersion(s, routev1.GroupVersion)
 	metav1.AddToGroupVersion(s, infinispan.SchemeGroupVersion)
 	metav1.AddToGroupVersion(s, mongodb.SchemeBuilder.GroupVersion)
+	metav1.AddToGroupVersion(s, postgresql.SchemeBuilder.GroupVersion)
+	metav1.AddToGroupVersion(s, tekton.GroupVersion)
+	metav1.AddToGroupVersion(s, istio.GroupVersion)
 	metav1.AddToGroupVersion(s, v1beta2.SchemeGroupVersion)
 	metav1.AddToGroupVersion(s, grafana.GroupVersion)
 	metav1.AddToGroupVersion(s, eventingv1.SchemeGroupVersion)
 	metav1.AddToGroupVersion(s, sourcesv1.SchemeGroupVersion)
+	metav1.AddToGroupVersion(s, servingv1.GroupVersion)
+	metav1.AddToGroupVersion(s, certmanagerv1.GroupVersion)
 	return s
 }
 
@@ -74,6 +86,7 @@ func GetRegisteredSchema() *runtime.Scheme {
 func getRegisteredSchemeBuilder() runtime.SchemeBuilder {
 	return runtime.NewSchemeBuilder(
 		v1beta1.SchemeBuilder.AddToScheme,
+		appv1.SchemeBuilder.AddToScheme,
 		v1.SchemeBuilder.AddToScheme,
 		clientgoscheme.AddToScheme,
 		corev1.AddToScheme,
@@ -87,9 +100,14 @@ func getRegisteredSchemeBuilder() runtime.SchemeBuilder {
 		apiextensionsv1.AddToScheme,
 		v1beta2.SchemeBuilder.AddToScheme,
 		mongodb.SchemeBuilder.AddToScheme,
+		postgresql.SchemeBuilder.AddToScheme,
+		tekton.SchemeBuilder.AddToScheme,
+		istio.SchemeBuilder.AddToScheme,
 		infinispan.AddToScheme,
 		keycloakv1alpha1.SchemeBuilder.AddToScheme,
 		monv1.SchemeBuilder.AddToScheme,
 		eventingv1.AddToScheme, sourcesv1.AddToScheme,
+		servingv1.SchemeBuilder.AddToScheme,
+		certmanagerv1.SchemeBuilder.AddToScheme,
 		grafana.AddToScheme)
 }